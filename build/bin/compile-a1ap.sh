@@ -11,6 +11,8 @@ export OAPI_CODEGEN_VERSION=1.6.6
 ## Validating specification first
 docker run -v ${ONOS_ROOT}/onos-a1t/api/northbound/v301/enrichment_information/a1ap_enrichment_information.yaml:/openapi.yaml --rm p1c2u/openapi-spec-validator:${OAPI_SPEC_VALIDATOR_VERSION} /openapi.yaml
 docker run -v ${ONOS_ROOT}/onos-a1t/api/northbound/v301/policy_management/a1ap_policy_management.yaml:/openapi.yaml --rm p1c2u/openapi-spec-validator:${OAPI_SPEC_VALIDATOR_VERSION} /openapi.yaml
+docker run -v ${ONOS_ROOT}/onos-a1t/api/northbound/v400/policy_management/a1ap_policy_management.yaml:/openapi.yaml --rm p1c2u/openapi-spec-validator:${OAPI_SPEC_VALIDATOR_VERSION} /openapi.yaml
+docker run -v ${ONOS_ROOT}/onos-a1t/api/northbound/v400/data_management/a1ap_data_management.yaml:/openapi.yaml --rm p1c2u/openapi-spec-validator:${OAPI_SPEC_VALIDATOR_VERSION} /openapi.yaml
 
 # Old way, requires installing prerequisites with make openapi-spec-validator
 #openapi-spec-validator api/northbound/v301/policy_management/a1ap_policy_management.yaml
@@ -24,3 +26,5 @@ docker run -v ${ONOS_ROOT}/onos-a1t/api/northbound/v301/policy_management/a1ap_p
 # Old way, requires installing prerequisites with make oapi-codegen
 oapi-codegen api/northbound/v301/enrichment_information/a1ap_enrichment_information.yaml > pkg/northbound/a1ap/enrichment_information/a1ap_ei.go
 oapi-codegen api/northbound/v301/policy_management/a1ap_policy_management.yaml > pkg/northbound/a1ap/policy_management/a1ap_pm.go
+oapi-codegen -package A1apPolicyManagement api/northbound/v400/policy_management/a1ap_policy_management.yaml > pkg/northbound/a1ap/v400/policy_management/a1ap_pm.go
+oapi-codegen -package A1apDataManagement api/northbound/v400/data_management/a1ap_data_management.yaml > pkg/northbound/a1ap/v400/data_management/a1ap_dme.go
@@ -0,0 +1,15 @@
+#!/bin/sh
+
+# SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+#
+# SPDX-License-Identifier: Apache-2.0
+
+# Runs in the onosproject/protoc-go image; see the protos target of the Makefile
+proto_imports="./api:${GOPATH}/src/github.com/gogo/protobuf/protobuf:${GOPATH}/src/github.com/gogo/protobuf:${GOPATH}/src"
+go_import_paths="Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types"
+
+mkdir -p build/_output/protos
+protoc -I=$proto_imports \
+  --gogofaster_out=$go_import_paths,paths=source_relative,plugins=grpc:build/_output/protos \
+  api/onos/a1t/admin/admin_ext.proto
+mv build/_output/protos/onos/a1t/admin/admin_ext.pb.go pkg/northbound/cli/admin_ext.pb.go
@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"github.com/onosproject/onos-a1t/pkg/admincli"
+	libcli "github.com/onosproject/onos-lib-go/pkg/cli"
+)
+
+func main() {
+	libcli.Run(admincli.GetCommand())
+}
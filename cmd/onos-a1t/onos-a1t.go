@@ -6,8 +6,19 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/handler"
+	"github.com/onosproject/onos-a1t/pkg/inventory"
 	"github.com/onosproject/onos-a1t/pkg/manager"
+	"github.com/onosproject/onos-a1t/pkg/northbound/cli"
+	"github.com/onosproject/onos-a1t/pkg/policysource"
+	"github.com/onosproject/onos-a1t/pkg/policytype"
+	"github.com/onosproject/onos-a1t/pkg/southbound"
+	"github.com/onosproject/onos-a1t/pkg/stream"
 	"github.com/onosproject/onos-lib-go/pkg/certs"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 )
@@ -21,7 +32,39 @@ func main() {
 	configPath := flag.String("configPath", "/etc/onos/config/config.json", "path to config.json file")
 	grpcPort := flag.Int("grpcPort", 5150, "grpc Port number")
 	baseURL := flag.String("baseURL", "0.0.0.0:9639", "base URL for NBI A1T restfull server")
+	trustedProxies := flag.String("trustedProxies", "", "comma-separated CIDRs of the proxies trusted to give the address of the REST callers in X-Forwarded-For; empty uses the remote address of the connection")
+	metricsAddress := flag.String("metricsAddress", "0.0.0.0:9641", "address of the Prometheus metrics server, kept apart from the A1 REST API; empty disables it")
+	a1apVersions := flag.String("a1apVersions", handler.DefaultAPIVersion, fmt.Sprintf("comma-separated A1AP spec versions served by the REST server, among %v", handler.APIVersions()))
 	nonRTRICURL := flag.String("nonRTRICURL", "127.0.0.1:9640", "base URL of A1 in Non-RT RIC")
+	dmeCallbackURL := flag.String("dmeCallbackURL", "", "URL at which the Non-RT RIC delivers the results and status of the A1-DME jobs to A1T, e.g. http://onos-a1t:9639/v400, which requires v400 in a1apVersions; empty disables the jobs of the xApps")
+	auditRetention := flag.Int("auditRetention", 1000, "maximum number of policy audit records to keep")
+	streamBufferSize := flag.Int("streamBufferSize", 64, "number of messages buffered by each internal stream")
+	watcherBufferSize := flag.Int("watcherBufferSize", 64, "number of messages buffered for each internal stream watcher")
+	streamOverflowPolicy := flag.String("streamOverflowPolicy", stream.Block.String(), "what to do when a stream watcher buffer is full: Block, DropNewest or DropOldest")
+	sbReconnectInterval := flag.Duration("sbReconnectInterval", time.Second, "initial backoff interval before reconnecting to an xApp")
+	sbMaxReconnectInterval := flag.Duration("sbMaxReconnectInterval", 30*time.Second, "maximum backoff interval before reconnecting to an xApp")
+	sbRateLimit := flag.Float64("sbRateLimit", 0, "maximum number of policy requests per second sent to an xApp; 0 disables rate limiting")
+	sbRateBurst := flag.Int("sbRateBurst", 1, "number of policy requests that can be sent at once to an xApp above the rate limit")
+	sbMaxInFlight := flag.Int("sbMaxInFlight", 16, "maximum number of policy requests an xApp processes at the same time; 0 means unlimited")
+	sbQueueTimeout := flag.Duration("sbQueueTimeout", 2*time.Second, "how long a policy request may wait in the queue of an xApp")
+	sbLoadBalancingPolicy := flag.String("sbLoadBalancingPolicy", southbound.PickFirst.String(), "how requests are spread over the endpoints of an xApp: PickFirst or RoundRobin")
+	circuitFailureThreshold := flag.Int("circuitFailureThreshold", 5, "number of consecutive timeouts or delivery errors after which the circuit of an xApp opens")
+	circuitOpenTimeout := flag.Duration("circuitOpenTimeout", 30*time.Second, "how long the circuit of an xApp stays open before it is probed")
+	circuitHalfOpenProbes := flag.Int("circuitHalfOpenProbes", 1, "number of concurrent probe requests sent to an xApp whose circuit is half-open")
+	policySourceDir := flag.String("policySourceDir", "", "directory of YAML/JSON policy manifests to sync, e.g. a Git checkout; empty disables the policy source")
+	policySourceInterval := flag.Duration("policySourceInterval", 30*time.Second, "how often the policy manifests are synced")
+	policySourceOwner := flag.String("policySourceOwner", policysource.Caller, "owner label of the policies managed by the policy source")
+	policySourceDryRun := flag.Bool("policySourceDryRun", false, "only report the changes needed to sync the policy manifests")
+	policySourceCorrectDrift := flag.Bool("policySourceCorrectDrift", false, "delete the policies reported by xApps that are neither in the manifests nor known to A1T, except the ones they held when A1T started")
+	policyTypeRegistry := flag.String("policyTypeRegistry", "", "path to the YAML/JSON file with the conflict rules of the policy types")
+	policyQuota := flag.Int("policyQuota", 0, "maximum number of policies of each type a tenant may own; 0 means unlimited")
+	requireTenant := flag.Bool("requireTenant", false, "reject the REST callers without a client certificate instead of letting them manage every policy")
+	statusHistorySize := flag.Int("statusHistorySize", 1000, "number of policy status notifications kept to resume status streams")
+	statusCacheTTL := flag.Duration("statusCacheTTL", controller.DefaultConfig().StatusCacheTTL, "how long the last status pushed by an xApp answers the status requests without querying it; 0 always queries the xApps")
+	inventoryInterval := flag.Duration("inventoryInterval", inventory.DefaultConfig().Interval, "period of the scans of the policies held by the xApps; 0 disables the periodic scans")
+	inventoryAutoRepair := flag.Bool("inventoryAutoRepair", false, "push the policies again to the xApps which miss them or hold stale ones after each scan")
+	adminAuthz := flag.String("adminAuthz", "", "path to the YAML/JSON file granting the callers the write RPCs of the admin API; empty denies them all")
+	circuitAggregationPolicy := flag.String("circuitAggregationPolicy", controller.FailFast.String(), "how a policy fan-out treats the xApps whose circuit is open: FailFast or SkipOpen")
 
 	ready := make(chan bool)
 
@@ -32,14 +75,97 @@ func main() {
 		log.Fatal(err)
 	}
 
+	overflowPolicy, err := stream.ParseOverflowPolicy(*streamOverflowPolicy)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	lbPolicy, err := southbound.ParseLoadBalancingPolicy(*sbLoadBalancingPolicy)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	aggregationPolicy, err := controller.ParseAggregationPolicy(*circuitAggregationPolicy)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctrlConfig := controller.DefaultConfig()
+	ctrlConfig.CircuitBreaker = controller.CircuitBreakerConfig{
+		FailureThreshold:  *circuitFailureThreshold,
+		OpenTimeout:       *circuitOpenTimeout,
+		HalfOpenProbes:    *circuitHalfOpenProbes,
+		AggregationPolicy: aggregationPolicy,
+	}
+	ctrlConfig.StatusHistorySize = *statusHistorySize
+	ctrlConfig.StatusCacheTTL = *statusCacheTTL
+	ctrlConfig.PolicyQuota = *policyQuota
+	ctrlConfig.RequireTenant = *requireTenant
+	ctrlConfig.DMECallbackURL = *dmeCallbackURL
+	if *policyTypeRegistry != "" {
+		ctrlConfig.PolicyTypes, err = policytype.LoadRegistry(*policyTypeRegistry)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var adminAuthorization *cli.Authorization
+	if *adminAuthz != "" {
+		adminAuthorization, err = cli.LoadAuthorization(*adminAuthz)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var proxies []string
+	if *trustedProxies != "" {
+		proxies = strings.Split(*trustedProxies, ",")
+	}
+
+	sourceConfig := policysource.DefaultConfig()
+	sourceConfig.Dir = *policySourceDir
+	sourceConfig.Interval = *policySourceInterval
+	sourceConfig.Owner = *policySourceOwner
+	sourceConfig.DryRun = *policySourceDryRun
+	sourceConfig.CorrectDrift = *policySourceCorrectDrift
+
+	inventoryConfig := inventory.DefaultConfig()
+	inventoryConfig.Interval = *inventoryInterval
+	inventoryConfig.AutoRepair = *inventoryAutoRepair
+
+	sbConfig := southbound.DefaultConfig()
+	sbConfig.Backoff.InitialInterval = *sbReconnectInterval
+	sbConfig.Backoff.MaxInterval = *sbMaxReconnectInterval
+	sbConfig.LoadBalancingPolicy = lbPolicy
+	sbConfig.RateLimit = southbound.RateLimitConfig{
+		Rate:         *sbRateLimit,
+		Burst:        *sbRateBurst,
+		MaxInFlight:  *sbMaxInFlight,
+		QueueTimeout: *sbQueueTimeout,
+	}
+
 	cfg := manager.Config{
-		CAPath:      *caPath,
-		KeyPath:     *keyPath,
-		CertPath:    *certPath,
-		GRPCPort:    *grpcPort,
-		ConfigPath:  *configPath,
-		BaseURL:     *baseURL,
-		NonRTRICURL: *nonRTRICURL,
+		CAPath:         *caPath,
+		KeyPath:        *keyPath,
+		CertPath:       *certPath,
+		GRPCPort:       *grpcPort,
+		ConfigPath:     *configPath,
+		BaseURL:        *baseURL,
+		TrustedProxies: proxies,
+		MetricsAddress: *metricsAddress,
+		A1APVersions:   strings.Split(*a1apVersions, ","),
+		NonRTRICURL:    *nonRTRICURL,
+		AuditRetention: *auditRetention,
+		StreamConfig: stream.BrokerConfig{
+			StreamBufferSize:  *streamBufferSize,
+			WatcherBufferSize: *watcherBufferSize,
+			OverflowPolicy:    overflowPolicy,
+		},
+		SouthboundConfig:   sbConfig,
+		ControllerConfig:   ctrlConfig,
+		PolicySourceConfig: sourceConfig,
+		InventoryConfig:    inventoryConfig,
+		AdminAuthorization: adminAuthorization,
 	}
 
 	log.Info("Starting onos-a1t")
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package admincli provides the onos-cli style commands of the A1T admin extension service
+package admincli
+
+import (
+	"context"
+	"text/tabwriter"
+
+	"github.com/onosproject/onos-a1t/pkg/northbound/cli"
+	libcli "github.com/onosproject/onos-lib-go/pkg/cli"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const (
+	configName     = "a1t"
+	defaultAddress = "onos-a1t:5150"
+	noHeadersFlag  = "no-headers"
+)
+
+func init() {
+	libcli.InitConfig(configName)
+}
+
+// GetCommand returns the root command for the A1T admin extension service
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "a1t-admin {get,set,delete,rollback,transfer,bulk,sync,reconcile,disconnect,reconnect,drain,undrain,watch} [args]",
+		Short: "ONOS A1T admin commands",
+	}
+
+	libcli.AddConfigFlags(cmd, defaultAddress)
+	cmd.AddCommand(libcli.GetConfigCommand())
+	cmd.AddCommand(getGetCommand())
+	cmd.AddCommand(getSetCommand())
+	cmd.AddCommand(getDeleteCommand())
+	cmd.AddCommand(getRollbackCommand())
+	cmd.AddCommand(getTransferCommand())
+	cmd.AddCommand(getBulkCommand())
+	cmd.AddCommand(getSyncCommand())
+	cmd.AddCommand(getReconcileCommand())
+	cmd.AddCommand(getDisconnectCommand())
+	cmd.AddCommand(getReconnectCommand())
+	cmd.AddCommand(getDrainCommand(true))
+	cmd.AddCommand(getDrainCommand(false))
+	cmd.AddCommand(getWatchCommand())
+	return cmd
+}
+
+// connect returns a client of the admin extension service and the context of its calls, carrying the auth header
+func connect(cmd *cobra.Command) (cli.A1TAdminExtServiceClient, *grpc.ClientConn, context.Context, error) {
+	conn, err := libcli.GetConnection(cmd)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ctx := libcli.NewContextWithAuthHeaderFromFlag(cmd.Context(), cmd.Flags().Lookup(libcli.AuthHeaderFlag))
+	return cli.NewA1TAdminExtServiceClient(conn), conn, ctx, nil
+}
+
+func newWriter() *tabwriter.Writer {
+	writer := new(tabwriter.Writer)
+	writer.Init(libcli.GetOutput(), 0, 0, 3, ' ', tabwriter.FilterHTML)
+	return writer
+}
+
+func noHeaders(cmd *cobra.Command) bool {
+	noHeaders, _ := cmd.Flags().GetBool(noHeadersFlag)
+	return noHeaders
+}
+
+func addNoHeadersFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(noHeadersFlag, false, "disables output headers")
+}
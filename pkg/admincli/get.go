@@ -0,0 +1,555 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package admincli
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/onosproject/onos-a1t/pkg/northbound/cli"
+	"github.com/spf13/cobra"
+)
+
+func getGetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get {history,sessions,circuits,policy-source,owners,schedules,ei-types,ei-jobs,ei-job,inventory,divergence,policy-types,policies,policy-statuses} [args]",
+		Short: "Get A1T resources",
+	}
+	cmd.AddCommand(getGetHistoryCommand())
+	cmd.AddCommand(getGetSessionsCommand())
+	cmd.AddCommand(getGetCircuitsCommand())
+	cmd.AddCommand(getGetPolicySourceCommand())
+	cmd.AddCommand(getGetOwnersCommand())
+	cmd.AddCommand(getGetSchedulesCommand())
+	cmd.AddCommand(getGetEITypesCommand())
+	cmd.AddCommand(getGetEIJobsCommand())
+	cmd.AddCommand(getGetEIJobCommand())
+	cmd.AddCommand(getGetInventoryCommand())
+	cmd.AddCommand(getGetDivergenceCommand())
+	cmd.AddCommand(getGetPolicyTypesCommand())
+	cmd.AddCommand(getGetPoliciesCommand("policies", "Get the policy objects"))
+	cmd.AddCommand(getGetPoliciesCommand("policy-statuses", "Get the policy statuses"))
+	return cmd
+}
+
+func getGetHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [policy-type-id [policy-object-id]]",
+		Short: "Get the audit history of the policies",
+		Args:  cobra.MaximumNArgs(2),
+		RunE:  runGetHistoryCommand,
+	}
+	cmd.Flags().String("since", "", "only the records from this RFC 3339 time")
+	cmd.Flags().String("until", "", "only the records until this RFC 3339 time")
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetHistoryCommand(cmd *cobra.Command, args []string) error {
+	request := &cli.GetPolicyHistoryRequest{}
+	if len(args) > 0 {
+		request.PolicyTypeId = args[0]
+	}
+	if len(args) > 1 {
+		request.PolicyObjectId = args[1]
+	}
+	var err error
+	if request.Since, err = getTimeFlag(cmd, "since"); err != nil {
+		return err
+	}
+	if request.Until, err = getTimeFlag(cmd, "until"); err != nil {
+		return err
+	}
+
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := client.GetPolicyHistory(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Sequence\tTimestamp\tOperation\tPolicy Type ID\tPolicy Object ID\tRevision\tCaller\txApp Outcomes")
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		outcomes := make([]string, 0, len(resp.XappOutcomes))
+		for _, o := range resp.XappOutcomes {
+			outcome := "ok"
+			if !o.Success {
+				outcome = o.Reason
+			}
+			outcomes = append(outcomes, fmt.Sprintf("%s:%s", o.XappId, outcome))
+		}
+		_, _ = fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", resp.Sequence, formatTime(resp.Timestamp), resp.Operation,
+			resp.PolicyTypeId, resp.PolicyObjectId, resp.Revision, resp.Caller, strings.Join(outcomes, ","))
+	}
+	return writer.Flush()
+}
+
+func getGetSessionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sessions [xapp-id]",
+		Short: "Get the southbound sessions of the xApps",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runGetSessionsCommand,
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetSessionsCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.ListXAppSessions(ctx, &cli.ListXAppSessionsRequest{XappId: optionalArg(args, 0)})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "xApp ID\tA1 Service\tEndpoint\tState\tAttempts\tSince\tLast Error")
+	}
+	for _, s := range resp.Sessions {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n", s.XappId, s.A1Service, s.Endpoint, s.State, s.Attempts, formatTime(s.Since), s.LastError)
+	}
+	return writer.Flush()
+}
+
+func getGetCircuitsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuits [xapp-id]",
+		Short: "Get the circuit breakers of the xApps",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runGetCircuitsCommand,
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetCircuitsCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.ListCircuitBreakers(ctx, &cli.ListCircuitBreakersRequest{XappId: optionalArg(args, 0)})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "xApp ID\tState\tConsecutive Failures\tSince\tLast Error")
+	}
+	for _, c := range resp.CircuitBreakers {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%s\n", c.XappId, c.State, c.ConsecutiveFailures, formatTime(c.Since), c.LastError)
+	}
+	return writer.Flush()
+}
+
+func getGetPolicySourceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy-source",
+		Short: "Get the status of the last sync of the policy source",
+		Args:  cobra.NoArgs,
+		RunE:  runGetPolicySourceCommand,
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetPolicySourceCommand(cmd *cobra.Command, _ []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	status, err := client.GetPolicySourceStatus(ctx, &cli.GetPolicySourceStatusRequest{})
+	if err != nil {
+		return err
+	}
+	return printPolicySourceStatus(cmd, status)
+}
+
+func getGetOwnersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owners [policy-type-id]",
+		Short: "Get the owners of the policies",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runGetOwnersCommand,
+	}
+	cmd.Flags().String("owner", "", "only the policies of this owner")
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetOwnersCommand(cmd *cobra.Command, args []string) error {
+	owner, _ := cmd.Flags().GetString("owner")
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.ListPolicyOwners(ctx, &cli.ListPolicyOwnersRequest{PolicyTypeId: optionalArg(args, 0), Owner: owner})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Policy Type ID\tPolicy Object ID\tOwner\tRevision")
+	}
+	for _, p := range resp.Policies {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%d\n", p.PolicyTypeId, p.PolicyObjectId, p.Owner, p.Revision)
+	}
+	return writer.Flush()
+}
+
+func getGetSchedulesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedules [policy-type-id]",
+		Short: "Get the scheduled policies",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runGetSchedulesCommand,
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetSchedulesCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.ListPolicySchedules(ctx, &cli.ListPolicySchedulesRequest{PolicyTypeId: optionalArg(args, 0)})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Policy Type ID\tPolicy Object ID\tOwner\tValid From\tValid Until\tRecurrence\tDeployed\tNext Transition")
+	}
+	for _, p := range resp.Policies {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%t\t%s\n", p.PolicyTypeId, p.PolicyObjectId, p.Owner, formatTime(p.ValidFrom),
+			formatTime(p.ValidUntil), p.Recurrence, p.Deployed, formatTime(p.NextTransition))
+	}
+	return writer.Flush()
+}
+
+func getGetEITypesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ei-types",
+		Short: "Get the EI types consumed by the xApps",
+		Args:  cobra.NoArgs,
+		RunE:  runGetEITypesCommand,
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetEITypesCommand(cmd *cobra.Command, _ []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.ListEITypes(ctx, &cli.ListEITypesRequest{})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "EI Type ID\txApp IDs\tJobs")
+	}
+	for _, t := range resp.EiTypes {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%d\n", t.EiTypeId, strings.Join(t.XappIds, ","), t.Jobs)
+	}
+	return writer.Flush()
+}
+
+func getGetEIJobsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ei-jobs [ei-type-id]",
+		Short: "Get the EI jobs",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runGetEIJobsCommand,
+	}
+	cmd.Flags().String("xapp", "", "only the jobs owned or consumed by this xApp")
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetEIJobsCommand(cmd *cobra.Command, args []string) error {
+	xAppID, _ := cmd.Flags().GetString("xapp")
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.ListEIJobs(ctx, &cli.ListEIJobsRequest{EiTypeId: optionalArg(args, 0), XappId: xAppID})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "EI Job ID\tEI Type ID\tOwner\tConsumers\tStatus\tOrphaned\tLast Result\tLast Delivery Error")
+	}
+	for _, j := range resp.Jobs {
+		printEIJob(writer, j)
+	}
+	return writer.Flush()
+}
+
+func getGetEIJobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ei-job <ei-job-id>",
+		Short: "Get an EI job",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runGetEIJobCommand,
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetEIJobCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.GetEIJob(ctx, &cli.GetEIJobRequest{EiJobId: args[0]})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "EI Job ID\tEI Type ID\tOwner\tConsumers\tStatus\tOrphaned\tLast Result\tLast Delivery Error")
+	}
+	printEIJob(writer, resp.Job)
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(writer, "Job Definition: %s\n", resp.Job.JobDefinition)
+	if resp.Job.LastResult != "" {
+		_, _ = fmt.Fprintf(writer, "Last Result: %s\n", resp.Job.LastResult)
+	}
+	return writer.Flush()
+}
+
+func printEIJob(writer io.Writer, j *cli.EIJob) {
+	_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%t\t%s\t%s\n", j.EiJobId, j.EiTypeId, j.Owner, strings.Join(j.ConsumerXappIds, ","), j.Status,
+		j.Orphaned, formatTime(j.LastResultTimestamp), j.LastDeliveryError)
+}
+
+func getGetInventoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inventory [xapp-id]",
+		Short: "Get the policies held by the xApps",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runGetInventoryCommand,
+	}
+	cmd.Flags().Bool("rescan", false, "scan the xApps before answering")
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetInventoryCommand(cmd *cobra.Command, args []string) error {
+	rescan, _ := cmd.Flags().GetBool("rescan")
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.GetPolicyInventory(ctx, &cli.GetPolicyInventoryRequest{XappId: optionalArg(args, 0), Rescan: rescan})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "xApp ID\tPolicy Type ID\tPolicy Object ID\tScanned\tError")
+	}
+	for _, x := range resp.Xapps {
+		for _, p := range x.Policies {
+			_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t\n", x.XappId, p.PolicyTypeId, p.PolicyObjectId, formatTime(x.Timestamp))
+		}
+		policyTypeIDs := make([]string, 0, len(x.Errors))
+		for policyTypeID := range x.Errors {
+			policyTypeIDs = append(policyTypeIDs, policyTypeID)
+		}
+		sort.Strings(policyTypeIDs)
+		for _, policyTypeID := range policyTypeIDs {
+			_, _ = fmt.Fprintf(writer, "%s\t%s\t\t%s\t%s\n", x.XappId, policyTypeID, formatTime(x.Timestamp), x.Errors[policyTypeID])
+		}
+	}
+	return writer.Flush()
+}
+
+func getGetDivergenceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "divergence [xapp-id]",
+		Short: "Get the policies the xApps miss, hold stale or hold unknown to A1T",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runGetDivergenceCommand,
+	}
+	cmd.Flags().String("kind", "", "only this kind of divergence: Missing, Stale, Unknown or Unreachable")
+	cmd.Flags().Bool("rescan", false, "scan the xApps before answering")
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetDivergenceCommand(cmd *cobra.Command, args []string) error {
+	kind, _ := cmd.Flags().GetString("kind")
+	rescan, _ := cmd.Flags().GetBool("rescan")
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.GetDivergenceReport(ctx, &cli.GetDivergenceReportRequest{XappId: optionalArg(args, 0), Kind: kind, Rescan: rescan})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "xApp ID\tPolicy Type ID\tPolicy Object ID\tKind\tRepaired\tReason")
+	}
+	for _, d := range resp.Divergences {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%t\t%s\n", d.XappId, d.PolicyTypeId, d.PolicyObjectId, d.Kind, d.Repaired, d.Reason)
+	}
+	return writer.Flush()
+}
+
+func getGetPolicyTypesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy-types [policy-type-id]",
+		Short: "Get the policy types and the policies the xApps hold",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runGetPolicyTypesCommand,
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetPolicyTypesCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Policy Type ID\tPolicy Object IDs\tError")
+	}
+	request := &cli.ListPolicyTypeObjectsRequest{PolicyTypeId: optionalArg(args, 0)}
+	for {
+		resp, err := client.ListPolicyTypeObjects(ctx, request)
+		if err != nil {
+			return err
+		}
+		for _, t := range resp.PolicyTypes {
+			_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\n", t.PolicyTypeId, strings.Join(t.PolicyIds, ","), t.Error)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		request.PageToken = resp.NextPageToken
+	}
+	return writer.Flush()
+}
+
+func getGetPoliciesCommand(use, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [policy-type-id [policy-object-id]]",
+		Short: short,
+		Args:  cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGetPoliciesCommand(cmd, args, use == "policy-statuses")
+		},
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runGetPoliciesCommand(cmd *cobra.Command, args []string, statuses bool) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Policy Type ID\tPolicy Object ID\tPolicy Object\tError")
+	}
+	request := &cli.ListPolicyObjectsRequest{PolicyTypeId: optionalArg(args, 0), PolicyObjectId: optionalArg(args, 1)}
+	for {
+		list := client.ListPolicyObjects
+		if statuses {
+			list = client.ListPolicyObjectStatuses
+		}
+		resp, err := list(ctx, request)
+		if err != nil {
+			return err
+		}
+		for _, p := range resp.Policies {
+			_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", p.PolicyTypeId, p.PolicyObjectId, p.PolicyObject, p.Error)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		request.PageToken = resp.NextPageToken
+	}
+	return writer.Flush()
+}
+
+func optionalArg(args []string, i int) string {
+	if len(args) > i {
+		return args[i]
+	}
+	return ""
+}
+
+func getTimeFlag(cmd *cobra.Command, name string) (time.Time, error) {
+	value, _ := cmd.Flags().GetString(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s: %v", name, err)
+	}
+	return t, nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
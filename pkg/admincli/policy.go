@@ -0,0 +1,290 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package admincli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/onosproject/onos-a1t/pkg/northbound/cli"
+	libcli "github.com/onosproject/onos-lib-go/pkg/cli"
+	"github.com/spf13/cobra"
+)
+
+// bulkOperation is an operation of the file given to the bulk command
+type bulkOperation struct {
+	Action                  string          `json:"action"`
+	PolicyTypeID            string          `json:"policyTypeId"`
+	PolicyObjectID          string          `json:"policyObjectId"`
+	NotificationDestination string          `json:"notificationDestination,omitempty"`
+	PolicyObject            json.RawMessage `json:"policyObject,omitempty"`
+}
+
+func getSetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set {policy} [args]",
+		Short: "Set A1T resources",
+	}
+	cmd.AddCommand(getSetPolicyCommand())
+	return cmd
+}
+
+func getSetPolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy <policy-type-id> <policy-object-id> <policy-object-file>",
+		Short: "Create or update a policy object",
+		Args:  cobra.ExactArgs(3),
+		RunE:  runSetPolicyCommand,
+	}
+	cmd.Flags().String("notification-destination", "", "the URL the policy status notifications are sent to")
+	cmd.Flags().StringSlice("if-match", nil, "only if the policy has one of these ETags")
+	cmd.Flags().StringSlice("if-none-match", nil, "only if the policy has none of these ETags, or does not exist for *")
+	return cmd
+}
+
+func runSetPolicyCommand(cmd *cobra.Command, args []string) error {
+	policyObject, err := os.ReadFile(args[2])
+	if err != nil {
+		return err
+	}
+	notificationDestination, _ := cmd.Flags().GetString("notification-destination")
+	ifMatch, _ := cmd.Flags().GetStringSlice("if-match")
+	ifNoneMatch, _ := cmd.Flags().GetStringSlice("if-none-match")
+
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.PutPolicyObject(ctx, &cli.PutPolicyObjectRequest{
+		PolicyTypeId:            args[0],
+		PolicyObjectId:          args[1],
+		PolicyObject:            string(policyObject),
+		NotificationDestination: notificationDestination,
+		IfMatch:                 ifMatch,
+		IfNoneMatch:             ifNoneMatch,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Created {
+		libcli.Output("Policy %s/%s created (ETag %s)\n", args[0], args[1], resp.Etag)
+	} else {
+		libcli.Output("Policy %s/%s updated (ETag %s)\n", args[0], args[1], resp.Etag)
+	}
+	return nil
+}
+
+func getDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete {policy,ei-job} [args]",
+		Short: "Delete A1T resources",
+	}
+	cmd.AddCommand(getDeletePolicyCommand())
+	cmd.AddCommand(getDeleteEIJobCommand())
+	return cmd
+}
+
+func getDeletePolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy <policy-type-id> <policy-object-id>",
+		Short: "Delete a policy object",
+		Args:  cobra.ExactArgs(2),
+		RunE:  runDeletePolicyCommand,
+	}
+	cmd.Flags().StringSlice("if-match", nil, "only if the policy has one of these ETags")
+	return cmd
+}
+
+func runDeletePolicyCommand(cmd *cobra.Command, args []string) error {
+	ifMatch, _ := cmd.Flags().GetStringSlice("if-match")
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = client.DeletePolicyObject(ctx, &cli.DeletePolicyObjectRequest{PolicyTypeId: args[0], PolicyObjectId: args[1], IfMatch: ifMatch})
+	if err != nil {
+		return err
+	}
+	libcli.Output("Policy %s/%s deleted\n", args[0], args[1])
+	return nil
+}
+
+func getDeleteEIJobCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "ei-job <ei-job-id>",
+		Short: "Force the deletion of an EI job",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runDeleteEIJobCommand,
+	}
+}
+
+func runDeleteEIJobCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.ForceDeleteEIJob(ctx, &cli.ForceDeleteEIJobRequest{EiJobId: args[0]})
+	if err != nil {
+		return err
+	}
+	if resp.RemoteDeleted {
+		libcli.Output("EI job %s deleted\n", resp.EiJobId)
+	} else {
+		libcli.Output("EI job %s deleted from A1T only\n", resp.EiJobId)
+	}
+	return nil
+}
+
+func getRollbackCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback {policy} [args]",
+		Short: "Roll back A1T resources",
+	}
+	cmd.AddCommand(getRollbackPolicyCommand())
+	return cmd
+}
+
+func getRollbackPolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy [policy-type-id [policy-object-id]]",
+		Short: "Roll back the policies to a revision or a point in time",
+		Args:  cobra.MaximumNArgs(2),
+		RunE:  runRollbackPolicyCommand,
+	}
+	cmd.Flags().Uint64("revision", 0, "the revision of the policy to restore")
+	cmd.Flags().String("timestamp", "", "the RFC 3339 time of the policies to restore")
+	cmd.Flags().Bool("dry-run", false, "only show the changes")
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runRollbackPolicyCommand(cmd *cobra.Command, args []string) error {
+	request := &cli.RollbackPolicyRequest{PolicyTypeId: optionalArg(args, 0), PolicyObjectId: optionalArg(args, 1)}
+	request.Revision, _ = cmd.Flags().GetUint64("revision")
+	request.DryRun, _ = cmd.Flags().GetBool("dry-run")
+	var err error
+	if request.Timestamp, err = getTimeFlag(cmd, "timestamp"); err != nil {
+		return err
+	}
+
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.RollbackPolicy(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Policy Type ID\tPolicy Object ID\tAction\tFrom Revision\tTo Revision\tChanged Fields\tSuccess\tReason")
+	}
+	for _, r := range resp.Rollbacks {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%d\t%d\t%s\t%t\t%s\n", r.PolicyTypeId, r.PolicyObjectId, r.Action, r.FromRevision, r.ToRevision,
+			strings.Join(r.ChangedFields, ","), r.Success, r.Reason)
+	}
+	return writer.Flush()
+}
+
+func getTransferCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer {policy} [args]",
+		Short: "Transfer the ownership of A1T resources",
+	}
+	cmd.AddCommand(getTransferPolicyCommand())
+	return cmd
+}
+
+func getTransferPolicyCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "policy <policy-type-id> <policy-object-id> <owner>",
+		Short: "Transfer the ownership of a policy object",
+		Args:  cobra.ExactArgs(3),
+		RunE:  runTransferPolicyCommand,
+	}
+}
+
+func runTransferPolicyCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.TransferPolicyOwnership(ctx, &cli.TransferPolicyOwnershipRequest{PolicyTypeId: args[0], PolicyObjectId: args[1], Owner: args[2]})
+	if err != nil {
+		return err
+	}
+	libcli.Output("Policy %s/%s transferred to %s (revision %d)\n", resp.Policy.PolicyTypeId, resp.Policy.PolicyObjectId, resp.Policy.Owner, resp.Policy.Revision)
+	return nil
+}
+
+func getBulkCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk <operations-file>",
+		Short: "Apply a JSON list of policy operations",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runBulkCommand,
+	}
+	cmd.Flags().Bool("atomic", false, "undo the applied operations when one fails")
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runBulkCommand(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var operations []bulkOperation
+	if err := json.Unmarshal(data, &operations); err != nil {
+		return fmt.Errorf("invalid operations file %s: %v", args[0], err)
+	}
+	atomic, _ := cmd.Flags().GetBool("atomic")
+	request := &cli.BulkPolicyOperationsRequest{
+		Operations: make([]*cli.BulkPolicyOperation, 0, len(operations)),
+		Atomic:     atomic,
+	}
+	for _, op := range operations {
+		request.Operations = append(request.Operations, &cli.BulkPolicyOperation{
+			Action:                  op.Action,
+			PolicyTypeId:            op.PolicyTypeID,
+			PolicyObjectId:          op.PolicyObjectID,
+			NotificationDestination: op.NotificationDestination,
+			PolicyObject:            string(op.PolicyObject),
+		})
+	}
+
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.BulkPolicyOperations(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Index\tAction\tPolicy Type ID\tPolicy Object ID\tApplied\tCompensated\tReason")
+	}
+	for _, r := range resp.Results {
+		_, _ = fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%t\t%t\t%s\n", r.Index, r.Action, r.PolicyTypeId, r.PolicyObjectId, r.Applied, r.Compensated, r.Reason)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("bulk operations failed: %s", resp.Reason)
+	}
+	return nil
+}
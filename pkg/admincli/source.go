@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package admincli
+
+import (
+	"fmt"
+
+	"github.com/onosproject/onos-a1t/pkg/northbound/cli"
+	libcli "github.com/onosproject/onos-lib-go/pkg/cli"
+	"github.com/spf13/cobra"
+)
+
+func getSyncCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync {policy-source} [args]",
+		Short: "Sync A1T with its sources",
+	}
+	cmd.AddCommand(getSyncPolicySourceCommand())
+	return cmd
+}
+
+func getSyncPolicySourceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy-source",
+		Short: "Sync the policies with the policy source",
+		Args:  cobra.NoArgs,
+		RunE:  runSyncPolicySourceCommand,
+	}
+	cmd.Flags().Bool("dry-run", false, "only show the changes")
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runSyncPolicySourceCommand(cmd *cobra.Command, _ []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	status, err := client.SyncPolicySource(ctx, &cli.SyncPolicySourceRequest{DryRun: dryRun})
+	if err != nil {
+		return err
+	}
+	return printPolicySourceStatus(cmd, status)
+}
+
+func printPolicySourceStatus(cmd *cobra.Command, status *cli.PolicySourceStatus) error {
+	libcli.Output("Synced: %s\nDigest: %s\nDry Run: %t\nManifests: %d\n", formatTime(status.Timestamp), status.Digest, status.DryRun, status.Manifests)
+	for _, e := range status.Errors {
+		libcli.Output("Error: %s\n", e)
+	}
+	libcli.Output("\n")
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Kind\tAction\tPolicy Type ID\tPolicy Object ID\tFile\tApplied\tReason")
+	}
+	for _, c := range status.Changes {
+		_, _ = fmt.Fprintf(writer, "Change\t%s\t%s\t%s\t%s\t%t\t%s\n", c.Action, c.PolicyTypeId, c.PolicyObjectId, c.File, c.Applied, c.Reason)
+	}
+	for _, c := range status.Conflicts {
+		_, _ = fmt.Fprintf(writer, "Conflict\t\t%s\t%s\t%s\tfalse\t%s (owner %s)\n", c.PolicyTypeId, c.PolicyObjectId, c.File, c.Reason, c.Owner)
+	}
+	for _, d := range status.Drift {
+		action := "Detect"
+		if d.Preexisting {
+			action = "Preexisting"
+		}
+		_, _ = fmt.Fprintf(writer, "Drift\t%s\t%s\t%s\t\t%t\t%s\n", action, d.PolicyTypeId, d.PolicyObjectId, d.Corrected, d.Reason)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package admincli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/onosproject/onos-a1t/pkg/northbound/cli"
+	"github.com/spf13/cobra"
+)
+
+func getWatchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch {circuits,policy-status} [args]",
+		Short: "Watch A1T resources",
+	}
+	cmd.AddCommand(getWatchCircuitsCommand())
+	cmd.AddCommand(getWatchPolicyStatusCommand())
+	return cmd
+}
+
+func getWatchCircuitsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuits [xapp-id]",
+		Short: "Watch the state changes of the circuit breakers",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runWatchCircuitsCommand,
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runWatchCircuitsCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := client.WatchCircuitBreakers(ctx, &cli.WatchCircuitBreakersRequest{XappId: optionalArg(args, 0)})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Timestamp\txApp ID\tFrom State\tTo State\tReason")
+		_ = writer.Flush()
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", formatTime(resp.Timestamp), resp.XappId, resp.FromState, resp.ToState, resp.Reason)
+		_ = writer.Flush()
+	}
+}
+
+func getWatchPolicyStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy-status [policy-type-id [policy-object-id]]",
+		Short: "Watch the status notifications of the policies",
+		Args:  cobra.MaximumNArgs(2),
+		RunE:  runWatchPolicyStatusCommand,
+	}
+	cmd.Flags().String("xapp", "", "only the statuses reported by this xApp")
+	cmd.Flags().Bool("snapshot", false, "start with the last known statuses")
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runWatchPolicyStatusCommand(cmd *cobra.Command, args []string) error {
+	xAppID, _ := cmd.Flags().GetString("xapp")
+	snapshot, _ := cmd.Flags().GetBool("snapshot")
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stream, err := client.WatchPolicyObjectStatus(ctx, &cli.WatchPolicyObjectStatusRequest{
+		PolicyTypeId:   optionalArg(args, 0),
+		PolicyObjectId: optionalArg(args, 1),
+		XappId:         xAppID,
+		Snapshot:       snapshot,
+	})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Timestamp\tPolicy Type ID\tPolicy Object ID\txApp ID\tStatus")
+		_ = writer.Flush()
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", formatTime(resp.Timestamp), resp.PolicyTypeId, resp.PolicyObjectId, resp.XappId, resp.PolicyObjectStatus)
+		_ = writer.Flush()
+	}
+}
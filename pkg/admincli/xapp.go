@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package admincli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onosproject/onos-a1t/pkg/northbound/cli"
+	libcli "github.com/onosproject/onos-lib-go/pkg/cli"
+	"github.com/spf13/cobra"
+)
+
+func getReconcileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile <xapp-id>",
+		Short: "Re-send the policies of A1T to an xApp",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runReconcileCommand,
+	}
+	addNoHeadersFlag(cmd)
+	return cmd
+}
+
+func runReconcileCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.ReconcileXApp(ctx, &cli.ReconcileXAppRequest{XappId: args[0]})
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if !noHeaders(cmd) {
+		_, _ = fmt.Fprintln(writer, "Policy Type ID\tPolicy Object ID\tAction\tSuccess\tReason")
+	}
+	for _, p := range resp.Policies {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%t\t%s\n", p.PolicyTypeId, p.PolicyObjectId, p.Action, p.Success, p.Reason)
+	}
+	return writer.Flush()
+}
+
+func getDisconnectCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "disconnect <xapp-id>",
+		Short: "Close the southbound sessions of an xApp",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runDisconnectCommand,
+	}
+}
+
+func runDisconnectCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := client.DisconnectXApp(ctx, &cli.DisconnectXAppRequest{XappId: args[0]}); err != nil {
+		return err
+	}
+	libcli.Output("xApp %s disconnected\n", args[0])
+	return nil
+}
+
+func getReconnectCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reconnect <xapp-id>",
+		Short: "Re-open the southbound sessions of an xApp",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runReconnectCommand,
+	}
+}
+
+func runReconnectCommand(cmd *cobra.Command, args []string) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := client.ReconnectXApp(ctx, &cli.ReconnectXAppRequest{XappId: args[0]}); err != nil {
+		return err
+	}
+	libcli.Output("xApp %s reconnected\n", args[0])
+	return nil
+}
+
+func getDrainCommand(drained bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain <xapp-id>",
+		Short: "Stop sending new policies to an xApp",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDrainCommand(cmd, args, drained)
+		},
+	}
+	if !drained {
+		cmd.Use = "undrain <xapp-id>"
+		cmd.Short = "Resume sending new policies to an xApp"
+	}
+	return cmd
+}
+
+func runDrainCommand(cmd *cobra.Command, args []string, drained bool) error {
+	client, conn, ctx, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	resp, err := client.DrainXApp(ctx, &cli.DrainXAppRequest{XappId: args[0], Drained: drained})
+	if err != nil {
+		return err
+	}
+	libcli.Output("Drained xApps: %s\n", strings.Join(resp.DrainedXappIds, ","))
+	return nil
+}
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+)
+
+var log = logging.GetLogger()
+
+const DefaultRetention = 1000
+
+type Operation int
+
+const (
+	PolicyCreate Operation = iota
+	PolicyUpdate
+	PolicyDelete
+	PolicyRollback
+	PolicyTransfer
+	PolicyActivate
+	PolicyDeactivate
+	PolicyExpire
+	PolicyReconcile
+	XAppDisconnect
+	XAppReconnect
+	XAppDrain
+	XAppUndrain
+)
+
+func (o Operation) String() string {
+	return [...]string{"PolicyCreate", "PolicyUpdate", "PolicyDelete", "PolicyRollback", "PolicyTransfer", "PolicyActivate", "PolicyDeactivate", "PolicyExpire", "PolicyReconcile",
+		"XAppDisconnect", "XAppReconnect", "XAppDrain", "XAppUndrain"}[o]
+}
+
+// XAppOutcome is the result of a policy operation on a single target xApp
+type XAppOutcome struct {
+	XAppID  string
+	Success bool
+	Reason  string
+}
+
+// Record is a single entry of the policy audit trail
+type Record struct {
+	Sequence     uint64
+	Timestamp    time.Time
+	Operation    Operation
+	PolicyTypeID string
+	PolicyID     string
+	Caller       string
+	RequestID    string
+	Revision     uint64
+	OldBody      map[string]interface{}
+	NewBody      map[string]interface{}
+	TargetXApps  []string
+	Outcomes     []*XAppOutcome
+	mu           sync.Mutex
+}
+
+func NewRecord(operation Operation, policyTypeID, policyID, caller, requestID string) *Record {
+	return &Record{
+		Timestamp:    time.Now(),
+		Operation:    operation,
+		PolicyTypeID: policyTypeID,
+		PolicyID:     policyID,
+		Caller:       caller,
+		RequestID:    requestID,
+		TargetXApps:  make([]string, 0),
+		Outcomes:     make([]*XAppOutcome, 0),
+	}
+}
+
+// SetTargetXApps sets the xApps the operation is going to be fanned out to
+func (r *Record) SetTargetXApps(xAppIDs []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.TargetXApps = append(make([]string, 0, len(xAppIDs)), xAppIDs...)
+}
+
+// AddOutcome adds the result of the operation for the given xApp
+func (r *Record) AddOutcome(xAppID string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	outcome := &XAppOutcome{
+		XAppID:  xAppID,
+		Success: err == nil,
+	}
+	if err != nil {
+		outcome.Reason = err.Error()
+	}
+	r.Outcomes = append(r.Outcomes, outcome)
+}
+
+// Success returns true if the operation succeeded on every target xApp
+func (r *Record) Success() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, o := range r.Outcomes {
+		if !o.Success {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Record) clone() *Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := &Record{
+		Sequence:     r.Sequence,
+		Timestamp:    r.Timestamp,
+		Operation:    r.Operation,
+		PolicyTypeID: r.PolicyTypeID,
+		PolicyID:     r.PolicyID,
+		Caller:       r.Caller,
+		RequestID:    r.RequestID,
+		Revision:     r.Revision,
+		OldBody:      r.OldBody,
+		NewBody:      r.NewBody,
+		TargetXApps:  append(make([]string, 0, len(r.TargetXApps)), r.TargetXApps...),
+		Outcomes:     make([]*XAppOutcome, 0, len(r.Outcomes)),
+	}
+	for _, o := range r.Outcomes {
+		oc := *o
+		c.Outcomes = append(c.Outcomes, &oc)
+	}
+	return c
+}
+
+// Filter selects audit records; empty fields match everything
+type Filter struct {
+	PolicyTypeID string
+	PolicyID     string
+	Since        time.Time
+	Until        time.Time
+}
+
+func (f Filter) match(r *Record) bool {
+	if f.PolicyTypeID != "" && f.PolicyTypeID != r.PolicyTypeID {
+		return false
+	}
+	if f.PolicyID != "" && f.PolicyID != r.PolicyID {
+		return false
+	}
+	if !f.Since.IsZero() && r.Timestamp.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && r.Timestamp.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+type Log interface {
+	// Append appends the record to the audit log
+	Append(ctx context.Context, record *Record) error
+
+	// Query returns the records matching the filter, oldest first
+	Query(ctx context.Context, filter Filter) []*Record
+}
+
+// NewLog creates an in-memory audit log keeping at most retention records
+func NewLog(retention int) Log {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return &auditLog{
+		retention: retention,
+		records:   make([]*Record, 0),
+	}
+}
+
+type auditLog struct {
+	retention int
+	records   []*Record
+	sequence  uint64
+	mu        sync.RWMutex
+}
+
+func (l *auditLog) Append(ctx context.Context, record *Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sequence++
+	record.Sequence = l.sequence
+	log.Infof("Audit record %d: %v policy %v of type %v by %v (request %v)", record.Sequence, record.Operation,
+		record.PolicyID, record.PolicyTypeID, record.Caller, record.RequestID)
+	l.records = append(l.records, record.clone())
+	if len(l.records) > l.retention {
+		l.records = l.records[len(l.records)-l.retention:]
+	}
+	return nil
+}
+
+func (l *auditLog) Query(ctx context.Context, filter Filter) []*Record {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	results := make([]*Record, 0)
+	for _, r := range l.records {
+		if filter.match(r) {
+			results = append(results, r.clone())
+		}
+	}
+	return results
+}
+
+type recordKey struct{}
+
+type callerKey struct{}
+
+type caller struct {
+	identity  string
+	requestID string
+}
+
+// WithCaller returns a context carrying the identity of the caller and the ID of its request
+func WithCaller(ctx context.Context, identity string, requestID string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller{identity: identity, requestID: requestID})
+}
+
+// CallerFromContext returns the caller identity and request ID carried by the context
+func CallerFromContext(ctx context.Context) (string, string) {
+	c, ok := ctx.Value(callerKey{}).(caller)
+	if !ok {
+		return "", ""
+	}
+	return c.identity, c.requestID
+}
+
+// NewContext returns a context carrying the record, so that the controller can fill in per-xApp outcomes
+func NewContext(ctx context.Context, record *Record) context.Context {
+	return context.WithValue(ctx, recordKey{}, record)
+}
+
+// FromContext returns the record carried by the context, if any
+func FromContext(ctx context.Context) (*Record, bool) {
+	record, ok := ctx.Value(recordKey{}).(*Record)
+	return record, ok
+}
+
+var _ Log = &auditLog{}
@@ -7,30 +7,37 @@ package controller
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/onosproject/onos-a1t/pkg/dme"
 	"github.com/onosproject/onos-a1t/pkg/rnib"
 	"github.com/onosproject/onos-a1t/pkg/store"
 	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
-
-	a1einbi "github.com/onosproject/onos-a1t/pkg/northbound/a1ap/enrichment_information"
 )
 
 var log = logging.GetLogger()
 
-func NewA1EIController(nonRTRICURL string, subscriptionStore store.Store, eijobsStore store.Store, rnibClient rnib.TopoClient, streamBroker stream.Broker) A1EIController {
-	nbiClient, err := a1einbi.NewClientWithResponses(nonRTRICURL)
-	if err != nil {
-		log.Fatal(err)
-	}
+// statusNotificationTimeout bounds the notification of a job status to the URI given by its consumer
+const statusNotificationTimeout = 5 * time.Second
 
+// NewA1EIController returns the controller of the information jobs: the legacy A1-EI operations and the EI SBI of the
+// xApps are served by the A1-DME API of the Non-RT RIC
+func NewA1EIController(nonRTRICURL string, subscriptionStore store.Store, eijobsStore store.Store, rnibClient rnib.TopoClient, streamBroker stream.Broker, config Config) A1EIController {
 	return &a1eiController{
 		nonRTRICURL:       nonRTRICURL,
 		eijobsStore:       eijobsStore,
 		subscriptionStore: subscriptionStore,
 		rnibClient:        rnibClient,
-		nbiClient:         nbiClient,
+		dmeClient:         dme.NewClient(nonRTRICURL),
 		streamBroker:      streamBroker,
+		requester:         stream.NewRequester(streamBroker, TimeoutTimer),
+		callbackURL:       strings.TrimSuffix(config.DMECallbackURL, "/"),
+		httpClient:        &http.Client{Timeout: statusNotificationTimeout},
 	}
 }
 
@@ -40,6 +47,19 @@ type A1EIController interface {
 	HandleEIJobDelete(ctx context.Context, eiJobID string) error
 	HandleEIJobNotify(ctx context.Context, eiJobID string, eiJobObject map[string]interface{}) error
 	HandleGetEIJobStatus(ctx context.Context, eiJobID string) (string, error)
+	HandleGetInfoTypes(ctx context.Context) ([]string, error)
+	HandleGetInfoType(ctx context.Context, infoTypeID string) (dme.InfoTypeObject, error)
+	HandleGetInfoJobs(ctx context.Context, infoTypeID, owner string) []string
+	HandleGetInfoJob(ctx context.Context, infoJobID string) (*dme.InfoJobObject, error)
+	HandleInfoJobPut(ctx context.Context, infoJobID string, job *dme.InfoJobObject) (bool, error)
+	HandleInfoJobDelete(ctx context.Context, infoJobID string) error
+	HandleGetInfoJobStatus(ctx context.Context, infoJobID string) (*dme.InfoJobStatusObject, error)
+	HandleInfoJobResult(ctx context.Context, infoJobID string, result []byte) error
+	HandleInfoJobStatusNotify(ctx context.Context, infoJobID string, status *dme.InfoJobStatusObject) error
+	HandleGetEITypeCatalogue(ctx context.Context) []*EITypeInfo
+	HandleGetEITypeInfo(ctx context.Context, eiTypeID string) (*EITypeInfo, error)
+	HandleListInfoJobs(ctx context.Context, infoTypeID, xAppID string) []*InfoJobInfo
+	HandleForceDeleteInfoJob(ctx context.Context, infoJobID string) (bool, error)
 	Receiver(ctx context.Context) error
 }
 
@@ -48,49 +68,57 @@ type a1eiController struct {
 	eijobsStore       store.Store
 	subscriptionStore store.Store
 	rnibClient        rnib.TopoClient
-	nbiClient         a1einbi.ClientWithResponsesInterface
+	dmeClient         dme.Client
 	streamBroker      stream.Broker
-}
-
-func (a1ei *a1eiController) Receiver(ctx context.Context) error {
-	panic("implement me")
+	requester         stream.Requester
+	// callbackURL is the URL at which the Non-RT RIC reaches A1T, e.g. http://onos-a1t:9639
+	callbackURL string
+	// httpClient notifies the job status to the URIs given by the consumers
+	httpClient *http.Client
+	// jobsMu serializes the changes of the information jobs
+	jobsMu sync.Mutex
 }
 
 func (a1ei *a1eiController) HandleGetEIJobTypes(ctx context.Context) (*[]string, error) {
-	resp, err := a1ei.nbiClient.GetEiTypeIdentifiersUsingGETWithResponse(ctx)
+	infoTypeIDs, err := a1ei.HandleGetInfoTypes(ctx)
 	if err != nil {
 		return &[]string{}, err
 	}
-
-	return resp.JSON200, nil
+	return &infoTypeIDs, nil
 }
 
 func (a1ei *a1eiController) HandleEIJobCreate(ctx context.Context, eiTypeID, eiJobID string) error {
-	eiJobObj := a1einbi.EiJobObject{
-		EiTypeId: eiTypeID,
-	}
-	eiJobObjPUT := a1einbi.PutIndividualEiJobUsingPUTJSONRequestBody(a1einbi.PutIndividualEiJobUsingPUTJSONBody(eiJobObj))
-
-	resp, err := a1ei.nbiClient.PutIndividualEiJobUsingPUTWithResponse(ctx, eiJobID, eiJobObjPUT)
-	if err != nil {
-		return err
-	}
-
-	if resp.JSON404 != nil {
-		return fmt.Errorf("%d", resp.JSON404.Status)
-
-	}
-	return nil
+	_, err := a1ei.HandleInfoJobPut(ctx, eiJobID, &dme.InfoJobObject{
+		InfoTypeID:    eiTypeID,
+		JobDefinition: make(map[string]interface{}),
+	})
+	return err
 }
+
 func (a1ei *a1eiController) HandleEIJobDelete(ctx context.Context, eiJobID string) error {
-	return nil
+	return a1ei.HandleInfoJobDelete(ctx, eiJobID)
 }
+
+// HandleEIJobNotify handles a status notification sent to the legacy A1-EI notification endpoint
 func (a1ei *a1eiController) HandleEIJobNotify(ctx context.Context, eiJobID string, eiJobObject map[string]interface{}) error {
-	//TODO: For all xApps waiting for eiJob Notification send EIJobStatusNotify
-	return nil
+	status, ok := eiJobObject["eiJobStatus"].(string)
+	if !ok {
+		return errors.NewInvalid("EI job status notification %v has no eiJobStatus", eiJobObject)
+	}
+	return a1ei.HandleInfoJobStatusNotify(ctx, eiJobID, &dme.InfoJobStatusObject{InfoJobStatus: status})
 }
+
 func (a1ei *a1eiController) HandleGetEIJobStatus(ctx context.Context, eiJobID string) (string, error) {
-	return "", nil
+	status, err := a1ei.HandleGetInfoJobStatus(ctx, eiJobID)
+	if err != nil {
+		return "", err
+	}
+	return status.InfoJobStatus, nil
+}
+
+// callbackURI returns the URI of the A1T endpoint of the job receiving the callbacks of the Non-RT RIC
+func (a1ei *a1eiController) callbackURI(infoJobID, endpoint string) string {
+	return fmt.Sprintf("%s%s/info-jobs/%s/%s", a1ei.callbackURL, dme.BasePath, infoJobID, endpoint)
 }
 
 /*
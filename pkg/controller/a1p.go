@@ -9,10 +9,13 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	policyschemas "github.com/onosproject/onos-a1-dm/go/policy_schemas"
 	policystatusv2 "github.com/onosproject/onos-a1-dm/go/policy_status/v2"
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/policytype"
 	"github.com/onosproject/onos-a1t/pkg/rnib"
 	"github.com/onosproject/onos-a1t/pkg/store"
 	"github.com/onosproject/onos-a1t/pkg/stream"
@@ -21,11 +24,24 @@ import (
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 )
 
-func NewA1PController(subscriptionStore store.Store, rnibClient rnib.TopoClient, streamBroker stream.Broker) A1PController {
+func NewA1PController(subscriptionStore store.Store, policyIntentStore store.Store, rnibClient rnib.TopoClient, streamBroker stream.Broker, auditLog audit.Log, config Config) A1PController {
 	return &a1pController{
 		subscriptionStore: subscriptionStore,
+		policyIntentStore: policyIntentStore,
 		rnibClient:        rnibClient,
 		streamBroker:      streamBroker,
+		requester:         stream.NewRequester(streamBroker, TimeoutTimer),
+		auditLog:          auditLog,
+		circuitBreakers:   newCircuitBreakers(config.CircuitBreaker),
+		policyStatuses:    newPolicyStatuses(config.StatusHistorySize),
+		statusCacheTTL:    config.StatusCacheTTL,
+		policyQuota:       config.PolicyQuota,
+		requireTenant:     config.RequireTenant,
+		policyTypes:       config.PolicyTypes,
+		conflictDetector:  NewScopeConflictDetector(config.PolicyTypes),
+		policySchedules:   newPolicySchedules(policyIntentStore),
+		policyLocks:       newPolicyLocks(),
+		drainedXApps:      newDrainedXApps(),
 	}
 }
 
@@ -33,24 +49,97 @@ type A1PController interface {
 	HandlePolicyCreate(ctx context.Context, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) error
 	HandlePolicyDelete(ctx context.Context, policyID, policyTypeID string) error
 	HandlePolicyUpdate(ctx context.Context, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) error
+	HandlePolicyPut(ctx context.Context, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) (bool, string, error)
+	HandleGetPolicyETag(ctx context.Context, policyID, policyTypeID string) (string, error)
 	HandleGetPolicyTypes(ctx context.Context) []string
 	HandleGetPolicytypesPolicyTypeId(ctx context.Context, policyTypeID string) (map[string]interface{}, map[string]interface{}, error)
 	HandleGetPolicytypesPolicyTypeIdPolicies(ctx context.Context, policyTypeID string) ([]string, error)
 	HandleGetPolicy(ctx context.Context, policyID, policyTypeID string) (map[string]interface{}, error)
 	HandleGetPolicyStatus(ctx context.Context, policyID, policyTypeID string) (map[string]interface{}, error)
+	HandleGetAggregatedPolicyStatus(ctx context.Context, policyID, policyTypeID string) (*AggregatedPolicyStatus, error)
+	HandleQueryXApps(ctx context.Context, policyID, policyTypeID string, payloadType a1.PayloadType) ([]*XAppQueryResult, error)
+	HandlePolicyRollback(ctx context.Context, policyTypeID, policyID string, revision uint64, timestamp time.Time, dryRun bool) ([]*PolicyRollback, error)
+	HandlePolicyBulk(ctx context.Context, operations []BulkOperation, atomic bool) ([]*BulkResult, error)
+	HandleListPolicyOwners(ctx context.Context, policyTypeID, owner string) []*PolicyOwnership
+	HandlePolicyTransfer(ctx context.Context, policyTypeID, policyID, owner string) (*PolicyOwnership, error)
+	HandleListPolicySchedules(ctx context.Context, policyTypeID string) []*PolicyScheduleStatus
+	HandleReconcileXApp(ctx context.Context, xAppID string) ([]*ReconcileResult, error)
+	HandleDrainXApp(ctx context.Context, xAppID string, drained bool) error
+	DrainedXApps() []string
+	CircuitBreakers() []CircuitStatus
+	WatchCircuitBreakers(ctx context.Context, ch chan<- CircuitEvent) error
+	WatchPolicyStatus(ctx context.Context, options PolicyStatusWatchOptions, ch chan<- PolicyStatusEvent) error
 	Receiver(ctx context.Context) error
 }
 
 type a1pController struct {
 	subscriptionStore store.Store
+	policyIntentStore store.Store
 	rnibClient        rnib.TopoClient
 	streamBroker      stream.Broker
+	requester         stream.Requester
+	auditLog          audit.Log
+	circuitBreakers   *circuitBreakers
+	policyStatuses    *policyStatuses
+	statusCacheTTL    time.Duration
+	policyQuota       int
+	requireTenant     bool
+	policyTypes       policytype.Registry
+	conflictDetector  ConflictDetector
+	policySchedules   *policySchedules
+	policyLocks       *policyLocks
+	drainedXApps      *drainedXApps
+}
+
+func (a *a1pController) CircuitBreakers() []CircuitStatus {
+	return a.circuitBreakers.list()
+}
+
+func (a *a1pController) WatchCircuitBreakers(ctx context.Context, ch chan<- CircuitEvent) error {
+	a.circuitBreakers.watch(ctx, ch)
+	return nil
+}
+
+func (a *a1pController) WatchPolicyStatus(ctx context.Context, options PolicyStatusWatchOptions, ch chan<- PolicyStatusEvent) error {
+	owner, ok, err := a.callerOwner(ctx)
+	if err != nil {
+		return err
+	}
+	var allowed func(PolicyStatusEvent) bool
+	if ok && owner != "" {
+		// the owner is checked when each event is sent, as the ownership of a policy may be transferred
+		allowed = func(event PolicyStatusEvent) bool {
+			return a.isOwner(ctx, event.PolicyTypeID, event.PolicyID, owner)
+		}
+	}
+	a.policyStatuses.watch(ctx, options, allowed, ch)
+	return nil
 }
 
 func (a *a1pController) Receiver(ctx context.Context) error {
+	a.policySchedules.load(ctx)
+	go a.runScheduler(ctx)
+	a.watchStreams(ctx)
 	return a.watchSubStore(ctx)
 }
 
+// watchStreams watches the northbound stream of an xApp again whenever the southbound sets it up again, e.g. after an
+// operator reconnected the xApp, since closing the stream dropped the watcher
+func (a *a1pController) watchStreams(ctx context.Context) {
+	ch := make(chan stream.StreamEvent)
+	a.streamBroker.WatchStreams(ctx, ch)
+	go func() {
+		for e := range ch {
+			if e.Type != stream.StreamAdded || e.ID.DestEndpointID != stream.A1PController {
+				continue
+			}
+			if err := a.watchStream(ctx, e.ID); err != nil {
+				log.Warn(err)
+			}
+		}
+	}()
+}
+
 func (a *a1pController) watchSubStore(ctx context.Context) error {
 	log.Info("Start watching subscription store at a1p controller")
 	ch := make(chan store.Event)
@@ -87,28 +176,38 @@ func (a *a1pController) createEventSubStoreHandler(ctx context.Context, entry *s
 	log.Infof("Subscription store entry %v was just created or updated", *entry)
 	key := entry.Key.(store.SubscriptionKey)
 	targetXAppID := string(key.TargetXAppID)
-	msgCh := make(chan *stream.SBStreamMessage)
 	sbID, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(targetXAppID, stream.PolicyManagement))
 	a.streamBroker.AddStream(ctx, nbID)
 	a.streamBroker.AddStream(ctx, sbID)
+	if err := a.watchStream(ctx, nbID); err != nil {
+		log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// watchStream dispatches the messages the xApp sends on the northbound stream until the stream is closed; a stream
+// already watched is left alone
+func (a *a1pController) watchStream(ctx context.Context, nbID stream.ID) error {
+	msgCh := make(chan *stream.SBStreamMessage)
+	watcherID := stream.GetWatcherID(stream.A1PController, nbID)
+	err := a.streamBroker.Watch(nbID, msgCh, watcherID)
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("New watcher %v added", watcherID)
 
-	go func(msgCh chan *stream.SBStreamMessage) {
+	go func() {
 		for msg := range msgCh {
 			err := a.dispatchReceivedMsg(ctx, msg)
 			if err != nil {
 				log.Error(err)
 			}
 		}
-	}(msgCh)
-
-	watcherID := uuid.New()
-	log.Infof("New watcher %v added", watcherID)
-	err := a.streamBroker.Watch(nbID, msgCh, watcherID)
-	if err != nil {
-		log.Error(err)
-		return err
-	}
-
+	}()
 	return nil
 }
 
@@ -126,6 +225,13 @@ func (a *a1pController) dispatchReceivedMsg(ctx context.Context, sbMessage *stre
 		msg := sbMessage.Payload.(*a1.PolicyStatusMessage)
 		uri := msg.NotificationDestination
 		payload := msg.Message.Payload
+		a.policyStatuses.update(PolicyStatusEvent{
+			PolicyTypeID: msg.PolicyType.GetId(),
+			PolicyID:     msg.PolicyId,
+			XAppID:       sbMessage.TargetXAppID,
+			Payload:      payload,
+			Timestamp:    time.Now(),
+		})
 		ack := &a1.PolicyAckMessage{
 			PolicyType: msg.PolicyType,
 			PolicyId:   msg.PolicyId,
@@ -149,6 +255,8 @@ func (a *a1pController) dispatchReceivedMsg(ctx context.Context, sbMessage *stre
 		}
 		log.Infof("PolicyStatus forwarding Resp: %v", resp)
 		ackSbMessage := stream.NewSBStreamMessage(sbMessage.TargetXAppID, stream.PolicyAckMessage, sbMessage.A1SBIRPCType, stream.PolicyManagement, ack)
+		// the ack goes back on the status session of the endpoint that sent the status
+		ackSbMessage.Endpoint = sbMessage.Endpoint
 		err = a.streamBroker.Send(sbID, ackSbMessage)
 		if err != nil {
 			return err
@@ -158,213 +266,177 @@ func (a *a1pController) dispatchReceivedMsg(ctx context.Context, sbMessage *stre
 }
 
 func (a *a1pController) HandlePolicyCreate(ctx context.Context, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) error {
-	targetXAppIDs, err := a.rnibClient.GetXAppIDsForPolicyTypeID(ctx, policyTypeID)
-	if err != nil {
-		log.Error(err)
-		return err
-	}
-
-	log.Infof("targetXAppIDs %v for policyTypeID %v", targetXAppIDs, policyTypeID)
-
-	var resErr error = nil
+	return a.auditPolicyOperation(ctx, audit.PolicyCreate, policyID, policyTypeID, params, policyObject, func(ctx context.Context) error {
+		return a.policyCreate(ctx, policyID, policyTypeID, params, policyObject)
+	})
+}
 
-	for _, targetXAppID := range targetXAppIDs {
-		requestID := uuid.New().String()
-		obj, err := json.Marshal(policyObject)
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-		reqMsg := &a1.PolicyRequestMessage{
-			PolicyId: policyID,
-			PolicyType: &a1.PolicyType{
-				Id: policyTypeID,
-			},
-			Message: &a1.RequestMessage{
-				Header: &a1.Header{
-					RequestId:   requestID,
-					AppId:       targetXAppID,
-					Encoding:    a1.Encoding_PROTO,
-					PayloadType: a1.PayloadType_POLICY,
-				},
-				Payload: obj,
-			},
-		}
-		if callbackURI, ok := params[utils.NotificationDestination]; ok {
-			reqMsg.NotificationDestination = callbackURI
-		}
-		sbID, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(targetXAppID, stream.PolicyManagement))
-		sbMessage := stream.NewSBStreamMessage(targetXAppID, stream.PolicyRequestMessage, stream.PolicySetup, stream.PolicyManagement, reqMsg)
-		respCh := make(chan *stream.SBStreamMessage)
+func (a *a1pController) HandlePolicyDelete(ctx context.Context, policyID, policyTypeID string) error {
+	return a.auditPolicyOperation(ctx, audit.PolicyDelete, policyID, policyTypeID, nil, nil, func(ctx context.Context) error {
+		return a.policyDelete(ctx, policyID, policyTypeID)
+	})
+}
 
-		watcherID := uuid.New()
-		log.Infof("New watcher %v added", watcherID)
-		outputCh := make(chan interface{}, 1)
-		//eCh := make(chan error, 1)
+func (a *a1pController) HandlePolicyUpdate(ctx context.Context, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) error {
+	return a.auditPolicyOperation(ctx, audit.PolicyUpdate, policyID, policyTypeID, params, policyObject, func(ctx context.Context) error {
+		return a.policyUpdate(ctx, policyID, policyTypeID, params, policyObject)
+	})
+}
 
-		go waitRespMsgWithTimer(nbID, watcherID, requestID, respCh, outputCh, TimeoutTimer, a.streamBroker)
+// auditPolicyOperation runs the policy operation if the caller may write the policy and it passes the conflict rules, stores the new policy revision if it succeeded and appends the audit record;
+// a nil policyObject means that the policy is deleted
+func (a *a1pController) auditPolicyOperation(ctx context.Context, operation audit.Operation, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}, apply func(ctx context.Context) error) error {
+	unlock := a.policyLocks.lock(store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID})
+	defer unlock()
+	_, err := a.writePolicy(ctx, operation, policyID, policyTypeID, params, policyObject, apply)
+	return err
+}
 
-		err = a.streamBroker.Watch(nbID, respCh, watcherID)
-		if err != nil {
-			log.Error(err)
+// writePolicy is auditPolicyOperation for a locked policy; it also checks the precondition of the context and returns
+// the new revision
+func (a *a1pController) writePolicy(ctx context.Context, operation audit.Operation, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}, apply func(ctx context.Context) error) (uint64, error) {
+	caller, requestID := audit.CallerFromContext(ctx)
+	record := audit.NewRecord(operation, policyTypeID, policyID, caller, requestID)
+	record.NewBody = policyObject
+	intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, policyTypeID, policyID)
+	if err == nil && intent.Exists() {
+		record.OldBody = intent.Latest().PolicyObject
+	}
 
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return err
+	owner, err := a.checkOwner(ctx, intent, policyTypeID, policyID)
+	if err == nil {
+		err = a.checkPrecondition(ctx, intent, policyTypeID, policyID)
+	}
+	if err == nil && policyObject != nil {
+		err = a.checkConflicts(ctx, policyTypeID, policyID, policyObject)
+	}
+	if err == nil {
+		err = apply(audit.NewContext(ctx, record))
+	}
+	if err == nil {
+		a.conflictDetector.Index(policyTypeID, policyID, policyObject)
+		revision, revErr := store.AddPolicyRevision(ctx, a.policyIntentStore, policyTypeID, policyID, params, policyObject, policyObject == nil, owner)
+		if revErr != nil {
+			log.Warn(revErr)
+		} else {
+			record.Revision = revision.Revision
 		}
+	}
 
-		err = a.streamBroker.Send(sbID, sbMessage)
-		if err != nil {
-			log.Error(err)
+	auditErr := a.auditLog.Append(ctx, record)
+	if auditErr != nil {
+		log.Warn(auditErr)
+	}
+	return record.Revision, err
+}
 
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return err
-		}
+func (a *a1pController) policyCreate(ctx context.Context, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) error {
+	return a.policySetup(ctx, stream.PolicySetup, policyID, policyTypeID, params, policyObject)
+}
 
-		err, _ = checkOutput(<-outputCh)
-		if err != nil {
-			resErr = err
+func (a *a1pController) policyUpdate(ctx context.Context, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) error {
+	return a.policySetup(ctx, stream.PolicyUpdate, policyID, policyTypeID, params, policyObject)
+}
+
+// policySetup sends the policy object to all target xApps with either PolicySetup or PolicyUpdate; a scheduled policy is
+// only sent while its validity window is open
+func (a *a1pController) policySetup(ctx context.Context, rpcType stream.A1SBIRPCType, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) error {
+	schedule, err := ParsePolicySchedule(params)
+	if err != nil {
+		return err
+	}
+	key := store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID}
+	if schedule != nil && !schedule.Active(time.Now()) {
+		if rpcType == stream.PolicyUpdate && a.isDeployed(ctx, policyTypeID, policyID) {
+			if err := a.policyDelete(ctx, policyID, policyTypeID); err != nil {
+				return err
+			}
 		}
-		close(outputCh)
+		log.Infof("Policy %v of type %v is outside of its validity window - not sent to the xApps", policyID, policyTypeID)
+		a.policySchedules.set(ctx, key, false)
+		return nil
 	}
-	if resErr != nil {
-		log.Error(resErr)
+	if schedule != nil && rpcType == stream.PolicyUpdate && !a.isDeployed(ctx, policyTypeID, policyID) {
+		rpcType = stream.PolicySetup
 	}
 
-	return resErr
-}
-
-func (a *a1pController) HandlePolicyDelete(ctx context.Context, policyID, policyTypeID string) error {
 	targetXAppIDs, err := a.rnibClient.GetXAppIDsForPolicyTypeID(ctx, policyTypeID)
 	if err != nil {
 		log.Error(err)
 		return err
 	}
 
+	// drained xApps keep the policies they hold up to date, but get no new ones
+	if rpcType == stream.PolicySetup {
+		targetXAppIDs = a.drainedXApps.filter(targetXAppIDs)
+	}
+
 	log.Infof("targetXAppIDs %v for policyTypeID %v", targetXAppIDs, policyTypeID)
+	setAuditTargets(ctx, targetXAppIDs)
+
+	obj, err := json.Marshal(policyObject)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
 
 	var resErr error = nil
 
 	for _, targetXAppID := range targetXAppIDs {
-		requestID := uuid.New().String()
-		reqMsg := &a1.PolicyRequestMessage{
-			PolicyId: policyID,
-			PolicyType: &a1.PolicyType{
-				Id: policyTypeID,
-			},
-			Message: &a1.RequestMessage{
-				Header: &a1.Header{
-					RequestId:   requestID,
-					AppId:       targetXAppID,
-					Encoding:    a1.Encoding_PROTO,
-					PayloadType: a1.PayloadType_POLICY,
-				},
-			},
-		}
-		sbID, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(targetXAppID, stream.PolicyManagement))
-		sbMessage := stream.NewSBStreamMessage(targetXAppID, stream.PolicyResultMessage, stream.PolicyDelete, stream.PolicyManagement, reqMsg)
-		respCh := make(chan *stream.SBStreamMessage)
-
-		watcherID := uuid.New()
-		log.Infof("New watcher %v added", watcherID)
-		outputCh := make(chan interface{}, 1)
-
-		go waitRespMsgWithTimer(nbID, watcherID, requestID, respCh, outputCh, TimeoutTimer, a.streamBroker)
-
-		err = a.streamBroker.Watch(nbID, respCh, watcherID)
-		if err != nil {
-			log.Error(err)
-
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return err
-		}
-
-		err = a.streamBroker.Send(sbID, sbMessage)
-		if err != nil {
-			log.Error(err)
-
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return err
+		reqMsg := newPolicyRequestMessage(targetXAppID, policyID, policyTypeID, a1.PayloadType_POLICY)
+		reqMsg.Message.Payload = obj
+		if callbackURI, ok := params[utils.NotificationDestination]; ok {
+			reqMsg.NotificationDestination = callbackURI
 		}
-
-		err, _ = checkOutput(<-outputCh)
-		if err != nil {
+		_, err = a.sendPolicyRequest(ctx, targetXAppID, rpcType, reqMsg)
+		recordAuditOutcome(ctx, targetXAppID, err)
+		if err != nil && !a.skipOpenCircuit(targetXAppID, err) {
 			resErr = err
 		}
-		close(outputCh)
 	}
 
 	if resErr != nil {
 		log.Error(resErr)
+	} else if schedule != nil {
+		a.policySchedules.set(ctx, key, true)
+	} else {
+		a.policySchedules.forget(ctx, key)
 	}
 
 	return resErr
 }
 
-func (a *a1pController) HandlePolicyUpdate(ctx context.Context, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) error {
-	targetXAppIDs, err := a.rnibClient.GetXAppIDsForPolicyTypeID(ctx, policyTypeID)
+// policyDelete withdraws the policy from all target xApps; nothing is sent for a scheduled policy the xApps do not hold
+func (a *a1pController) policyDelete(ctx context.Context, policyID, policyTypeID string) error {
+	key := store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID}
+	if !a.isDeployed(ctx, policyTypeID, policyID) {
+		a.policySchedules.forget(ctx, key)
+		return nil
+	}
 
+	targetXAppIDs, err := a.rnibClient.GetXAppIDsForPolicyTypeID(ctx, policyTypeID)
 	if err != nil {
 		log.Error(err)
 		return err
 	}
 
 	log.Infof("targetXAppIDs %v for policyTypeID %v", targetXAppIDs, policyTypeID)
+	setAuditTargets(ctx, targetXAppIDs)
 
 	var resErr error = nil
-	for _, targetXAppID := range targetXAppIDs {
-		requestID := uuid.New().String()
-		obj, err := json.Marshal(policyObject)
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-		reqMsg := &a1.PolicyRequestMessage{
-			PolicyId: policyID,
-			PolicyType: &a1.PolicyType{
-				Id: policyTypeID,
-			},
-			Message: &a1.RequestMessage{
-				Header: &a1.Header{
-					RequestId:   requestID,
-					AppId:       targetXAppID,
-					Encoding:    a1.Encoding_PROTO,
-					PayloadType: a1.PayloadType_POLICY,
-				},
-				Payload: obj,
-			},
-		}
-		if callbackURI, ok := params[utils.NotificationDestination]; ok {
-			reqMsg.NotificationDestination = callbackURI
-		}
-		sbID, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(targetXAppID, stream.PolicyManagement))
-		sbMessage := stream.NewSBStreamMessage(targetXAppID, stream.PolicyRequestMessage, stream.PolicyUpdate, stream.PolicyManagement, reqMsg)
-		respCh := make(chan *stream.SBStreamMessage)
-
-		watcherID := uuid.New()
-		log.Infof("New watcher %v added", watcherID)
-		outputCh := make(chan interface{}, 1)
-
-		go waitRespMsgWithTimer(nbID, watcherID, requestID, respCh, outputCh, TimeoutTimer, a.streamBroker)
-
-		err = a.streamBroker.Watch(nbID, respCh, watcherID)
-		if err != nil {
-			log.Error(err)
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return err
-		}
-
-		err = a.streamBroker.Send(sbID, sbMessage)
-		if err != nil {
-			log.Error(err)
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return err
-		}
 
-		err, _ = checkOutput(<-outputCh)
-		if err != nil {
+	for _, targetXAppID := range targetXAppIDs {
+		reqMsg := newPolicyRequestMessage(targetXAppID, policyID, policyTypeID, a1.PayloadType_POLICY)
+		_, err = a.sendPolicyRequest(ctx, targetXAppID, stream.PolicyDelete, reqMsg)
+		recordAuditOutcome(ctx, targetXAppID, err)
+		if err != nil && !a.skipOpenCircuit(targetXAppID, err) {
 			resErr = err
 		}
-		close(outputCh)
+	}
+
+	if resErr == nil {
+		a.policyStatuses.remove(policyTypeID, policyID)
+		a.policySchedules.forget(ctx, key)
 	}
 
 	if resErr != nil {
@@ -374,6 +446,41 @@ func (a *a1pController) HandlePolicyUpdate(ctx context.Context, policyID, policy
 	return resErr
 }
 
+// sendPolicyRequest sends the request to the target xApp and waits for the matching PolicyResultMessage;
+// it fails fast without sending anything while the circuit of the xApp is open
+func (a *a1pController) sendPolicyRequest(ctx context.Context, targetXAppID string, rpcType stream.A1SBIRPCType, reqMsg *a1.PolicyRequestMessage) (*a1.PolicyResultMessage, error) {
+	if err := a.circuitBreakers.allow(targetXAppID); err != nil {
+		log.Warn(err)
+		return nil, err
+	}
+	sbID, _ := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(targetXAppID, stream.PolicyManagement))
+	sbMessage := stream.NewSBStreamMessage(targetXAppID, stream.PolicyRequestMessage, rpcType, stream.PolicyManagement, reqMsg)
+	resp, err := a.requester.Request(ctx, sbID, sbMessage)
+	if err == nil {
+		err = resp.Error
+	}
+	if err != nil && ctx.Err() != nil {
+		// the caller canceled the request or its deadline passed, which says nothing of the xApp
+		a.circuitBreakers.release(targetXAppID)
+	} else {
+		a.circuitBreakers.done(targetXAppID, deliveryFailure(err))
+	}
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return checkOutput(resp)
+}
+
+// skipOpenCircuit tells if the error of the xApp is left out of the fan-out result by the aggregation policy
+func (a *a1pController) skipOpenCircuit(targetXAppID string, err error) bool {
+	if IsCircuitOpen(err) && a.circuitBreakers.config.AggregationPolicy == SkipOpen {
+		log.Infof("Skipping xApp %v: %v", targetXAppID, err)
+		return true
+	}
+	return false
+}
+
 func (a *a1pController) HandleGetPolicyTypes(ctx context.Context) []string {
 	results := make([]string, 0)
 	policyTypes, err := a.rnibClient.GetPolicyTypes(ctx)
@@ -418,62 +525,27 @@ func (a *a1pController) HandleGetPolicytypesPolicyTypeIdPolicies(ctx context.Con
 	log.Infof("targetXAppIDs %v for policyTypeID %v", targetXAppIDs, policyTypeID)
 
 	objs := make([][]string, 0)
+	xAppIDs := make([]string, 0)
 
 	var resErr error = nil
 
 	for _, targetXAppID := range targetXAppIDs {
-		requestID := uuid.New().String()
-		reqMsg := &a1.PolicyRequestMessage{
-			PolicyType: &a1.PolicyType{
-				Id: policyTypeID,
-			},
-			Message: &a1.RequestMessage{
-				Header: &a1.Header{
-					RequestId:   requestID,
-					AppId:       targetXAppID,
-					Encoding:    a1.Encoding_PROTO,
-					PayloadType: a1.PayloadType_POLICY,
-				},
-			},
-		}
-		sbID, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(targetXAppID, stream.PolicyManagement))
-		sbMessage := stream.NewSBStreamMessage(targetXAppID, stream.PolicyRequestMessage, stream.PolicyQuery, stream.PolicyManagement, reqMsg)
-		respCh := make(chan *stream.SBStreamMessage)
-
-		watcherID := uuid.New()
-		log.Infof("New watcher %v added", watcherID)
-		outputCh := make(chan interface{}, 1)
-
-		go waitRespMsgWithTimer(nbID, watcherID, requestID, respCh, outputCh, TimeoutTimer, a.streamBroker)
-
-		err = a.streamBroker.Watch(nbID, respCh, watcherID)
-		if err != nil {
-			log.Error(err)
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return nil, err
-		}
-
-		err = a.streamBroker.Send(sbID, sbMessage)
+		reqMsg := newPolicyRequestMessage(targetXAppID, "", policyTypeID, a1.PayloadType_POLICY)
+		resp, err := a.sendPolicyRequest(ctx, targetXAppID, stream.PolicyQuery, reqMsg)
 		if err != nil {
-			log.Error(err)
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return nil, err
+			if !a.skipOpenCircuit(targetXAppID, err) {
+				resErr = err
+			}
+			continue
 		}
 
-		err, resp := checkOutput(<-outputCh)
+		var obj []string
+		err = json.Unmarshal(resp.Message.Payload, &obj)
 		if err != nil {
 			resErr = err
-		}
-		close(outputCh)
-
-		var obj []string
-		if err == nil {
-			err = json.Unmarshal(resp.(*a1.PolicyResultMessage).Message.Payload, &obj)
-			if err != nil {
-				resErr = err
-			} else {
-				objs = append(objs, obj)
-			}
+		} else {
+			objs = append(objs, obj)
+			xAppIDs = append(xAppIDs, targetXAppID)
 		}
 	}
 
@@ -482,100 +554,23 @@ func (a *a1pController) HandleGetPolicytypesPolicyTypeIdPolicies(ctx context.Con
 		return nil, resErr
 	}
 
-	if ok, err := utils.PolicyObjListValidate(objs); !ok {
+	if err := checkConsistency(objs, xAppIDs); err != nil {
 		log.Error(err)
 		return nil, err
 	}
 
-	return objs[0], nil
+	return a.filterOwnedPolicyIDs(ctx, policyTypeID, objs[0])
 }
 
 func (a *a1pController) HandleGetPolicy(ctx context.Context, policyID, policyTypeID string) (map[string]interface{}, error) {
-	targetXAppIDs, err := a.rnibClient.GetXAppIDsForPolicyTypeID(ctx, policyTypeID)
-	if err != nil {
-		log.Error(err)
+	if err := a.checkReadOwner(ctx, policyTypeID, policyID); err != nil {
 		return nil, err
 	}
-
-	log.Infof("targetXAppIDs %v for policyTypeID %v", targetXAppIDs, policyTypeID)
-
-	objs := make([]map[string]interface{}, 0)
-
-	var resErr error = nil
-
-	for _, targetXAppID := range targetXAppIDs {
-		requestID := uuid.New().String()
-		reqMsg := &a1.PolicyRequestMessage{
-			PolicyId: policyID,
-			PolicyType: &a1.PolicyType{
-				Id: policyTypeID,
-			},
-			Message: &a1.RequestMessage{
-				Header: &a1.Header{
-					RequestId:   requestID,
-					AppId:       targetXAppID,
-					Encoding:    a1.Encoding_PROTO,
-					PayloadType: a1.PayloadType_POLICY,
-				},
-			},
-		}
-		sbID, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(targetXAppID, stream.PolicyManagement))
-		sbMessage := stream.NewSBStreamMessage(targetXAppID, stream.PolicyRequestMessage, stream.PolicyQuery, stream.PolicyManagement, reqMsg)
-		respCh := make(chan *stream.SBStreamMessage)
-
-		watcherID := uuid.New()
-		log.Infof("New watcher %v added", watcherID)
-		outputCh := make(chan interface{}, 1)
-
-		go waitRespMsgWithTimer(nbID, watcherID, requestID, respCh, outputCh, TimeoutTimer, a.streamBroker)
-
-		err = a.streamBroker.Watch(nbID, respCh, watcherID)
-		if err != nil {
-			log.Error(err)
-
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return nil, err
-		}
-
-		err = a.streamBroker.Send(sbID, sbMessage)
-		if err != nil {
-			log.Error(err)
-
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return nil, err
-		}
-
-		err, resp := checkOutput(<-outputCh)
-		if err != nil {
-			resErr = err
-		}
-		close(outputCh)
-
-		var obj map[string]interface{}
-		if err == nil {
-			err = json.Unmarshal(resp.(*a1.PolicyResultMessage).Message.Payload, &obj)
-			if err != nil {
-				resErr = err
-			} else {
-				objs = append(objs, obj)
-			}
-		}
-	}
-
-	if resErr != nil {
-		log.Error(resErr)
-		return nil, resErr
-	}
-
-	if ok, err := utils.PolicyObjListValidate(objs); !ok {
-		log.Error(err)
-		return nil, err
-	}
-
-	return objs[0], nil
+	return a.queryPolicyObject(ctx, policyID, policyTypeID, a1.PayloadType_POLICY)
 }
 
-func (a *a1pController) HandleGetPolicyStatus(ctx context.Context, policyID, policyTypeID string) (map[string]interface{}, error) {
+// queryPolicyObject queries the policy object or status from all target xApps and checks that they are the same
+func (a *a1pController) queryPolicyObject(ctx context.Context, policyID, policyTypeID string, payloadType a1.PayloadType) (map[string]interface{}, error) {
 	targetXAppIDs, err := a.rnibClient.GetXAppIDsForPolicyTypeID(ctx, policyTypeID)
 	if err != nil {
 		log.Error(err)
@@ -585,65 +580,27 @@ func (a *a1pController) HandleGetPolicyStatus(ctx context.Context, policyID, pol
 	log.Infof("targetXAppIDs %v for policyTypeID %v", targetXAppIDs, policyTypeID)
 
 	objs := make([]map[string]interface{}, 0)
+	xAppIDs := make([]string, 0)
 
 	var resErr error = nil
 
 	for _, targetXAppID := range targetXAppIDs {
-		requestID := uuid.New().String()
-		reqMsg := &a1.PolicyRequestMessage{
-			PolicyId: policyID,
-			PolicyType: &a1.PolicyType{
-				Id: policyTypeID,
-			},
-			Message: &a1.RequestMessage{
-				Header: &a1.Header{
-					RequestId:   requestID,
-					AppId:       targetXAppID,
-					Encoding:    a1.Encoding_PROTO,
-					PayloadType: a1.PayloadType_STATUS,
-				},
-			},
-		}
-		sbID, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(targetXAppID, stream.PolicyManagement))
-		sbMessage := stream.NewSBStreamMessage(targetXAppID, stream.PolicyRequestMessage, stream.PolicyQuery, stream.PolicyManagement, reqMsg)
-		respCh := make(chan *stream.SBStreamMessage)
-
-		watcherID := uuid.New()
-		log.Infof("New watcher %v added", watcherID)
-		outputCh := make(chan interface{}, 1)
-
-		go waitRespMsgWithTimer(nbID, watcherID, requestID, respCh, outputCh, TimeoutTimer, a.streamBroker)
-
-		err = a.streamBroker.Watch(nbID, respCh, watcherID)
-		if err != nil {
-			log.Error(err)
-
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return nil, err
-		}
-
-		err = a.streamBroker.Send(sbID, sbMessage)
+		reqMsg := newPolicyRequestMessage(targetXAppID, policyID, policyTypeID, payloadType)
+		resp, err := a.sendPolicyRequest(ctx, targetXAppID, stream.PolicyQuery, reqMsg)
 		if err != nil {
-			log.Error(err)
-
-			a.streamBroker.DeleteWatcher(nbID, watcherID)
-			return nil, err
+			if !a.skipOpenCircuit(targetXAppID, err) {
+				resErr = err
+			}
+			continue
 		}
 
-		err, resp := checkOutput(<-outputCh)
+		var obj map[string]interface{}
+		err = json.Unmarshal(resp.Message.Payload, &obj)
 		if err != nil {
 			resErr = err
-		}
-		close(outputCh)
-
-		var obj map[string]interface{}
-		if err == nil {
-			err = json.Unmarshal(resp.(*a1.PolicyResultMessage).Message.Payload, &obj)
-			if err != nil {
-				resErr = err
-			} else {
-				objs = append(objs, obj)
-			}
+		} else {
+			objs = append(objs, obj)
+			xAppIDs = append(xAppIDs, targetXAppID)
 		}
 	}
 
@@ -652,10 +609,27 @@ func (a *a1pController) HandleGetPolicyStatus(ctx context.Context, policyID, pol
 		return nil, resErr
 	}
 
-	if ok, err := utils.PolicyObjListValidate(objs); !ok {
+	if err := checkObjectConsistency(policyTypeID, payloadType, objs, xAppIDs); err != nil {
 		log.Error(err)
 		return nil, err
 	}
 
 	return objs[0], nil
 }
+
+func newPolicyRequestMessage(targetXAppID, policyID, policyTypeID string, payloadType a1.PayloadType) *a1.PolicyRequestMessage {
+	return &a1.PolicyRequestMessage{
+		PolicyId: policyID,
+		PolicyType: &a1.PolicyType{
+			Id: policyTypeID,
+		},
+		Message: &a1.RequestMessage{
+			Header: &a1.Header{
+				RequestId:   uuid.New().String(),
+				AppId:       targetXAppID,
+				Encoding:    a1.Encoding_PROTO,
+				PayloadType: payloadType,
+			},
+		},
+	}
+}
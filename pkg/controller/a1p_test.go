@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sbclient "github.com/onosproject/onos-a1t/pkg/southbound/client"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testXAppID = "xapp-1"
+
+func TestPolicyStatusAfterReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var notifications atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		notifications.Add(1)
+	}))
+	defer server.Close()
+
+	broker := stream.NewBroker(stream.DefaultBrokerConfig())
+	a := &a1pController{
+		streamBroker:   broker,
+		policyStatuses: newPolicyStatuses(10),
+	}
+	a.watchStreams(ctx)
+	statuses := make(chan PolicyStatusEvent, 10)
+	require.NoError(t, a.WatchPolicyStatus(ctx, PolicyStatusWatchOptions{}, statuses))
+
+	// the southbound sets up the streams of the xApp, then the controller learns about its subscription
+	sbclient.CreateStream(ctx, testXAppID, stream.PolicyManagement, broker)
+	require.NoError(t, a.createEventSubStoreHandler(ctx, &store.Entry{Key: store.SubscriptionKey{TargetXAppID: testXAppID}}))
+	_, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(testXAppID, stream.PolicyManagement))
+	waitForWatchers(t, broker, nbID, 1)
+	sendPolicyStatus(t, broker, nbID, server.URL, "1")
+	assert.Equal(t, "1", receivePolicyStatus(t, statuses).PolicyID)
+
+	// an operator disconnects and reconnects the xApp: the southbound closes the streams and sets them up again
+	sbclient.DeleteStream(testXAppID, stream.PolicyManagement, broker)
+	sbclient.CreateStream(ctx, testXAppID, stream.PolicyManagement, broker)
+	waitForWatchers(t, broker, nbID, 1)
+	sendPolicyStatus(t, broker, nbID, server.URL, "2")
+	assert.Equal(t, "2", receivePolicyStatus(t, statuses).PolicyID)
+
+	// the subscription of the xApp changing does not add a second watcher
+	require.NoError(t, a.createEventSubStoreHandler(ctx, &store.Entry{Key: store.SubscriptionKey{TargetXAppID: testXAppID}}))
+	assert.Equal(t, 1, broker.Stats()[nbID].Watchers)
+	assert.Eventually(t, func() bool {
+		return notifications.Load() == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func waitForWatchers(t *testing.T, broker stream.Broker, id stream.ID, watchers int) {
+	require.Eventually(t, func() bool {
+		return broker.Stats()[id].Watchers == watchers
+	}, time.Second, 10*time.Millisecond)
+}
+
+func sendPolicyStatus(t *testing.T, broker stream.Broker, nbID stream.ID, uri, policyID string) {
+	msg := &a1.PolicyStatusMessage{
+		PolicyId:   policyID,
+		PolicyType: &a1.PolicyType{Id: "ORAN_TrafficSteeringPreference_2.0.0"},
+		Message: &a1.StatusMessage{
+			Header:  &a1.Header{RequestId: policyID},
+			Payload: []byte(`{"enforceStatus":"ENFORCED"}`),
+		},
+		NotificationDestination: uri,
+	}
+	require.NoError(t, broker.Send(nbID, stream.NewSBStreamMessage(testXAppID, stream.PolicyStatusMessage, stream.PolicyStatus, stream.PolicyManagement, msg)))
+}
+
+func receivePolicyStatus(t *testing.T, statuses chan PolicyStatusEvent) PolicyStatusEvent {
+	select {
+	case event := <-statuses:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no policy status received")
+		return PolicyStatusEvent{}
+	}
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	policystatusv2 "github.com/onosproject/onos-a1-dm/go/policy_status/v2"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// XAppPolicyStatus is the status of a policy in an xApp; Status is nil if Err is set
+type XAppPolicyStatus struct {
+	XAppID string
+	Status *policystatusv2.API
+	// Cached is set if the status is the last one the xApp pushed on its status stream rather than the answer to a query
+	Cached    bool
+	Timestamp time.Time
+	Err       error
+}
+
+// Enforced returns whether the xApp enforces the policy
+func (s *XAppPolicyStatus) Enforced() bool {
+	return s.Err == nil && s.Status.EnforceStatus == policystatusv2.Enforced
+}
+
+// String returns the xApp ID with its status, e.g. xapp-1 (NOT_ENFORCED, SCOPE_NOT_APPLICABLE, cached)
+func (s *XAppPolicyStatus) String() string {
+	var details []string
+	if s.Err != nil {
+		details = []string{"UNKNOWN", strings.ReplaceAll(s.Err.Error(), "\n", " ")}
+	} else {
+		details = []string{string(s.Status.EnforceStatus)}
+		if s.Status.EnforceReason != nil {
+			details = append(details, string(*s.Status.EnforceReason))
+		}
+	}
+	if s.Cached {
+		details = append(details, "cached")
+	}
+	return fmt.Sprintf("%v (%v)", s.XAppID, strings.Join(details, ", "))
+}
+
+// AggregatedPolicyStatus is the status of a policy across its target xApps
+type AggregatedPolicyStatus struct {
+	Status *policystatusv2.API
+	XApps  []*XAppPolicyStatus
+}
+
+// StatusObject returns the aggregated status as the policy status object of the A1-P responses
+func (s *AggregatedPolicyStatus) StatusObject() (map[string]interface{}, error) {
+	b, err := s.Status.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	status := make(map[string]interface{})
+	if err := json.Unmarshal(b, &status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// AggregatePolicyStatuses returns the status of a policy given its status in each target xApp: ENFORCED if all of them
+// enforce it, NOT_ENFORCED otherwise, with the reason of the xApps which do not enforce it if they all give the same
+// one and OTHER_REASON if not; an xApp whose status is unknown does not enforce the policy for OTHER_REASON
+func AggregatePolicyStatuses(xApps []*XAppPolicyStatus) *policystatusv2.API {
+	var reason *policystatusv2.EnforceReason
+	enforced := true
+	for _, s := range xApps {
+		if s.Enforced() {
+			continue
+		}
+		xAppReason := policystatusv2.OtherReason
+		if s.Err == nil && s.Status.EnforceReason != nil {
+			xAppReason = *s.Status.EnforceReason
+		}
+		if enforced {
+			reason = &xAppReason
+		} else if *reason != xAppReason {
+			otherReason := policystatusv2.OtherReason
+			reason = &otherReason
+		}
+		enforced = false
+	}
+	if enforced {
+		return &policystatusv2.API{EnforceStatus: policystatusv2.Enforced}
+	}
+	return &policystatusv2.API{EnforceStatus: policystatusv2.NotEnforced, EnforceReason: reason}
+}
+
+// ParsePolicyStatus parses a policy status reported by an xApp and validates it against the policy status schema
+func ParsePolicyStatus(payload []byte) (*policystatusv2.API, error) {
+	if !utils.JsonValidate(policystatusv2.RawSchema, string(payload)) {
+		return nil, errors.NewInvalid("policy status %s does not follow the policy status schema", payload)
+	}
+	status, err := policystatusv2.UnmarshalAPI(payload)
+	if err != nil {
+		return nil, errors.NewInvalid("invalid policy status: %v", err)
+	}
+	return &status, nil
+}
+
+func (a *a1pController) HandleGetPolicyStatus(ctx context.Context, policyID, policyTypeID string) (map[string]interface{}, error) {
+	aggregated, err := a.HandleGetAggregatedPolicyStatus(ctx, policyID, policyTypeID)
+	if err != nil {
+		return nil, err
+	}
+	return aggregated.StatusObject()
+}
+
+// HandleGetAggregatedPolicyStatus returns the status of the policy in each target xApp and their aggregation; the last
+// status an xApp pushed is used instead of querying it if it came after the last write of the policy and within the
+// status cache TTL
+func (a *a1pController) HandleGetAggregatedPolicyStatus(ctx context.Context, policyID, policyTypeID string) (*AggregatedPolicyStatus, error) {
+	if err := a.checkReadOwner(ctx, policyTypeID, policyID); err != nil {
+		return nil, err
+	}
+	targetXAppIDs, err := a.rnibClient.GetXAppIDsForPolicyTypeID(ctx, policyTypeID)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	notBefore := time.Now().Add(-a.statusCacheTTL)
+	if intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, policyTypeID, policyID); err == nil && intent.Exists() {
+		if written := intent.Latest().Timestamp; written.After(notBefore) {
+			notBefore = written
+		}
+	}
+
+	aggregated := &AggregatedPolicyStatus{
+		XApps: make([]*XAppPolicyStatus, 0, len(targetXAppIDs)),
+	}
+	var resErr error
+	for _, targetXAppID := range targetXAppIDs {
+		if a.statusCacheTTL > 0 {
+			if event, ok := a.policyStatuses.get(policyTypeID, policyID, targetXAppID); ok && event.Timestamp.After(notBefore) {
+				status, err := ParsePolicyStatus(event.Payload)
+				aggregated.XApps = append(aggregated.XApps, &XAppPolicyStatus{
+					XAppID:    targetXAppID,
+					Status:    status,
+					Cached:    true,
+					Timestamp: event.Timestamp,
+					Err:       err,
+				})
+				continue
+			}
+		}
+
+		xAppStatus := &XAppPolicyStatus{
+			XAppID:    targetXAppID,
+			Timestamp: time.Now(),
+		}
+		reqMsg := newPolicyRequestMessage(targetXAppID, policyID, policyTypeID, a1.PayloadType_STATUS)
+		resp, err := a.sendPolicyRequest(ctx, targetXAppID, stream.PolicyQuery, reqMsg)
+		if err != nil {
+			if a.skipOpenCircuit(targetXAppID, err) {
+				continue
+			}
+			xAppStatus.Err = err
+		} else {
+			xAppStatus.Status, xAppStatus.Err = ParsePolicyStatus(resp.Message.Payload)
+		}
+		if xAppStatus.Err != nil {
+			log.Warnf("Failed to get the status of policy %v of type %v from xApp %v: %v", policyID, policyTypeID, targetXAppID, xAppStatus.Err)
+			resErr = xAppStatus.Err
+		}
+		aggregated.XApps = append(aggregated.XApps, xAppStatus)
+	}
+
+	known := false
+	for _, s := range aggregated.XApps {
+		known = known || s.Err == nil
+	}
+	if !known {
+		if resErr != nil {
+			return nil, resErr
+		}
+		return nil, errors.NewNotFound("there is no status of policy %v of type %v", policyID, policyTypeID)
+	}
+	aggregated.Status = AggregatePolicyStatuses(aggregated.XApps)
+	return aggregated, nil
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+
+	policystatusv2 "github.com/onosproject/onos-a1-dm/go/policy_status/v2"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregatedPolicyStatus(t *testing.T) {
+	scope := policystatusv2.ScopeNotApplicable
+	xApps := []*XAppPolicyStatus{
+		{XAppID: "xapp-1", Status: &policystatusv2.API{EnforceStatus: policystatusv2.Enforced}},
+		{XAppID: "xapp-2", Status: &policystatusv2.API{EnforceStatus: policystatusv2.NotEnforced, EnforceReason: &scope}, Cached: true},
+		{XAppID: "xapp-3", Err: errors.NewTimeout("no answer")},
+	}
+	aggregated := &AggregatedPolicyStatus{Status: AggregatePolicyStatuses(xApps), XApps: xApps}
+
+	status, err := aggregated.StatusObject()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"enforceStatus": "NOT_ENFORCED", "enforceReason": "OTHER_REASON"}, status)
+
+	assert.Equal(t, "xapp-1 (ENFORCED)", xApps[0].String())
+	assert.Equal(t, "xapp-2 (NOT_ENFORCED, SCOPE_NOT_APPLICABLE, cached)", xApps[1].String())
+	assert.Equal(t, "xapp-3 (UNKNOWN, no answer)", xApps[2].String())
+}
@@ -6,6 +6,8 @@ package controller
 
 import (
 	"context"
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/policytype"
 	"github.com/onosproject/onos-a1t/pkg/rnib"
 	"github.com/onosproject/onos-a1t/pkg/store"
 	"github.com/onosproject/onos-a1t/pkg/stream"
@@ -14,16 +16,43 @@ import (
 
 var TimeoutTimer = 5 * time.Second
 
+type Config struct {
+	CircuitBreaker CircuitBreakerConfig
+	// StatusHistorySize is the number of policy status events kept to resume status watches
+	StatusHistorySize int
+	// StatusCacheTTL is how long the last status an xApp pushed answers the status requests without querying the xApp;
+	// 0 always queries the xApps
+	StatusCacheTTL time.Duration
+	// PolicyQuota is the maximum number of policies of each type a tenant may own; 0 means unlimited
+	PolicyQuota int
+	// RequireTenant rejects the callers bound to no tenant, e.g. the REST callers without a client certificate, instead
+	// of letting them manage every policy
+	RequireTenant bool
+	// PolicyTypes holds the conflict rules of the policy types
+	PolicyTypes policytype.Registry
+	// DMECallbackURL is the URL at which the Non-RT RIC reaches A1T to deliver the results and status of the information jobs
+	DMECallbackURL string
+}
+
+func DefaultConfig() Config {
+	return Config{
+		CircuitBreaker:    DefaultCircuitBreakerConfig(),
+		StatusHistorySize: 1000,
+		StatusCacheTTL:    30 * time.Second,
+		PolicyTypes:       policytype.NewRegistry(),
+	}
+}
+
 type Broker interface {
 	A1PController() A1PController
 	A1EIController() A1EIController
 	Run(ctx context.Context) error
 }
 
-func NewBroker(nonRTRICURL string, subscriptionStore store.Store, eijobsStore store.Store, rnibClient rnib.TopoClient, streamBroker stream.Broker) Broker {
+func NewBroker(nonRTRICURL string, subscriptionStore store.Store, policyIntentStore store.Store, eijobsStore store.Store, rnibClient rnib.TopoClient, streamBroker stream.Broker, auditLog audit.Log, config Config) Broker {
 	return &broker{
-		a1pController:  NewA1PController(subscriptionStore, rnibClient, streamBroker),
-		a1eiController: NewA1EIController(nonRTRICURL, subscriptionStore, eijobsStore, rnibClient, streamBroker),
+		a1pController:  NewA1PController(subscriptionStore, policyIntentStore, rnibClient, streamBroker, auditLog, config),
+		a1eiController: NewA1EIController(nonRTRICURL, subscriptionStore, eijobsStore, rnibClient, streamBroker, config),
 		rnibClient:     rnibClient,
 	}
 }
@@ -39,11 +68,7 @@ func (b *broker) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	//err = b.a1eiController.Receiver(ctx)
-	//if err != nil {
-	//	return err
-	//}
-	return nil
+	return b.a1eiController.Receiver(ctx)
 }
 
 func (b *broker) A1PController() A1PController {
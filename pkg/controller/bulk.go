@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	policyschemas "github.com/onosproject/onos-a1-dm/go/policy_schemas"
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+type BulkAction int
+
+const (
+	// BulkPut creates the policy or updates it if A1T already knows it, like a PUT without the query to the xApps
+	BulkPut BulkAction = iota
+	BulkCreate
+	BulkUpdate
+	BulkDelete
+)
+
+func (b BulkAction) String() string {
+	return [...]string{"Put", "Create", "Update", "Delete"}[b]
+}
+
+func ParseBulkAction(action string) (BulkAction, error) {
+	for _, b := range []BulkAction{BulkPut, BulkCreate, BulkUpdate, BulkDelete} {
+		if strings.EqualFold(action, b.String()) {
+			return b, nil
+		}
+	}
+	return BulkPut, errors.NewInvalid("unknown bulk action %v", action)
+}
+
+type BulkOperation struct {
+	Action       BulkAction
+	PolicyTypeID string
+	PolicyID     string
+	Params       map[string]string
+	PolicyObject map[string]interface{}
+}
+
+// BulkResult is the outcome of a single operation of the batch; Err is set if the operation was invalid or failed
+type BulkResult struct {
+	Index        int
+	PolicyTypeID string
+	PolicyID     string
+	// Action is the action applied, with BulkPut resolved to BulkCreate or BulkUpdate
+	Action      BulkAction
+	Applied     bool
+	Compensated bool
+	Err         error
+}
+
+// bulkConcurrency is the number of operations of a batch sent to the xApps at the same time
+const bulkConcurrency = 16
+
+// HandlePolicyBulk validates all operations and, if they are all valid, applies them concurrently. With atomic, the
+// applied operations are compensated if any operation fails. The returned error is set if the batch was not applied.
+func (a *a1pController) HandlePolicyBulk(ctx context.Context, operations []BulkOperation, atomic bool) ([]*BulkResult, error) {
+	results := make([]*BulkResult, len(operations))
+	previous := make([]*store.PolicyRevision, len(operations))
+	valid := true
+	seen := make(map[store.PolicyIntentKey]int)
+	for i, op := range operations {
+		results[i] = &BulkResult{
+			Index:        i,
+			PolicyTypeID: op.PolicyTypeID,
+			PolicyID:     op.PolicyID,
+			Action:       op.Action,
+		}
+		key := store.PolicyIntentKey{PolicyTypeID: op.PolicyTypeID, PolicyID: op.PolicyID}
+		if j, ok := seen[key]; ok {
+			results[i].Err = errors.NewInvalid("policy %v of type %v is already in operation %d", op.PolicyID, op.PolicyTypeID, j)
+		} else {
+			seen[key] = i
+			results[i].Action, previous[i], results[i].Err = a.validateBulkOperation(ctx, op)
+		}
+		if results[i].Err != nil {
+			valid = false
+		}
+	}
+	if !valid {
+		return results, errors.NewInvalid("bulk request rejected - no operation was applied")
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, bulkConcurrency)
+	for i, op := range operations {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *BulkResult, op BulkOperation) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result.Err = a.applyBulkOperation(ctx, result.Action, op)
+			result.Applied = result.Err == nil
+		}(results[i], op)
+	}
+	wg.Wait()
+
+	var resErr error
+	for _, r := range results {
+		if r.Err != nil {
+			resErr = errors.NewInternal("%d of %d operations failed", countFailed(results), len(results))
+			break
+		}
+	}
+	if resErr == nil || !atomic {
+		return results, resErr
+	}
+
+	// compensate in reverse order, each applied operation with its inverse
+	for i := len(results) - 1; i >= 0; i-- {
+		r := results[i]
+		if !r.Applied {
+			continue
+		}
+		err := a.compensateBulkOperation(ctx, r, previous[i])
+		if err != nil {
+			log.Warnf("Compensation of %v of policy %v of type %v failed: %v", r.Action, r.PolicyID, r.PolicyTypeID, err)
+			continue
+		}
+		r.Compensated = true
+	}
+	return results, resErr
+}
+
+// validateBulkOperation checks the operation against the policy schema and the existing policies, i.e. the ones known
+// to A1T or held by the xApps, and returns the action to apply and the current revision of the policy, if any
+func (a *a1pController) validateBulkOperation(ctx context.Context, op BulkOperation) (BulkAction, *store.PolicyRevision, error) {
+	if op.PolicyTypeID == "" || op.PolicyID == "" {
+		return op.Action, nil, errors.NewInvalid("policy type ID and policy ID are required")
+	}
+	if _, ok := policyschemas.PolicySchemas[op.PolicyTypeID]; !ok {
+		return op.Action, nil, errors.NewNotSupported("PolicyTypeID %v is not supported", op.PolicyTypeID)
+	}
+
+	var current *store.PolicyRevision
+	if intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, op.PolicyTypeID, op.PolicyID); err == nil && intent.Exists() {
+		current = intent.Latest()
+	}
+	exists, err := a.policyExists(ctx, op.PolicyID, op.PolicyTypeID)
+	if err != nil {
+		return op.Action, nil, err
+	}
+
+	action := op.Action
+	if action == BulkPut {
+		action = BulkCreate
+		if exists {
+			action = BulkUpdate
+		}
+	}
+
+	switch action {
+	case BulkCreate, BulkUpdate:
+		if action == BulkCreate && exists {
+			return action, nil, errors.NewAlreadyExists("policy %v of type %v already exists", op.PolicyID, op.PolicyTypeID)
+		}
+		if action == BulkUpdate && !exists {
+			return action, nil, errors.NewNotFound("policy %v of type %v not found", op.PolicyID, op.PolicyTypeID)
+		}
+		obj, err := json.Marshal(op.PolicyObject)
+		if err != nil {
+			return action, nil, errors.NewInvalid(err.Error())
+		}
+		if op.PolicyObject == nil || !utils.JsonValidateWithTypeID(op.PolicyTypeID, string(obj)) {
+			return action, nil, errors.NewInvalid("PolicyObject validation failed: policyObject %v", op.PolicyObject)
+		}
+	case BulkDelete:
+		if !exists {
+			return action, nil, errors.NewNotFound("policy %v of type %v not found", op.PolicyID, op.PolicyTypeID)
+		}
+	}
+	return action, current, nil
+}
+
+func (a *a1pController) applyBulkOperation(ctx context.Context, action BulkAction, op BulkOperation) error {
+	params := op.Params
+	if params == nil {
+		params = make(map[string]string)
+	}
+	switch action {
+	case BulkCreate:
+		return a.HandlePolicyCreate(ctx, op.PolicyID, op.PolicyTypeID, params, op.PolicyObject)
+	case BulkUpdate:
+		return a.HandlePolicyUpdate(ctx, op.PolicyID, op.PolicyTypeID, params, op.PolicyObject)
+	case BulkDelete:
+		return a.HandlePolicyDelete(ctx, op.PolicyID, op.PolicyTypeID)
+	}
+	return nil
+}
+
+// compensateBulkOperation restores the revision the policy had before the operation; a policy only the xApps held
+// cannot be restored
+func (a *a1pController) compensateBulkOperation(ctx context.Context, result *BulkResult, previous *store.PolicyRevision) error {
+	if previous == nil && result.Action != BulkCreate {
+		return errors.NewNotSupported("A1T has no revision of policy %v of type %v to restore", result.PolicyID, result.PolicyTypeID)
+	}
+	params := make(map[string]string)
+	if previous != nil && previous.Params != nil {
+		params = previous.Params
+	}
+	switch result.Action {
+	case BulkCreate:
+		return a.auditPolicyOperation(ctx, audit.PolicyRollback, result.PolicyID, result.PolicyTypeID, nil, nil, func(ctx context.Context) error {
+			return a.policyDelete(ctx, result.PolicyID, result.PolicyTypeID)
+		})
+	case BulkUpdate:
+		return a.auditPolicyOperation(ctx, audit.PolicyRollback, result.PolicyID, result.PolicyTypeID, params, previous.PolicyObject, func(ctx context.Context) error {
+			return a.policyUpdate(ctx, result.PolicyID, result.PolicyTypeID, params, previous.PolicyObject)
+		})
+	case BulkDelete:
+		return a.auditPolicyOperation(ctx, audit.PolicyRollback, result.PolicyID, result.PolicyTypeID, params, previous.PolicyObject, func(ctx context.Context) error {
+			return a.policyCreate(ctx, result.PolicyID, result.PolicyTypeID, params, previous.PolicyObject)
+		})
+	}
+	return nil
+}
+
+func countFailed(results []*BulkResult) int {
+	n := 0
+	for _, r := range results {
+		if r.Err != nil {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/policytype"
+	"github.com/onosproject/onos-a1t/pkg/rnib"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicyTypeID = "ORAN_QoSTarget_2.0.0"
+
+// fakeTopoClient targets the policies of every type to the same xApps
+type fakeTopoClient struct {
+	rnib.TopoClient
+	xAppIDs []string
+}
+
+func (c *fakeTopoClient) GetXAppIDsForPolicyTypeID(ctx context.Context, policyTypeID string) ([]string, error) {
+	return c.xAppIDs, nil
+}
+
+// fakeXApps answers the policy requests with the policies each xApp holds; the xApps reject the policies in failing
+type fakeXApps struct {
+	policies map[string]map[string][]byte
+	failing  map[string]bool
+	mu       sync.Mutex
+}
+
+func newFakeXApps(xAppIDs ...string) *fakeXApps {
+	x := &fakeXApps{
+		policies: make(map[string]map[string][]byte),
+		failing:  make(map[string]bool),
+	}
+	for _, xAppID := range xAppIDs {
+		x.policies[xAppID] = make(map[string][]byte)
+	}
+	return x
+}
+
+func (x *fakeXApps) Request(ctx context.Context, id stream.ID, message *stream.SBStreamMessage) (*stream.SBStreamMessage, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	req := message.Payload.(*a1.PolicyRequestMessage)
+	policies := x.policies[message.TargetXAppID]
+	result := &a1.Result{Success: true}
+	var payload []byte
+	switch message.A1SBIRPCType {
+	case stream.PolicySetup, stream.PolicyUpdate:
+		if x.failing[req.PolicyId] {
+			result = &a1.Result{Reason: "policy rejected"}
+		} else {
+			policies[req.PolicyId] = req.Message.Payload
+		}
+	case stream.PolicyDelete:
+		delete(policies, req.PolicyId)
+	case stream.PolicyQuery:
+		if req.PolicyId == "" {
+			policyIDs := make([]string, 0, len(policies))
+			for policyID := range policies {
+				policyIDs = append(policyIDs, policyID)
+			}
+			sort.Strings(policyIDs)
+			payload, _ = json.Marshal(policyIDs)
+		} else if obj, ok := policies[req.PolicyId]; ok {
+			payload = obj
+		} else {
+			result = &a1.Result{Reason: "policy not found"}
+		}
+	}
+	return stream.NewSBStreamMessage(message.TargetXAppID, stream.PolicyResultMessage, message.A1SBIRPCType, stream.PolicyManagement, &a1.PolicyResultMessage{
+		PolicyId:   req.PolicyId,
+		PolicyType: req.PolicyType,
+		Message:    &a1.ResultMessage{Header: req.Message.Header, Payload: payload, Result: result},
+	}), nil
+}
+
+// policy returns the policy object the xApp holds, or nil
+func (x *fakeXApps) policy(xAppID, policyID string) map[string]interface{} {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	obj, ok := x.policies[xAppID][policyID]
+	if !ok {
+		return nil
+	}
+	policyObject := make(map[string]interface{})
+	_ = json.Unmarshal(obj, &policyObject)
+	return policyObject
+}
+
+// newTestA1PController returns a controller sending the policies of every type to the fake xApps
+func newTestA1PController(xApps *fakeXApps, policyTypes policytype.Registry) *a1pController {
+	config := DefaultConfig()
+	if policyTypes != nil {
+		config.PolicyTypes = policyTypes
+	}
+	xAppIDs := make([]string, 0, len(xApps.policies))
+	for xAppID := range xApps.policies {
+		xAppIDs = append(xAppIDs, xAppID)
+	}
+	sort.Strings(xAppIDs)
+	a := NewA1PController(store.NewStore(), store.NewStore(), &fakeTopoClient{xAppIDs: xAppIDs}, stream.NewBroker(stream.DefaultBrokerConfig()), audit.NewLog(0), config).(*a1pController)
+	a.requester = xApps
+	return a
+}
+
+// newQoSTarget returns a QoS target policy object for the QoS flow with the priority level
+func newQoSTarget(qosID int, priorityLevel float64) map[string]interface{} {
+	return map[string]interface{}{
+		"scope":         map[string]interface{}{"qosId": map[string]interface{}{"5qI": float64(qosID)}},
+		"qosObjectives": map[string]interface{}{"priorityLevel": priorityLevel},
+	}
+}
+
+func TestPolicyBulkAtomic(t *testing.T) {
+	ctx := context.Background()
+	xApps := newFakeXApps("xapp-1", "xapp-2")
+	xApps.failing["failing"] = true
+	a := newTestA1PController(xApps, nil)
+	require.NoError(t, a.HandlePolicyCreate(ctx, "existing", testPolicyTypeID, nil, newQoSTarget(1, 1)))
+
+	// the operations applied before one failed are compensated in all xApps
+	results, err := a.HandlePolicyBulk(ctx, []BulkOperation{
+		{Action: BulkPut, PolicyTypeID: testPolicyTypeID, PolicyID: "new", PolicyObject: newQoSTarget(2, 1)},
+		{Action: BulkPut, PolicyTypeID: testPolicyTypeID, PolicyID: "existing", PolicyObject: newQoSTarget(1, 2)},
+		{Action: BulkCreate, PolicyTypeID: testPolicyTypeID, PolicyID: "failing", PolicyObject: newQoSTarget(3, 1)},
+	}, true)
+	assert.True(t, errors.IsInternal(err))
+	require.Len(t, results, 3)
+	assert.Equal(t, BulkCreate, results[0].Action)
+	assert.Equal(t, BulkUpdate, results[1].Action)
+	for _, r := range results[:2] {
+		assert.NoError(t, r.Err)
+		assert.True(t, r.Applied)
+		assert.True(t, r.Compensated)
+	}
+	assert.Error(t, results[2].Err)
+	assert.False(t, results[2].Applied)
+	for _, xAppID := range []string{"xapp-1", "xapp-2"} {
+		assert.Nil(t, xApps.policy(xAppID, "new"))
+		assert.Equal(t, newQoSTarget(1, 1), xApps.policy(xAppID, "existing"))
+		assert.Nil(t, xApps.policy(xAppID, "failing"))
+	}
+
+	// without atomic, the operations that succeeded stay applied
+	results, err = a.HandlePolicyBulk(ctx, []BulkOperation{
+		{Action: BulkCreate, PolicyTypeID: testPolicyTypeID, PolicyID: "new", PolicyObject: newQoSTarget(2, 1)},
+		{Action: BulkDelete, PolicyTypeID: testPolicyTypeID, PolicyID: "existing"},
+		{Action: BulkCreate, PolicyTypeID: testPolicyTypeID, PolicyID: "failing", PolicyObject: newQoSTarget(3, 1)},
+	}, false)
+	assert.True(t, errors.IsInternal(err))
+	assert.True(t, results[0].Applied)
+	assert.True(t, results[1].Applied)
+	assert.False(t, results[0].Compensated)
+	assert.Equal(t, newQoSTarget(2, 1), xApps.policy("xapp-1", "new"))
+	assert.Nil(t, xApps.policy("xapp-1", "existing"))
+}
+
+func TestPolicyBulkValidation(t *testing.T) {
+	ctx := context.Background()
+	xApps := newFakeXApps("xapp-1")
+	a := newTestA1PController(xApps, nil)
+	require.NoError(t, a.HandlePolicyCreate(ctx, "existing", testPolicyTypeID, nil, newQoSTarget(1, 1)))
+
+	// a single invalid operation rejects the whole batch before anything is sent
+	results, err := a.HandlePolicyBulk(ctx, []BulkOperation{
+		{Action: BulkCreate, PolicyTypeID: testPolicyTypeID, PolicyID: "new", PolicyObject: newQoSTarget(2, 1)},
+		{Action: BulkCreate, PolicyTypeID: testPolicyTypeID, PolicyID: "existing", PolicyObject: newQoSTarget(1, 1)},
+		{Action: BulkUpdate, PolicyTypeID: testPolicyTypeID, PolicyID: "missing", PolicyObject: newQoSTarget(3, 1)},
+		{Action: BulkDelete, PolicyTypeID: testPolicyTypeID, PolicyID: "new"},
+		{Action: BulkCreate, PolicyTypeID: "unknown", PolicyID: "new", PolicyObject: newQoSTarget(2, 1)},
+		{Action: BulkCreate, PolicyTypeID: testPolicyTypeID, PolicyID: "invalid", PolicyObject: map[string]interface{}{"scope": 1}},
+	}, false)
+	assert.True(t, errors.IsInvalid(err))
+	assert.NoError(t, results[0].Err)
+	assert.True(t, errors.IsAlreadyExists(results[1].Err))
+	assert.True(t, errors.IsNotFound(results[2].Err))
+	assert.True(t, errors.IsInvalid(results[3].Err))
+	assert.True(t, errors.IsNotSupported(results[4].Err))
+	assert.True(t, errors.IsInvalid(results[5].Err))
+	for _, r := range results {
+		assert.False(t, r.Applied)
+	}
+	assert.Nil(t, xApps.policy("xapp-1", "new"))
+}
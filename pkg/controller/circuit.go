@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests to the xApp through
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects the requests to the xApp without sending them
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests through to find out if the xApp recovered
+	CircuitHalfOpen
+)
+
+func (c CircuitState) String() string {
+	return [...]string{"Closed", "Open", "HalfOpen"}[c]
+}
+
+// AggregationPolicy defines how a policy fan-out treats the xApps whose circuit is open
+type AggregationPolicy int
+
+const (
+	// FailFast fails the operation on the xApps whose circuit is open, without waiting for them
+	FailFast AggregationPolicy = iota
+	// SkipOpen leaves out the xApps whose circuit is open, so that the operation succeeds if all other xApps succeed
+	SkipOpen
+)
+
+func (a AggregationPolicy) String() string {
+	return [...]string{"FailFast", "SkipOpen"}[a]
+}
+
+func ParseAggregationPolicy(policy string) (AggregationPolicy, error) {
+	for _, a := range []AggregationPolicy{FailFast, SkipOpen} {
+		if strings.EqualFold(policy, a.String()) {
+			return a, nil
+		}
+	}
+	return FailFast, errors.NewInvalid("unknown aggregation policy %v", policy)
+}
+
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive timeouts or delivery errors after which the circuit opens
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before probing the xApp
+	OpenTimeout time.Duration
+	// HalfOpenProbes is the number of concurrent probe requests while the circuit is half-open
+	HalfOpenProbes    int
+	AggregationPolicy AggregationPolicy
+}
+
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		FailureThreshold:  5,
+		OpenTimeout:       30 * time.Second,
+		HalfOpenProbes:    1,
+		AggregationPolicy: FailFast,
+	}
+}
+
+// CircuitStatus is the state of the circuit breaker of an xApp
+type CircuitStatus struct {
+	XAppID              string
+	State               CircuitState
+	ConsecutiveFailures int
+	LastError           string
+	Since               time.Time
+}
+
+// CircuitEvent is emitted on every state change of a circuit breaker
+type CircuitEvent struct {
+	XAppID    string
+	From      CircuitState
+	To        CircuitState
+	Reason    string
+	Timestamp time.Time
+}
+
+// CircuitOpenError is the error of the requests rejected because the circuit of the xApp is open
+type CircuitOpenError struct {
+	XAppID  string
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit of xApp %v is open until %v", e.XAppID, e.RetryAt.Format(time.RFC3339))
+}
+
+func IsCircuitOpen(err error) bool {
+	_, ok := err.(*CircuitOpenError)
+	return ok
+}
+
+type circuit struct {
+	status   CircuitStatus
+	openedAt time.Time
+	probes   int
+}
+
+// circuitBreakers keeps one circuit breaker per xApp
+type circuitBreakers struct {
+	config   CircuitBreakerConfig
+	circuits map[string]*circuit
+	watchers map[uuid.UUID]chan<- CircuitEvent
+	mu       sync.Mutex
+}
+
+func newCircuitBreakers(config CircuitBreakerConfig) *circuitBreakers {
+	return &circuitBreakers{
+		config:   config,
+		circuits: make(map[string]*circuit),
+		watchers: make(map[uuid.UUID]chan<- CircuitEvent),
+	}
+}
+
+// allow returns a CircuitOpenError if the request to the xApp must not be sent; otherwise done has to be called
+// with the outcome of the request
+func (b *circuitBreakers) allow(xAppID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.getCircuit(xAppID)
+	switch c.status.State {
+	case CircuitOpen:
+		retryAt := c.openedAt.Add(b.config.OpenTimeout)
+		if time.Now().Before(retryAt) {
+			return &CircuitOpenError{XAppID: xAppID, RetryAt: retryAt}
+		}
+		b.transition(c, CircuitHalfOpen, "open timeout expired - probing")
+		c.probes = 1
+	case CircuitHalfOpen:
+		if c.probes >= b.config.HalfOpenProbes {
+			return &CircuitOpenError{XAppID: xAppID, RetryAt: time.Now().Add(b.config.OpenTimeout)}
+		}
+		c.probes++
+	}
+	return nil
+}
+
+func (b *circuitBreakers) done(xAppID string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.getCircuit(xAppID)
+	if c.status.State == CircuitHalfOpen && c.probes > 0 {
+		c.probes--
+	}
+	if err == nil {
+		c.status.ConsecutiveFailures = 0
+		if c.status.State != CircuitClosed {
+			b.transition(c, CircuitClosed, "request succeeded")
+		}
+		return
+	}
+
+	c.status.ConsecutiveFailures++
+	c.status.LastError = err.Error()
+	switch c.status.State {
+	case CircuitHalfOpen:
+		c.openedAt = time.Now()
+		b.transition(c, CircuitOpen, fmt.Sprintf("probe failed: %v", err))
+	case CircuitClosed:
+		if c.status.ConsecutiveFailures >= b.config.FailureThreshold {
+			c.openedAt = time.Now()
+			b.transition(c, CircuitOpen, fmt.Sprintf("%d consecutive failures, last: %v", c.status.ConsecutiveFailures, err))
+		}
+	}
+}
+
+// release ends a request allowed by the circuit without a verdict on the xApp, e.g. as the caller gave up on it
+func (b *circuitBreakers) release(xAppID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.getCircuit(xAppID)
+	if c.status.State == CircuitHalfOpen && c.probes > 0 {
+		c.probes--
+	}
+}
+
+func (b *circuitBreakers) getCircuit(xAppID string) *circuit {
+	c, ok := b.circuits[xAppID]
+	if !ok {
+		c = &circuit{
+			status: CircuitStatus{
+				XAppID: xAppID,
+				State:  CircuitClosed,
+				Since:  time.Now(),
+			},
+		}
+		b.circuits[xAppID] = c
+	}
+	return c
+}
+
+func (b *circuitBreakers) transition(c *circuit, state CircuitState, reason string) {
+	event := CircuitEvent{
+		XAppID:    c.status.XAppID,
+		From:      c.status.State,
+		To:        state,
+		Reason:    reason,
+		Timestamp: time.Now(),
+	}
+	log.Infof("Circuit of xApp %v: %v -> %v (%v)", event.XAppID, event.From, event.To, reason)
+	c.status.State = state
+	c.status.Since = event.Timestamp
+	circuitTransitions.WithLabelValues(event.XAppID, state.String()).Inc()
+	circuitState.WithLabelValues(event.XAppID).Set(float64(state))
+
+	for id, ch := range b.watchers {
+		select {
+		case ch <- event:
+		default:
+			log.Warnf("Circuit event watcher %v is full - event %v dropped", id, event)
+		}
+	}
+}
+
+func (b *circuitBreakers) list() []CircuitStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	results := make([]CircuitStatus, 0, len(b.circuits))
+	for _, c := range b.circuits {
+		results = append(results, c.status)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].XAppID < results[j].XAppID
+	})
+	return results
+}
+
+// watch sends the circuit events to ch until the context is done; ch should be buffered as slow watchers lose events
+func (b *circuitBreakers) watch(ctx context.Context, ch chan<- CircuitEvent) {
+	id := uuid.New()
+	b.mu.Lock()
+	b.watchers[id] = ch
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.watchers, id)
+		b.mu.Unlock()
+		close(ch)
+	}()
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newCircuitBreakers(CircuitBreakerConfig{FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond, HalfOpenProbes: 1})
+	events := make(chan CircuitEvent, 8)
+	b.watch(ctx, events)
+	failure := errors.NewTimeout("no answer")
+
+	// a success resets the consecutive failures
+	assert.NoError(t, b.allow("xapp-1"))
+	b.done("xapp-1", failure)
+	assert.NoError(t, b.allow("xapp-1"))
+	b.done("xapp-1", nil)
+	assert.NoError(t, b.allow("xapp-1"))
+	b.done("xapp-1", failure)
+	assert.Equal(t, CircuitClosed, b.list()[0].State)
+
+	assert.NoError(t, b.allow("xapp-1"))
+	b.done("xapp-1", failure)
+	status := b.list()[0]
+	assert.Equal(t, CircuitOpen, status.State)
+	assert.Equal(t, 2, status.ConsecutiveFailures)
+	assert.Equal(t, failure.Error(), status.LastError)
+	event := <-events
+	assert.Equal(t, CircuitClosed, event.From)
+	assert.Equal(t, CircuitOpen, event.To)
+
+	err := b.allow("xapp-1")
+	assert.True(t, IsCircuitOpen(err))
+	assert.NoError(t, b.allow("xapp-2"))
+
+	// once the open timeout expired, a single probe goes through and its failure opens the circuit again
+	time.Sleep(60 * time.Millisecond)
+	assert.NoError(t, b.allow("xapp-1"))
+	assert.Equal(t, CircuitHalfOpen, (<-events).To)
+	assert.True(t, IsCircuitOpen(b.allow("xapp-1")))
+	b.done("xapp-1", failure)
+	assert.Equal(t, CircuitOpen, (<-events).To)
+	assert.True(t, IsCircuitOpen(b.allow("xapp-1")))
+
+	// a successful probe closes the circuit
+	time.Sleep(60 * time.Millisecond)
+	assert.NoError(t, b.allow("xapp-1"))
+	assert.Equal(t, CircuitHalfOpen, (<-events).To)
+	b.done("xapp-1", nil)
+	assert.Equal(t, CircuitClosed, (<-events).To)
+	assert.NoError(t, b.allow("xapp-1"))
+	assert.Equal(t, 0, b.list()[0].ConsecutiveFailures)
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestParseAggregationPolicy(t *testing.T) {
+	policy, err := ParseAggregationPolicy("skipopen")
+	assert.NoError(t, err)
+	assert.Equal(t, SkipOpen, policy)
+	_, err = ParseAggregationPolicy("other")
+	assert.True(t, errors.IsInvalid(err))
+}
+
+// blockingRequester fails the requests with err, or with the error of their context once it is done if err is nil
+type blockingRequester struct {
+	err error
+}
+
+func (r *blockingRequester) Request(ctx context.Context, id stream.ID, message *stream.SBStreamMessage) (*stream.SBStreamMessage, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestSendPolicyRequestCircuit(t *testing.T) {
+	requester := &blockingRequester{}
+	a := &a1pController{
+		requester:       requester,
+		circuitBreakers: newCircuitBreakers(CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenProbes: 1}),
+	}
+
+	// the requests the caller gave up on do not count against the xApp
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := a.sendPolicyRequest(ctx, "xapp-1", stream.PolicyQuery, &a1.PolicyRequestMessage{})
+	assert.Error(t, err)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = a.sendPolicyRequest(ctx, "xapp-1", stream.PolicyQuery, &a1.PolicyRequestMessage{})
+	assert.Error(t, err)
+	status := a.circuitBreakers.list()[0]
+	assert.Equal(t, CircuitClosed, status.State)
+	assert.Equal(t, 0, status.ConsecutiveFailures)
+
+	// an xApp which does not answer in time does
+	requester.err = errors.NewTimeout("no answer")
+	_, err = a.sendPolicyRequest(context.Background(), "xapp-1", stream.PolicyQuery, &a1.PolicyRequestMessage{})
+	assert.Error(t, err)
+	assert.Equal(t, CircuitOpen, a.circuitBreakers.list()[0].State)
+}
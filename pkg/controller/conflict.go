@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/onosproject/onos-a1t/pkg/policytype"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// PolicyConflict is an existing policy whose scope overlaps with the scope of the policy being written
+type PolicyConflict struct {
+	PolicyTypeID string
+	PolicyID     string
+	// Scope is the overlapping scope identifier, e.g. ueId=0000000000000001
+	Scope string
+}
+
+func (c *PolicyConflict) String() string {
+	return fmt.Sprintf("%v/%v (%v)", c.PolicyTypeID, c.PolicyID, c.Scope)
+}
+
+// ConflictDetector finds the existing policies a new policy object conflicts with
+type ConflictDetector interface {
+	// Detect returns the policies the policy object conflicts with
+	Detect(policyTypeID, policyID string, policyObject map[string]interface{}) []*PolicyConflict
+	// Index records the policy object once written; a nil policy object removes the policy
+	Index(policyTypeID, policyID string, policyObject map[string]interface{})
+}
+
+// NewScopeConflictDetector returns a detector indexing the policies by scope and applying the conflict rules of the registry
+func NewScopeConflictDetector(registry policytype.Registry) ConflictDetector {
+	return &scopeConflictDetector{
+		registry: registry,
+		byScope:  make(map[string]map[store.PolicyIntentKey]bool),
+		byPolicy: make(map[store.PolicyIntentKey][]string),
+	}
+}
+
+type scopeConflictDetector struct {
+	registry policytype.Registry
+	byScope  map[string]map[store.PolicyIntentKey]bool
+	byPolicy map[store.PolicyIntentKey][]string
+	mu       sync.RWMutex
+}
+
+func (d *scopeConflictDetector) Detect(policyTypeID, policyID string, policyObject map[string]interface{}) []*PolicyConflict {
+	conflicts := make([]*PolicyConflict, 0)
+	t, ok := d.registry.Get(policyTypeID)
+	if !ok || t.Conflicts == nil {
+		return conflicts
+	}
+	self := store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	for _, scope := range getScopeKeys(policyObject) {
+		if !t.Conflicts.ComparesScope(strings.SplitN(scope, "=", 2)[0]) {
+			continue
+		}
+		for key := range d.byScope[scope] {
+			if key == self || (key.PolicyTypeID != policyTypeID && !d.registry.Related(policyTypeID, key.PolicyTypeID)) {
+				continue
+			}
+			conflicts = append(conflicts, &PolicyConflict{
+				PolicyTypeID: key.PolicyTypeID,
+				PolicyID:     key.PolicyID,
+				Scope:        scope,
+			})
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].String() < conflicts[j].String()
+	})
+	return conflicts
+}
+
+func (d *scopeConflictDetector) Index(policyTypeID, policyID string, policyObject map[string]interface{}) {
+	key := store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, scope := range d.byPolicy[key] {
+		delete(d.byScope[scope], key)
+		if len(d.byScope[scope]) == 0 {
+			delete(d.byScope, scope)
+		}
+	}
+	delete(d.byPolicy, key)
+	if policyObject == nil {
+		return
+	}
+
+	scopes := getScopeKeys(policyObject)
+	for _, scope := range scopes {
+		if _, ok := d.byScope[scope]; !ok {
+			d.byScope[scope] = make(map[store.PolicyIntentKey]bool)
+		}
+		d.byScope[scope][key] = true
+	}
+	d.byPolicy[key] = scopes
+}
+
+// getScopeKeys returns the scope identifiers of the policy object as name=value, with the value in canonical JSON
+func getScopeKeys(policyObject map[string]interface{}) []string {
+	keys := make([]string, 0)
+	scope, ok := policyObject["scope"].(map[string]interface{})
+	if !ok {
+		return keys
+	}
+	for _, name := range policytype.Scopes {
+		value, ok := scope[name]
+		if !ok {
+			continue
+		}
+		// json.Marshal sorts the map keys, so equal identifiers give equal keys
+		b, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, name+"="+string(b))
+	}
+	return keys
+}
+
+type conflictReportKey struct{}
+
+// ConflictReport collects the conflicts the policy writes of a request were allowed with
+type ConflictReport struct {
+	conflicts []*PolicyConflict
+	mu        sync.Mutex
+}
+
+// WithConflictReport returns a context collecting the conflicts allowed by the Warn and Priority rules
+func WithConflictReport(ctx context.Context) (context.Context, *ConflictReport) {
+	report := &ConflictReport{
+		conflicts: make([]*PolicyConflict, 0),
+	}
+	return context.WithValue(ctx, conflictReportKey{}, report), report
+}
+
+func (r *ConflictReport) Conflicts() []*PolicyConflict {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append(make([]*PolicyConflict, 0, len(r.conflicts)), r.conflicts...)
+}
+
+func (r *ConflictReport) add(conflicts []*PolicyConflict) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.conflicts = append(r.conflicts, conflicts...)
+}
+
+// checkConflicts applies the conflict rule of the policy type: it returns a Conflict error if the policy has to be
+// rejected, otherwise the conflicts are reported in the context
+func (a *a1pController) checkConflicts(ctx context.Context, policyTypeID, policyID string, policyObject map[string]interface{}) error {
+	conflicts := a.conflictDetector.Detect(policyTypeID, policyID, policyObject)
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	action := policytype.ConflictWarn
+	priority := 0
+	if t, ok := a.policyTypes.Get(policyTypeID); ok && t.Conflicts != nil {
+		action = t.Conflicts.Action
+		priority = t.Conflicts.Priority
+	}
+
+	reject := action == policytype.ConflictReject
+	if action == policytype.ConflictPriority {
+		for _, c := range conflicts {
+			if t, ok := a.policyTypes.Get(c.PolicyTypeID); ok && t.Conflicts != nil && t.Conflicts.Priority > priority {
+				reject = true
+			}
+		}
+	}
+	if reject {
+		descriptions := make([]string, 0, len(conflicts))
+		for _, c := range conflicts {
+			descriptions = append(descriptions, c.String())
+		}
+		return errors.NewConflict("policy %v of type %v conflicts with %v", policyID, policyTypeID, strings.Join(descriptions, ", "))
+	}
+
+	log.Warnf("Policy %v of type %v overlaps with %v", policyID, policyTypeID, conflicts)
+	if report, ok := ctx.Value(conflictReportKey{}).(*ConflictReport); ok {
+		report.add(conflicts)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onosproject/onos-a1t/pkg/policytype"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newScopedPolicy(scope map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"scope": scope}
+}
+
+func TestPolicyConflicts(t *testing.T) {
+	ctx := context.Background()
+	xApps := newFakeXApps("xapp-1")
+	a := newTestA1PController(xApps, policytype.NewRegistry(
+		&policytype.PolicyType{PolicyTypeID: "type-reject", Conflicts: &policytype.ConflictRule{Action: policytype.ConflictReject, Scopes: []string{"cellId"}}},
+		&policytype.PolicyType{PolicyTypeID: "type-warn", Conflicts: &policytype.ConflictRule{Action: policytype.ConflictWarn, RelatedTypes: []string{"type-reject"}}},
+		&policytype.PolicyType{PolicyTypeID: "type-high", Conflicts: &policytype.ConflictRule{Action: policytype.ConflictPriority, Priority: 2, RelatedTypes: []string{"type-low"}}},
+		&policytype.PolicyType{PolicyTypeID: "type-low", Conflicts: &policytype.ConflictRule{Action: policytype.ConflictPriority, Priority: 1}},
+	))
+	cell1 := map[string]interface{}{"cId": map[string]interface{}{"ncI": 1.0}}
+	cell2 := map[string]interface{}{"cId": map[string]interface{}{"ncI": 2.0}}
+
+	// the rule of the type only compares the cells, and a policy does not conflict with itself
+	require.NoError(t, a.HandlePolicyCreate(ctx, "p1", "type-reject", nil, newScopedPolicy(map[string]interface{}{"cellId": cell1, "ueId": "0000000000000001"})))
+	err := a.HandlePolicyCreate(ctx, "p2", "type-reject", nil, newScopedPolicy(map[string]interface{}{"cellId": cell1}))
+	assert.True(t, errors.IsConflict(err))
+	assert.Nil(t, xApps.policy("xapp-1", "p2"))
+	assert.NoError(t, a.HandlePolicyCreate(ctx, "p3", "type-reject", nil, newScopedPolicy(map[string]interface{}{"ueId": "0000000000000001"})))
+	assert.NoError(t, a.HandlePolicyUpdate(ctx, "p1", "type-reject", nil, newScopedPolicy(map[string]interface{}{"cellId": cell1})))
+
+	// a related type warns about the overlap, an unrelated type without rules does not compare scopes
+	reportCtx, report := WithConflictReport(ctx)
+	assert.NoError(t, a.HandlePolicyCreate(reportCtx, "w1", "type-warn", nil, newScopedPolicy(map[string]interface{}{"cellId": cell1})))
+	assert.Equal(t, []*PolicyConflict{{PolicyTypeID: "type-reject", PolicyID: "p1", Scope: `cellId={"cId":{"ncI":1}}`}}, report.Conflicts())
+	reportCtx, report = WithConflictReport(ctx)
+	assert.NoError(t, a.HandlePolicyCreate(reportCtx, "o1", "type-other", nil, newScopedPolicy(map[string]interface{}{"cellId": cell1})))
+	assert.Empty(t, report.Conflicts())
+
+	// a policy is rejected if it overlaps with a policy of higher priority
+	require.NoError(t, a.HandlePolicyCreate(ctx, "h1", "type-high", nil, newScopedPolicy(map[string]interface{}{"cellId": cell2})))
+	err = a.HandlePolicyCreate(ctx, "l1", "type-low", nil, newScopedPolicy(map[string]interface{}{"cellId": cell2}))
+	assert.True(t, errors.IsConflict(err))
+	assert.NoError(t, a.HandlePolicyCreate(ctx, "h2", "type-high", nil, newScopedPolicy(map[string]interface{}{"cellId": cell2})))
+
+	// deleted policies leave the index
+	require.NoError(t, a.HandlePolicyDelete(ctx, "p1", "type-reject"))
+	require.NoError(t, a.HandlePolicyDelete(ctx, "w1", "type-warn"))
+	assert.NoError(t, a.HandlePolicyCreate(ctx, "p2", "type-reject", nil, newScopedPolicy(map[string]interface{}{"cellId": cell1})))
+}
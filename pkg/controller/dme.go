@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-a1t/pkg/dme"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+const (
+	infoJobResultEndpoint = "results"
+	infoJobStatusEndpoint = "status-notifications"
+)
+
+func (a1ei *a1eiController) HandleGetInfoTypes(ctx context.Context) ([]string, error) {
+	return a1ei.dmeClient.GetInfoTypes(ctx)
+}
+
+func (a1ei *a1eiController) HandleGetInfoType(ctx context.Context, infoTypeID string) (dme.InfoTypeObject, error) {
+	return a1ei.dmeClient.GetInfoType(ctx, infoTypeID)
+}
+
+// HandleGetInfoJobs returns the IDs of the jobs A1T created, filtered by type and owner if they are not empty
+func (a1ei *a1eiController) HandleGetInfoJobs(ctx context.Context, infoTypeID, owner string) []string {
+	infoJobIDs := make([]string, 0)
+	for infoJobID, job := range store.ListInfoJobs(ctx, a1ei.eijobsStore, infoTypeID) {
+		if owner == "" || job.JobOwner == owner {
+			infoJobIDs = append(infoJobIDs, infoJobID)
+		}
+	}
+	sort.Strings(infoJobIDs)
+	return infoJobIDs
+}
+
+func (a1ei *a1eiController) HandleGetInfoJob(ctx context.Context, infoJobID string) (*dme.InfoJobObject, error) {
+	job, err := store.GetInfoJob(ctx, a1ei.eijobsStore, infoJobID)
+	if err != nil {
+		return nil, err
+	}
+	return &dme.InfoJobObject{
+		InfoTypeID:               job.InfoTypeID,
+		JobOwner:                 job.JobOwner,
+		JobDefinition:            job.JobDefinition,
+		JobResultURI:             job.JobResultURI,
+		JobStatusNotificationURI: job.JobStatusNotificationURI,
+	}, nil
+}
+
+// HandleInfoJobPut creates or updates the job of a REST consumer at the Non-RT RIC; the results go straight to the
+// consumer, while the status notifications go through A1T if a callback URL is configured
+func (a1ei *a1eiController) HandleInfoJobPut(ctx context.Context, infoJobID string, job *dme.InfoJobObject) (bool, error) {
+	if job.InfoTypeID == "" {
+		return false, errors.NewInvalid("information job %v has no information type", infoJobID)
+	}
+	a1ei.jobsMu.Lock()
+	defer a1ei.jobsMu.Unlock()
+
+	if current, err := store.GetInfoJob(ctx, a1ei.eijobsStore, infoJobID); err == nil && len(current.Consumers) > 0 {
+		return false, errors.NewConflict("information job %v is managed by the xApps %v", infoJobID, consumerIDs(current))
+	}
+
+	value := &store.InfoJobValue{
+		InfoTypeID:               job.InfoTypeID,
+		JobOwner:                 job.JobOwner,
+		JobDefinition:            job.JobDefinition,
+		JobResultURI:             job.JobResultURI,
+		JobStatusNotificationURI: job.JobStatusNotificationURI,
+		Timestamp:                time.Now(),
+	}
+	remote := *job
+	if a1ei.callbackURL != "" {
+		remote.JobStatusNotificationURI = a1ei.callbackURI(infoJobID, infoJobStatusEndpoint)
+	}
+	created, err := a1ei.dmeClient.PutInfoJob(ctx, infoJobID, &remote)
+	if err != nil {
+		return false, err
+	}
+	log.Infof("Information job %v of type %v set up at the Non-RT RIC", infoJobID, job.InfoTypeID)
+	return created, store.PutInfoJob(ctx, a1ei.eijobsStore, infoJobID, value)
+}
+
+func (a1ei *a1eiController) HandleInfoJobDelete(ctx context.Context, infoJobID string) error {
+	a1ei.jobsMu.Lock()
+	defer a1ei.jobsMu.Unlock()
+	return a1ei.deleteInfoJob(ctx, infoJobID)
+}
+
+func (a1ei *a1eiController) deleteInfoJob(ctx context.Context, infoJobID string) error {
+	err := a1ei.dmeClient.DeleteInfoJob(ctx, infoJobID)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	log.Infof("Information job %v deleted", infoJobID)
+	return store.DeleteInfoJob(ctx, a1ei.eijobsStore, infoJobID)
+}
+
+// HandleGetInfoJobStatus returns the status of the job from the Non-RT RIC, or the last status it notified if it cannot be reached
+func (a1ei *a1eiController) HandleGetInfoJobStatus(ctx context.Context, infoJobID string) (*dme.InfoJobStatusObject, error) {
+	job, err := store.GetInfoJob(ctx, a1ei.eijobsStore, infoJobID)
+	if err != nil {
+		return nil, err
+	}
+	status, err := a1ei.dmeClient.GetInfoJobStatus(ctx, infoJobID)
+	if err != nil {
+		if job.Status == "" || !errors.IsUnavailable(err) {
+			return nil, err
+		}
+		log.Warn(err)
+		return &dme.InfoJobStatusObject{InfoJobStatus: job.Status}, nil
+	}
+	return status, nil
+}
+
+// HandleInfoJobResult delivers the data produced for the job to the xApps consuming it
+func (a1ei *a1eiController) HandleInfoJobResult(ctx context.Context, infoJobID string, result []byte) error {
+	job, err := store.GetInfoJob(ctx, a1ei.eijobsStore, infoJobID)
+	if err != nil {
+		return err
+	}
+	var resErr error
+	for _, xAppID := range a1ei.interestedConsumers(ctx, infoJobID, job) {
+		msg := &a1.EIResultMessage{
+			EiJobId: infoJobID,
+			Message: &a1.ResultMessage{
+				Header:  newEIHeader(xAppID),
+				Payload: result,
+			},
+		}
+		if err := a1ei.sendToXApp(ctx, xAppID, stream.EIResultMessage, stream.EIJobResultDelivery, msg); err != nil {
+			log.Warnf("Failed to deliver the result of information job %v to xApp %v: %v", infoJobID, xAppID, err)
+			resErr = err
+		}
+	}
+	a1ei.recordResult(ctx, infoJobID, result, resErr)
+	return resErr
+}
+
+// recordResult keeps the last result of the job for the operators
+func (a1ei *a1eiController) recordResult(ctx context.Context, infoJobID string, result []byte, deliveryErr error) {
+	a1ei.jobsMu.Lock()
+	defer a1ei.jobsMu.Unlock()
+	job, err := store.GetInfoJob(ctx, a1ei.eijobsStore, infoJobID)
+	if err != nil {
+		return
+	}
+	updated := *job
+	updated.LastResult = result
+	updated.LastResultTimestamp = time.Now()
+	updated.LastDeliveryError = ""
+	if deliveryErr != nil {
+		updated.LastDeliveryError = deliveryErr.Error()
+	}
+	if err := store.PutInfoJob(ctx, a1ei.eijobsStore, infoJobID, &updated); err != nil {
+		log.Warn(err)
+	}
+}
+
+// HandleInfoJobStatusNotify records the status notified by the Non-RT RIC and forwards it to the consumers of the job
+func (a1ei *a1eiController) HandleInfoJobStatusNotify(ctx context.Context, infoJobID string, status *dme.InfoJobStatusObject) error {
+	if status.InfoJobStatus != dme.StatusEnabled && status.InfoJobStatus != dme.StatusDisabled {
+		return errors.NewInvalid("invalid status %q of information job %v", status.InfoJobStatus, infoJobID)
+	}
+	a1ei.jobsMu.Lock()
+	job, err := store.GetInfoJob(ctx, a1ei.eijobsStore, infoJobID)
+	if err == nil {
+		updated := *job
+		updated.Status = status.InfoJobStatus
+		err = store.PutInfoJob(ctx, a1ei.eijobsStore, infoJobID, &updated)
+	}
+	a1ei.jobsMu.Unlock()
+	if err != nil {
+		return err
+	}
+	log.Infof("Information job %v is %v", infoJobID, status.InfoJobStatus)
+
+	if job.JobStatusNotificationURI != "" {
+		b, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.JobStatusNotificationURI, bytes.NewBuffer(b))
+		if err != nil {
+			return errors.NewInvalid("invalid status notification URI of information job %v: %v", infoJobID, err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := a1ei.httpClient.Do(req)
+		if err != nil {
+			return errors.NewUnavailable("failed to notify the status of information job %v: %v", infoJobID, err)
+		}
+		resp.Body.Close()
+	}
+
+	// the xApps use the EI status model
+	payload, err := json.Marshal(map[string]string{"eiJobStatus": status.InfoJobStatus})
+	if err != nil {
+		return err
+	}
+	var resErr error
+	for _, xAppID := range a1ei.interestedConsumers(ctx, infoJobID, job) {
+		msg := &a1.EIStatusMessage{
+			EiJobId: infoJobID,
+			Message: &a1.StatusMessage{
+				Header:  newEIHeader(xAppID),
+				Payload: payload,
+			},
+		}
+		if err := a1ei.sendToXApp(ctx, xAppID, stream.EIStatusMessage, stream.EIJobStatusNotify, msg); err != nil {
+			log.Warnf("Failed to notify the status of information job %v to xApp %v: %v", infoJobID, xAppID, err)
+			resErr = err
+		}
+	}
+	return resErr
+}
+
+// sendToXApp sends a message of A1T to the xApp and waits for its ack
+func (a1ei *a1eiController) sendToXApp(ctx context.Context, xAppID string, messageType stream.A1SBIMessageType, rpcType stream.A1SBIRPCType, payload interface{}) error {
+	sbID, _ := stream.GetStreamID(stream.A1EIController, stream.GetEndpointIDWithTargetXAppID(xAppID, stream.EnrichmentInformation))
+	msg := stream.NewSBStreamMessage(xAppID, messageType, rpcType, stream.EnrichmentInformation, payload)
+	resp, err := a1ei.requester.Request(ctx, sbID, msg)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if ack, ok := resp.Payload.(*a1.EIAckMessage); ok && ack.GetMessage().GetResult() != nil && !ack.GetMessage().GetResult().GetSuccess() {
+		return errors.NewUnavailable("xApp %v rejected %v: %v", xAppID, rpcType, ack.GetMessage().GetResult().GetReason())
+	}
+	return nil
+}
+
+func newEIHeader(xAppID string) *a1.Header {
+	return &a1.Header{
+		RequestId: uuid.New().String(),
+		AppId:     xAppID,
+		Encoding:  a1.Encoding_JSON,
+	}
+}
+
+// consumerIDs returns the sorted IDs of the xApps consuming the job
+func consumerIDs(job *store.InfoJobValue) []string {
+	xAppIDs := make([]string, 0, len(job.Consumers))
+	for xAppID := range job.Consumers {
+		xAppIDs = append(xAppIDs, xAppID)
+	}
+	sort.Strings(xAppIDs)
+	return xAppIDs
+}
+
+// sameInfoJob returns whether the job of an xApp can share the existing job
+func sameInfoJob(job *store.InfoJobValue, infoTypeID string, jobDefinition map[string]interface{}) bool {
+	return job.InfoTypeID == infoTypeID && reflect.DeepEqual(job.JobDefinition, jobDefinition)
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-a1t/pkg/dme"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfoJobStatusNotifyTimeout(t *testing.T) {
+	release := make(chan struct{})
+	consumer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer consumer.Close()
+	defer close(release)
+
+	eijobsStore := store.NewStore()
+	assert.NoError(t, store.PutInfoJob(context.Background(), eijobsStore, "job-1", &store.InfoJobValue{
+		InfoTypeID:               "type-1",
+		JobStatusNotificationURI: consumer.URL,
+	}))
+	a1ei := NewA1EIController("", nil, eijobsStore, nil, nil, DefaultConfig())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := a1ei.HandleInfoJobStatusNotify(ctx, "job-1", &dme.InfoJobStatusObject{InfoJobStatus: dme.StatusDisabled})
+	assert.True(t, errors.IsUnavailable(err))
+	assert.Less(t, time.Since(start), statusNotificationTimeout)
+}
@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/onosproject/onos-a1t/pkg/dme"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// eiJobObject is the EI job sent by the xApps on the EI SBI, as defined by A1AP v01.00
+type eiJobObject struct {
+	EiTypeID                 string                 `json:"eiTypeId"`
+	JobDefinition            map[string]interface{} `json:"jobDefinition"`
+	JobResultURI             string                 `json:"jobResultUri"`
+	JobStatusNotificationURI string                 `json:"jobStatusNotificationUri,omitempty"`
+}
+
+// eiTypeQuery is the payload of an EI query; an empty EI type asks for the EI type identifiers
+type eiTypeQuery struct {
+	EiTypeID string `json:"eiTypeId,omitempty"`
+}
+
+// Receiver serves the EI SBI requests of the xApps with the A1-DME jobs of the Non-RT RIC
+func (a1ei *a1eiController) Receiver(ctx context.Context) error {
+	a1ei.watchStreams(ctx)
+	log.Info("Start watching subscription store at a1ei controller")
+	ch := make(chan store.Event)
+	go a1ei.subStoreListener(ctx, ch)
+	err := a1ei.subscriptionStore.Watch(ctx, ch)
+	if err != nil {
+		close(ch)
+		log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (a1ei *a1eiController) subStoreListener(ctx context.Context, ch chan store.Event) {
+	for e := range ch {
+		if e.Type != store.Created {
+			continue
+		}
+		entry := e.Value.(*store.Entry)
+		if err := a1ei.createEventSubStoreHandler(ctx, entry); err != nil {
+			log.Warn(err)
+		}
+	}
+}
+
+// watchStreams watches the northbound stream of an xApp again whenever the southbound sets it up again, since closing
+// the stream dropped the watcher
+func (a1ei *a1eiController) watchStreams(ctx context.Context) {
+	ch := make(chan stream.StreamEvent)
+	a1ei.streamBroker.WatchStreams(ctx, ch)
+	go func() {
+		for e := range ch {
+			if e.Type != stream.StreamAdded || e.ID.DestEndpointID != stream.A1EIController {
+				continue
+			}
+			if err := a1ei.watchStream(ctx, e.ID); err != nil {
+				log.Warn(err)
+			}
+		}
+	}()
+}
+
+func (a1ei *a1eiController) createEventSubStoreHandler(ctx context.Context, entry *store.Entry) error {
+	key := entry.Key.(store.SubscriptionKey)
+	targetXAppID := string(key.TargetXAppID)
+	sbID, nbID := stream.GetStreamID(stream.A1EIController, stream.GetEndpointIDWithTargetXAppID(targetXAppID, stream.EnrichmentInformation))
+	a1ei.streamBroker.AddStream(ctx, nbID)
+	a1ei.streamBroker.AddStream(ctx, sbID)
+	return a1ei.watchStream(ctx, nbID)
+}
+
+// watchStream serves the EI requests the xApp sends on the northbound stream until the stream is closed; an xApp is
+// watched once, however often its subscription changes
+func (a1ei *a1eiController) watchStream(ctx context.Context, nbID stream.ID) error {
+	sbID := stream.ReverseID(nbID)
+	msgCh := make(chan *stream.SBStreamMessage)
+	err := a1ei.streamBroker.Watch(nbID, msgCh, stream.GetWatcherID(stream.A1EIController, nbID))
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for msg := range msgCh {
+			// the acks of the requests of A1T are handled by the requester
+			if msg.A1SBIMessageType != stream.EIRequestMessage {
+				continue
+			}
+			go a1ei.dispatchReceivedMsg(ctx, sbID, msg)
+		}
+	}()
+	return nil
+}
+
+// dispatchReceivedMsg serves the EI request of an xApp and sends the result back to the endpoint it came from
+func (a1ei *a1eiController) dispatchReceivedMsg(ctx context.Context, sbID stream.ID, sbMessage *stream.SBStreamMessage) {
+	req, ok := sbMessage.Payload.(*a1.EIRequestMessage)
+	if !ok {
+		log.Warnf("Unexpected EI request %v", sbMessage)
+		return
+	}
+	xAppID := sbMessage.TargetXAppID
+	payload, err := a1ei.handleEIRequest(ctx, xAppID, sbMessage.A1SBIRPCType, req)
+	result := &a1.Result{Success: err == nil}
+	if err != nil {
+		log.Warnf("EI %v of xApp %v for job %v failed: %v", sbMessage.A1SBIRPCType, xAppID, req.GetEiJobId(), err)
+		result.Reason = err.Error()
+	}
+	resp := &a1.EIResultMessage{
+		EiJobId: req.GetEiJobId(),
+		Message: &a1.ResultMessage{
+			Header:  req.GetMessage().GetHeader(),
+			Payload: payload,
+			Result:  result,
+		},
+	}
+	respMessage := stream.NewSBStreamMessage(xAppID, stream.EIResultMessage, sbMessage.A1SBIRPCType, stream.EnrichmentInformation, resp)
+	respMessage.Endpoint = sbMessage.Endpoint
+	if err := a1ei.streamBroker.Send(sbID, respMessage); err != nil {
+		log.Warn(err)
+	}
+}
+
+func (a1ei *a1eiController) handleEIRequest(ctx context.Context, xAppID string, rpcType stream.A1SBIRPCType, req *a1.EIRequestMessage) ([]byte, error) {
+	switch rpcType {
+	case stream.EIQuery:
+		return a1ei.handleEIQuery(ctx, xAppID, req.GetMessage().GetPayload())
+	case stream.EIJobSetup, stream.EIJobUpdate:
+		job := &eiJobObject{}
+		if err := json.Unmarshal(req.GetMessage().GetPayload(), job); err != nil {
+			return nil, errors.NewInvalid("invalid EI job %v: %v", req.GetEiJobId(), err)
+		}
+		return nil, a1ei.addConsumer(ctx, xAppID, req.GetEiJobId(), job)
+	case stream.EIJobDelete:
+		return nil, a1ei.removeConsumer(ctx, xAppID, req.GetEiJobId())
+	case stream.EIJobStatusQuery:
+		status, err := a1ei.HandleGetInfoJobStatus(ctx, req.GetEiJobId())
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(map[string]string{"eiJobStatus": status.InfoJobStatus})
+	}
+	return nil, errors.NewNotSupported("EI RPC %v is not supported", rpcType)
+}
+
+// handleEIQuery returns the EI types the xApp consumes and the Non-RT RIC knows, like GetEiTypeIdentifiers, or the
+// definition of the EI type given in the payload, like GetEiType
+func (a1ei *a1eiController) handleEIQuery(ctx context.Context, xAppID string, payload []byte) ([]byte, error) {
+	query := &eiTypeQuery{}
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, query); err != nil {
+			return nil, errors.NewInvalid("invalid EI query: %v", err)
+		}
+	}
+	if query.EiTypeID != "" {
+		if !a1ei.consumesEIType(ctx, xAppID, query.EiTypeID) {
+			return nil, errors.NewForbidden("xApp %v does not consume EI type %v", xAppID, query.EiTypeID)
+		}
+		infoType, err := a1ei.HandleGetInfoType(ctx, query.EiTypeID)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(infoType)
+	}
+
+	infoTypeIDs, err := a1ei.HandleGetInfoTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eiTypeIDs := make([]string, 0, len(infoTypeIDs))
+	for _, infoTypeID := range infoTypeIDs {
+		if a1ei.consumesEIType(ctx, xAppID, infoTypeID) {
+			eiTypeIDs = append(eiTypeIDs, infoTypeID)
+		}
+	}
+	return json.Marshal(eiTypeIDs)
+}
+
+// addConsumer sets the job of the xApp up at the Non-RT RIC; xApps requesting the same job share it, and its results
+// and status notifications go to A1T, which forwards them on the EI SBI
+func (a1ei *a1eiController) addConsumer(ctx context.Context, xAppID, infoJobID string, job *eiJobObject) error {
+	if job.EiTypeID == "" {
+		return errors.NewInvalid("EI job %v has no EI type", infoJobID)
+	}
+	if !a1ei.consumesEIType(ctx, xAppID, job.EiTypeID) {
+		return errors.NewForbidden("xApp %v does not consume EI type %v", xAppID, job.EiTypeID)
+	}
+	if a1ei.callbackURL == "" {
+		return errors.NewUnavailable("EI job %v cannot be set up: no A1-DME callback URL is configured", infoJobID)
+	}
+	a1ei.jobsMu.Lock()
+	defer a1ei.jobsMu.Unlock()
+
+	value := &store.InfoJobValue{
+		InfoTypeID:    job.EiTypeID,
+		JobOwner:      xAppID,
+		JobDefinition: job.JobDefinition,
+		Consumers:     make(map[string]bool),
+		Timestamp:     time.Now(),
+	}
+	current, err := store.GetInfoJob(ctx, a1ei.eijobsStore, infoJobID)
+	if err == nil {
+		if len(current.Consumers) == 0 {
+			return errors.NewConflict("information job %v is managed by the A1-DME consumer %v", infoJobID, current.JobOwner)
+		}
+		if sameInfoJob(current, job.EiTypeID, job.JobDefinition) {
+			if current.Consumers[xAppID] {
+				return nil
+			}
+			updated := *current
+			updated.Consumers = make(map[string]bool)
+			for consumer := range current.Consumers {
+				updated.Consumers[consumer] = true
+			}
+			updated.Consumers[xAppID] = true
+			log.Infof("xApp %v shares information job %v", xAppID, infoJobID)
+			return store.PutInfoJob(ctx, a1ei.eijobsStore, infoJobID, &updated)
+		}
+		// only the single consumer of a job may change it
+		if len(current.Consumers) > 1 || !current.Consumers[xAppID] {
+			return errors.NewConflict("information job %v is shared by the xApps %v", infoJobID, consumerIDs(current))
+		}
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+	value.Consumers[xAppID] = true
+
+	_, err = a1ei.dmeClient.PutInfoJob(ctx, infoJobID, &dme.InfoJobObject{
+		InfoTypeID:               job.EiTypeID,
+		JobOwner:                 xAppID,
+		JobDefinition:            job.JobDefinition,
+		JobResultURI:             a1ei.callbackURI(infoJobID, infoJobResultEndpoint),
+		JobStatusNotificationURI: a1ei.callbackURI(infoJobID, infoJobStatusEndpoint),
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Information job %v of type %v set up at the Non-RT RIC for xApp %v", infoJobID, job.EiTypeID, xAppID)
+	return store.PutInfoJob(ctx, a1ei.eijobsStore, infoJobID, value)
+}
+
+// removeConsumer removes the xApp from the consumers of the job and deletes the job once it has no consumers left
+func (a1ei *a1eiController) removeConsumer(ctx context.Context, xAppID, infoJobID string) error {
+	a1ei.jobsMu.Lock()
+	defer a1ei.jobsMu.Unlock()
+
+	current, err := store.GetInfoJob(ctx, a1ei.eijobsStore, infoJobID)
+	if err != nil {
+		return err
+	}
+	if !current.Consumers[xAppID] {
+		return errors.NewForbidden("xApp %v is not a consumer of information job %v", xAppID, infoJobID)
+	}
+	if len(current.Consumers) == 1 {
+		return a1ei.deleteInfoJob(ctx, infoJobID)
+	}
+	updated := *current
+	updated.Consumers = make(map[string]bool)
+	for consumer := range current.Consumers {
+		if consumer != xAppID {
+			updated.Consumers[consumer] = true
+		}
+	}
+	log.Infof("xApp %v no longer consumes information job %v", xAppID, infoJobID)
+	return store.PutInfoJob(ctx, a1ei.eijobsStore, infoJobID, &updated)
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	"github.com/onosproject/onos-a1t/pkg/dme"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// EITypeInfo is an entry of the EI type catalogue: an EI type and the xApps which declared they consume it
+type EITypeInfo struct {
+	EITypeID  string   `json:"eiTypeId"`
+	Consumers []string `json:"consumers"`
+	// Definition is the information type of the Non-RT RIC; empty if the Non-RT RIC does not know the type
+	Definition dme.InfoTypeObject `json:"definition,omitempty"`
+}
+
+// HandleGetEITypeCatalogue returns the EI types declared by the xApps; the xApps which do not declare their EI types
+// are not listed
+func (a1ei *a1eiController) HandleGetEITypeCatalogue(ctx context.Context) []*EITypeInfo {
+	consumers := make(map[string][]string)
+	for xAppID, eiTypeIDs := range a1ei.eiCapabilities(ctx) {
+		for eiTypeID := range eiTypeIDs {
+			if eiTypeID != store.AnyEIType {
+				consumers[eiTypeID] = append(consumers[eiTypeID], xAppID)
+			}
+		}
+	}
+	catalogue := make([]*EITypeInfo, 0, len(consumers))
+	for eiTypeID, xAppIDs := range consumers {
+		sort.Strings(xAppIDs)
+		catalogue = append(catalogue, &EITypeInfo{
+			EITypeID:  eiTypeID,
+			Consumers: xAppIDs,
+		})
+	}
+	sort.Slice(catalogue, func(i, j int) bool {
+		return catalogue[i].EITypeID < catalogue[j].EITypeID
+	})
+	return catalogue
+}
+
+// HandleGetEITypeInfo returns the catalogue entry of the EI type with its definition at the Non-RT RIC
+func (a1ei *a1eiController) HandleGetEITypeInfo(ctx context.Context, eiTypeID string) (*EITypeInfo, error) {
+	for _, info := range a1ei.HandleGetEITypeCatalogue(ctx) {
+		if info.EITypeID != eiTypeID {
+			continue
+		}
+		definition, err := a1ei.dmeClient.GetInfoType(ctx, eiTypeID)
+		if err != nil {
+			log.Warnf("Failed to get the definition of EI type %v: %v", eiTypeID, err)
+		} else {
+			info.Definition = definition
+		}
+		return info, nil
+	}
+	return nil, errors.NewNotFound("no xApp consumes EI type %v", eiTypeID)
+}
+
+// eiCapabilities returns the EI types of each xApp
+func (a1ei *a1eiController) eiCapabilities(ctx context.Context) map[string]map[string]bool {
+	capabilities := make(map[string]map[string]bool)
+	ch := make(chan *store.Entry)
+	go a1ei.subscriptionStore.Entries(ctx, ch)
+	for e := range ch {
+		key, ok := e.Key.(store.SubscriptionKey)
+		if !ok {
+			continue
+		}
+		capabilities[string(key.TargetXAppID)] = getEITypeIDs(e.Value.(*store.SubscriptionValue))
+	}
+	return capabilities
+}
+
+// consumesEIType returns whether the xApp declared the EI type, or does not declare its EI types
+func (a1ei *a1eiController) consumesEIType(ctx context.Context, xAppID, eiTypeID string) bool {
+	entry, err := a1ei.subscriptionStore.Get(ctx, store.SubscriptionKey{TargetXAppID: topoapi.ID(xAppID)})
+	if err != nil {
+		return false
+	}
+	eiTypeIDs := getEITypeIDs(entry.Value.(*store.SubscriptionValue))
+	return eiTypeIDs[store.AnyEIType] || eiTypeIDs[eiTypeID]
+}
+
+// interestedConsumers returns the consumers of the job which still consume its type
+func (a1ei *a1eiController) interestedConsumers(ctx context.Context, infoJobID string, job *store.InfoJobValue) []string {
+	xAppIDs := make([]string, 0, len(job.Consumers))
+	for _, xAppID := range consumerIDs(job) {
+		if !a1ei.consumesEIType(ctx, xAppID, job.InfoTypeID) {
+			log.Infof("xApp %v no longer consumes EI type %v - skipping information job %v", xAppID, job.InfoTypeID, infoJobID)
+			continue
+		}
+		xAppIDs = append(xAppIDs, xAppID)
+	}
+	return xAppIDs
+}
+
+func getEITypeIDs(value *store.SubscriptionValue) map[string]bool {
+	eiTypeIDs := make(map[string]bool)
+	for _, c := range value.A1ServiceCapabilities {
+		if c.A1Service == store.EnrichmentInformation {
+			eiTypeIDs[c.TypeID] = true
+		}
+	}
+	return eiTypeIDs
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onosproject/onos-a1t/pkg/dme"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDMEClient knows the information types of the Non-RT RIC
+type fakeDMEClient struct {
+	dme.Client
+	infoTypeIDs []string
+}
+
+func (c *fakeDMEClient) GetInfoTypes(ctx context.Context) ([]string, error) {
+	return c.infoTypeIDs, nil
+}
+
+func (c *fakeDMEClient) GetInfoType(ctx context.Context, infoTypeID string) (dme.InfoTypeObject, error) {
+	for _, id := range c.infoTypeIDs {
+		if id == infoTypeID {
+			return dme.InfoTypeObject{"id": infoTypeID}, nil
+		}
+	}
+	return nil, errors.NewNotFound("information type %v not found", infoTypeID)
+}
+
+func putEISubscription(t *testing.T, subscriptionStore store.Store, xAppID string, capabilities ...*store.A1ServiceType) {
+	_, err := subscriptionStore.Put(context.Background(), store.SubscriptionKey{TargetXAppID: topoapi.ID(xAppID)}, &store.SubscriptionValue{A1ServiceCapabilities: capabilities})
+	require.NoError(t, err)
+}
+
+func TestEITypeCatalogue(t *testing.T) {
+	ctx := context.Background()
+	subscriptionStore := store.NewStore()
+	putEISubscription(t, subscriptionStore, "xapp-1",
+		&store.A1ServiceType{A1Service: store.EnrichmentInformation, TypeID: "type-1"},
+		&store.A1ServiceType{A1Service: store.EnrichmentInformation, TypeID: "type-2"})
+	putEISubscription(t, subscriptionStore, "xapp-2", &store.A1ServiceType{A1Service: store.EnrichmentInformation, TypeID: "type-2"})
+	putEISubscription(t, subscriptionStore, "xapp-3", &store.A1ServiceType{A1Service: store.EnrichmentInformation, TypeID: store.AnyEIType})
+	putEISubscription(t, subscriptionStore, "xapp-4", &store.A1ServiceType{A1Service: store.PolicyManagement, TypeID: "type-1"})
+	a1ei := NewA1EIController("", subscriptionStore, store.NewStore(), nil, nil, DefaultConfig()).(*a1eiController)
+	a1ei.dmeClient = &fakeDMEClient{infoTypeIDs: []string{"type-1", "type-2", "type-3"}}
+
+	// the catalogue lists the declared EI types only, with the xApps consuming them
+	assert.Equal(t, []*EITypeInfo{
+		{EITypeID: "type-1", Consumers: []string{"xapp-1"}},
+		{EITypeID: "type-2", Consumers: []string{"xapp-1", "xapp-2"}},
+	}, a1ei.HandleGetEITypeCatalogue(ctx))
+	info, err := a1ei.HandleGetEITypeInfo(ctx, "type-2")
+	require.NoError(t, err)
+	assert.Equal(t, dme.InfoTypeObject{"id": "type-2"}, info.Definition)
+	_, err = a1ei.HandleGetEITypeInfo(ctx, "type-3")
+	assert.True(t, errors.IsNotFound(err))
+
+	// an xApp only learns about the EI types it consumes, unless it does not declare them
+	payload, err := a1ei.handleEIQuery(ctx, "xapp-2", nil)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["type-2"]`, string(payload))
+	payload, err = a1ei.handleEIQuery(ctx, "xapp-3", nil)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["type-1", "type-2", "type-3"]`, string(payload))
+	payload, err = a1ei.handleEIQuery(ctx, "xapp-1", []byte(`{"eiTypeId": "type-1"}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id": "type-1"}`, string(payload))
+	_, err = a1ei.handleEIQuery(ctx, "xapp-2", []byte(`{"eiTypeId": "type-1"}`))
+	assert.True(t, errors.IsForbidden(err))
+
+	// the jobs of a type only go to the consumers which still consume it
+	job := &store.InfoJobValue{InfoTypeID: "type-1", Consumers: map[string]bool{"xapp-1": true, "xapp-2": true, "xapp-3": true}}
+	assert.Equal(t, []string{"xapp-1", "xapp-3"}, a1ei.interestedConsumers(ctx, "job-1", job))
+}
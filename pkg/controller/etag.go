@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// PolicyETag returns the entity tag of a policy revision
+func PolicyETag(revision uint64) string {
+	return fmt.Sprintf("%q", fmt.Sprint(revision))
+}
+
+// Precondition holds the entity tags of the If-Match and If-None-Match headers of a request; "*" matches any
+// existing policy
+type Precondition struct {
+	IfMatch     []string
+	IfNoneMatch []string
+}
+
+type preconditionKey struct{}
+
+// WithPrecondition returns a context whose policy writes only apply if the precondition holds for the current policy
+func WithPrecondition(ctx context.Context, precondition Precondition) context.Context {
+	return context.WithValue(ctx, preconditionKey{}, precondition)
+}
+
+// PreconditionFailedError is returned when a policy write does not match the current revision of the policy; ETag
+// is empty if A1T has no revision of the policy, in which case Held tells whether the xApps hold it
+type PreconditionFailedError struct {
+	PolicyTypeID string
+	PolicyID     string
+	ETag         string
+	Held         bool
+}
+
+func (e *PreconditionFailedError) Error() string {
+	if e.Held {
+		return fmt.Sprintf("precondition failed: policy %v of type %v is held by the xApps", e.PolicyID, e.PolicyTypeID)
+	}
+	if e.ETag == "" {
+		return fmt.Sprintf("precondition failed: policy %v of type %v does not exist", e.PolicyID, e.PolicyTypeID)
+	}
+	return fmt.Sprintf("precondition failed: policy %v of type %v is at %v", e.PolicyID, e.PolicyTypeID, e.ETag)
+}
+
+func IsPreconditionFailed(err error) bool {
+	_, ok := err.(*PreconditionFailedError)
+	return ok
+}
+
+// checkPrecondition compares the precondition of the context with the current revision of the policy; If-None-Match
+// "*" also fails if the xApps hold a policy A1T has no revision of, as a create would overwrite it
+func (a *a1pController) checkPrecondition(ctx context.Context, intent *store.PolicyIntentValue, policyTypeID, policyID string) error {
+	precondition, ok := ctx.Value(preconditionKey{}).(Precondition)
+	if !ok {
+		return nil
+	}
+	etag := ""
+	if intent != nil && intent.Exists() {
+		etag = PolicyETag(intent.Latest().Revision)
+	}
+	failed := &PreconditionFailedError{
+		PolicyTypeID: policyTypeID,
+		PolicyID:     policyID,
+		ETag:         etag,
+	}
+	// If-Match uses the strong comparison and If-None-Match the weak one (RFC 7232)
+	if len(precondition.IfMatch) > 0 && (etag == "" || !matchETag(precondition.IfMatch, etag, false)) ||
+		len(precondition.IfNoneMatch) > 0 && etag != "" && matchETag(precondition.IfNoneMatch, etag, true) {
+		return failed
+	}
+	if etag == "" && matchETag(precondition.IfNoneMatch, "", true) {
+		held, err := a.policyExists(ctx, policyID, policyTypeID)
+		if err != nil {
+			return err
+		}
+		if held {
+			failed.Held = true
+			return failed
+		}
+	}
+	return nil
+}
+
+// matchETag returns whether one of the entity tags matches etag; "*" matches any entity tag, and weak entity tags only
+// match with the weak comparison
+func matchETag(etags []string, etag string, weak bool) bool {
+	for _, e := range etags {
+		if e == "*" {
+			return true
+		}
+		if strings.HasPrefix(e, "W/") {
+			if !weak {
+				continue
+			}
+			e = strings.TrimPrefix(e, "W/")
+		}
+		if etag != "" && e == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func newPolicyLocks() *policyLocks {
+	return &policyLocks{
+		locks: make(map[store.PolicyIntentKey]*policyLock),
+	}
+}
+
+// policyLocks serializes the writes of each policy, so that a write applies to the revision it was checked against
+type policyLocks struct {
+	locks map[store.PolicyIntentKey]*policyLock
+	mu    sync.Mutex
+}
+
+type policyLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock locks the policy and returns the function unlocking it
+func (l *policyLocks) lock(key store.PolicyIntentKey) func() {
+	l.mu.Lock()
+	pl, ok := l.locks[key]
+	if !ok {
+		pl = &policyLock{}
+		l.locks[key] = pl
+	}
+	pl.refs++
+	l.mu.Unlock()
+
+	pl.Lock()
+	return func() {
+		pl.Unlock()
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		pl.refs--
+		if pl.refs == 0 {
+			delete(l.locks, key)
+		}
+	}
+}
+
+// HandlePolicyPut creates the policy or updates it if it exists, atomically with respect to the other writes of the
+// policy, and returns whether it was created and the entity tag of the new revision
+func (a *a1pController) HandlePolicyPut(ctx context.Context, policyID, policyTypeID string, params map[string]string, policyObject map[string]interface{}) (bool, string, error) {
+	unlock := a.policyLocks.lock(store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID})
+	defer unlock()
+
+	exists, err := a.policyExists(ctx, policyID, policyTypeID)
+	if err != nil {
+		return false, "", err
+	}
+	var revision uint64
+	if exists {
+		revision, err = a.writePolicy(ctx, audit.PolicyUpdate, policyID, policyTypeID, params, policyObject, func(ctx context.Context) error {
+			return a.policyUpdate(ctx, policyID, policyTypeID, params, policyObject)
+		})
+	} else {
+		revision, err = a.writePolicy(ctx, audit.PolicyCreate, policyID, policyTypeID, params, policyObject, func(ctx context.Context) error {
+			return a.policyCreate(ctx, policyID, policyTypeID, params, policyObject)
+		})
+	}
+	if err != nil {
+		return false, "", err
+	}
+	return !exists, PolicyETag(revision), nil
+}
+
+// policyExists returns whether the policy is known to A1T or held by the xApps; a scheduled policy outside of its
+// validity window exists but is not held by the xApps, so it is updated without being sent
+func (a *a1pController) policyExists(ctx context.Context, policyID, policyTypeID string) (bool, error) {
+	intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, policyTypeID, policyID)
+	if err != nil && !errors.IsNotFound(err) {
+		return false, err
+	}
+	if intent != nil && intent.Exists() {
+		return true, nil
+	}
+	policyIDs, err := a.HandleGetPolicytypesPolicyTypeIdPolicies(WithPrivileged(ctx), policyTypeID)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range policyIDs {
+		if id == policyID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// HandleGetPolicyETag returns the entity tag of the current revision of the policy
+func (a *a1pController) HandleGetPolicyETag(ctx context.Context, policyID, policyTypeID string) (string, error) {
+	if err := a.checkReadOwner(ctx, policyTypeID, policyID); err != nil {
+		return "", err
+	}
+	intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, policyTypeID, policyID)
+	if err != nil {
+		return "", err
+	}
+	if !intent.Exists() {
+		return "", errors.NewNotFound("policy %v of type %v not found", policyID, policyTypeID)
+	}
+	return PolicyETag(intent.Latest().Revision), nil
+}
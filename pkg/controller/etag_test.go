@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchETag(t *testing.T) {
+	etag := PolicyETag(3)
+	assert.True(t, matchETag([]string{etag}, etag, false))
+	assert.True(t, matchETag([]string{"*"}, etag, false))
+	assert.True(t, matchETag([]string{`"1"`, etag}, etag, false))
+	assert.False(t, matchETag([]string{`"1"`}, etag, false))
+	assert.False(t, matchETag([]string{"W/" + etag}, etag, false))
+	assert.True(t, matchETag([]string{"W/" + etag}, etag, true))
+	assert.True(t, matchETag([]string{"*"}, "", true))
+	assert.False(t, matchETag([]string{etag}, "", true))
+}
+
+func TestCheckPrecondition(t *testing.T) {
+	a := &a1pController{}
+	intent := &store.PolicyIntentValue{Revisions: []*store.PolicyRevision{{Revision: 3}}}
+	etag := PolicyETag(3)
+
+	check := func(precondition Precondition) error {
+		return a.checkPrecondition(WithPrecondition(context.Background(), precondition), intent, "type", "policy")
+	}
+	assert.NoError(t, check(Precondition{IfMatch: []string{etag}}))
+	assert.True(t, IsPreconditionFailed(check(Precondition{IfMatch: []string{"W/" + etag}})))
+	assert.True(t, IsPreconditionFailed(check(Precondition{IfMatch: []string{`"2"`}})))
+	assert.True(t, IsPreconditionFailed(check(Precondition{IfNoneMatch: []string{"*"}})))
+	assert.True(t, IsPreconditionFailed(check(Precondition{IfNoneMatch: []string{"W/" + etag}})))
+	assert.NoError(t, check(Precondition{IfNoneMatch: []string{`"2"`}}))
+
+	err := a.checkPrecondition(WithPrecondition(context.Background(), Precondition{IfMatch: []string{"*"}}), nil, "type", "policy")
+	assert.True(t, IsPreconditionFailed(err))
+}
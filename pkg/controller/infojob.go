@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// InfoJobInfo is an information job as known by A1T, without asking the Non-RT RIC
+type InfoJobInfo struct {
+	InfoJobID string
+	*store.InfoJobValue
+	// Orphaned is whether the job was set up by xApps which are all gone
+	Orphaned bool
+}
+
+// HandleListInfoJobs returns the information jobs of the type owned or consumed by the xApp; empty values match every job
+func (a1ei *a1eiController) HandleListInfoJobs(ctx context.Context, infoTypeID, xAppID string) []*InfoJobInfo {
+	xApps := a1ei.eiCapabilities(ctx)
+	jobs := make([]*InfoJobInfo, 0)
+	for infoJobID, job := range store.ListInfoJobs(ctx, a1ei.eijobsStore, infoTypeID) {
+		if xAppID != "" && job.JobOwner != xAppID && !job.Consumers[xAppID] {
+			continue
+		}
+		jobs = append(jobs, &InfoJobInfo{
+			InfoJobID:    infoJobID,
+			InfoJobValue: job,
+			Orphaned:     isOrphaned(job, xApps),
+		})
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].InfoJobID < jobs[j].InfoJobID
+	})
+	return jobs
+}
+
+// HandleForceDeleteInfoJob removes the job even if the Non-RT RIC cannot be reached, and returns whether the Non-RT
+// RIC deleted it as well
+func (a1ei *a1eiController) HandleForceDeleteInfoJob(ctx context.Context, infoJobID string) (bool, error) {
+	a1ei.jobsMu.Lock()
+	defer a1ei.jobsMu.Unlock()
+	if _, err := store.GetInfoJob(ctx, a1ei.eijobsStore, infoJobID); err != nil {
+		return false, err
+	}
+
+	remoteDeleted := true
+	if err := a1ei.dmeClient.DeleteInfoJob(ctx, infoJobID); err != nil && !errors.IsNotFound(err) {
+		log.Warnf("Information job %v is force-deleted but the Non-RT RIC did not delete it: %v", infoJobID, err)
+		remoteDeleted = false
+	}
+	log.Infof("Information job %v force-deleted", infoJobID)
+	return remoteDeleted, store.DeleteInfoJob(ctx, a1ei.eijobsStore, infoJobID)
+}
+
+func isOrphaned(job *store.InfoJobValue, xApps map[string]map[string]bool) bool {
+	if len(job.Consumers) == 0 {
+		return false
+	}
+	for xAppID := range job.Consumers {
+		if _, ok := xApps[xAppID]; ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	circuitState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "a1t",
+		Subsystem: "controller",
+		Name:      "circuit_state",
+		Help:      "State of the circuit breaker of an xApp: 0 closed, 1 open, 2 half-open",
+	}, []string{"xapp_id"})
+
+	circuitTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "a1t",
+		Subsystem: "controller",
+		Name:      "circuit_transitions_total",
+		Help:      "Number of state changes of the circuit breaker of an xApp, by new state",
+	}, []string{"xapp_id", "state"})
+)
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+type ownerKey struct{}
+
+// privileged is the owner carried by the contexts of the privileged callers
+type privileged struct{}
+
+// WithOwner returns a context whose policy writes are labelled with the owner, i.e. the tenant of the caller; an empty
+// owner writes unowned policies
+func WithOwner(ctx context.Context, owner string) context.Context {
+	return context.WithValue(ctx, ownerKey{}, owner)
+}
+
+// WithPrivileged returns a context of a privileged caller, which is bound to no owner and manages every policy; only
+// the internal callers, such as the admin API, the scheduler and the policy source, opt in to it
+func WithPrivileged(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ownerKey{}, privileged{})
+}
+
+// OwnerFromContext returns the owner carried by the context; ok is false for privileged callers. A context carrying no
+// owner is the one of a caller bound to no tenant.
+func OwnerFromContext(ctx context.Context) (string, bool) {
+	switch owner := ctx.Value(ownerKey{}).(type) {
+	case privileged:
+		return "", false
+	case string:
+		return owner, true
+	}
+	return "", true
+}
+
+// callerOwner returns the owner carried by the context as OwnerFromContext does. It returns a Forbidden error for the
+// callers bound to no tenant if tenants are required.
+func (a *a1pController) callerOwner(ctx context.Context) (string, bool, error) {
+	owner, ok := OwnerFromContext(ctx)
+	if ok && owner == "" && a.requireTenant {
+		return "", false, errors.NewForbidden("callers without a tenant are not allowed to manage policies")
+	}
+	return owner, ok, nil
+}
+
+// PolicyOwnership is the owner of a policy; Owner is empty for unowned policies
+type PolicyOwnership struct {
+	PolicyTypeID string
+	PolicyID     string
+	Owner        string
+	Revision     uint64
+}
+
+// checkOwner returns the owner label of the new revision of the policy. It returns a Forbidden error if the policy is
+// owned by someone else than the caller, or if creating it would exceed the quota of the caller.
+func (a *a1pController) checkOwner(ctx context.Context, intent *store.PolicyIntentValue, policyTypeID, policyID string) (string, error) {
+	current := ""
+	exists := intent != nil && intent.Exists()
+	if exists {
+		current = intent.Latest().Owner
+	}
+	owner, ok, err := a.callerOwner(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return current, nil
+	}
+	if current != "" && current != owner {
+		return "", errors.NewForbidden("policy %v of type %v is owned by %v", policyID, policyTypeID, current)
+	}
+	if !exists && owner != "" && a.policyQuota > 0 {
+		if n := len(a.ownedPolicyIDs(ctx, policyTypeID, owner)); n >= a.policyQuota {
+			return "", errors.NewForbidden("%v already owns %d policies of type %v - the quota is %d", owner, n, policyTypeID, a.policyQuota)
+		}
+	}
+	return owner, nil
+}
+
+// ownedPolicyIDs returns the IDs of the existing policies of the type owned by the owner
+func (a *a1pController) ownedPolicyIDs(ctx context.Context, policyTypeID, owner string) map[string]bool {
+	results := make(map[string]bool)
+	for key, intent := range store.ListPolicyIntents(ctx, a.policyIntentStore, policyTypeID) {
+		if intent.Exists() && intent.Latest().Owner == owner {
+			results[key.PolicyID] = true
+		}
+	}
+	return results
+}
+
+// checkReadOwner returns a NotFound error if the caller is bound to a tenant which does not own the policy, as the
+// policy is not listed to it either
+func (a *a1pController) checkReadOwner(ctx context.Context, policyTypeID, policyID string) error {
+	owner, ok, err := a.callerOwner(ctx)
+	if err != nil || !ok || owner == "" {
+		return err
+	}
+	if !a.isOwner(ctx, policyTypeID, policyID, owner) {
+		return errors.NewNotFound("policy %v of type %v not found", policyID, policyTypeID)
+	}
+	return nil
+}
+
+// isOwner returns whether the policy exists and is owned by the owner
+func (a *a1pController) isOwner(ctx context.Context, policyTypeID, policyID, owner string) bool {
+	intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, policyTypeID, policyID)
+	return err == nil && intent.Exists() && intent.Latest().Owner == owner
+}
+
+// filterOwnedPolicyIDs keeps the policies owned by the tenant of the caller; unowned and privileged callers see every policy
+func (a *a1pController) filterOwnedPolicyIDs(ctx context.Context, policyTypeID string, policyIDs []string) ([]string, error) {
+	owner, ok, err := a.callerOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || owner == "" {
+		return policyIDs, nil
+	}
+	owned := a.ownedPolicyIDs(ctx, policyTypeID, owner)
+	results := make([]string, 0, len(owned))
+	for _, policyID := range policyIDs {
+		if owned[policyID] {
+			results = append(results, policyID)
+		}
+	}
+	return results, nil
+}
+
+// HandleListPolicyOwners returns the owners of the policies of the type, or of every type if policyTypeID is empty,
+// filtered by owner if it is not empty
+func (a *a1pController) HandleListPolicyOwners(ctx context.Context, policyTypeID, owner string) []*PolicyOwnership {
+	results := make([]*PolicyOwnership, 0)
+	for key, intent := range store.ListPolicyIntents(ctx, a.policyIntentStore, policyTypeID) {
+		if !intent.Exists() {
+			continue
+		}
+		latest := intent.Latest()
+		if owner != "" && latest.Owner != owner {
+			continue
+		}
+		results = append(results, &PolicyOwnership{
+			PolicyTypeID: key.PolicyTypeID,
+			PolicyID:     key.PolicyID,
+			Owner:        latest.Owner,
+			Revision:     latest.Revision,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].PolicyTypeID != results[j].PolicyTypeID {
+			return results[i].PolicyTypeID < results[j].PolicyTypeID
+		}
+		return results[i].PolicyID < results[j].PolicyID
+	})
+	return results
+}
+
+// HandlePolicyTransfer makes the owner, or nobody if owner is empty, the owner of the policy. The policy object is left
+// unchanged and nothing is sent to the xApps; the transfer is recorded as a new revision.
+func (a *a1pController) HandlePolicyTransfer(ctx context.Context, policyTypeID, policyID, owner string) (*PolicyOwnership, error) {
+	unlock := a.policyLocks.lock(store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID})
+	defer unlock()
+	intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, policyTypeID, policyID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if intent == nil || !intent.Exists() {
+		return nil, errors.NewNotFound("policy %v of type %v not found", policyID, policyTypeID)
+	}
+	latest := intent.Latest()
+
+	caller, requestID := audit.CallerFromContext(ctx)
+	record := audit.NewRecord(audit.PolicyTransfer, policyTypeID, policyID, caller, requestID)
+	record.OldBody = latest.PolicyObject
+	record.NewBody = latest.PolicyObject
+	revision, err := store.AddPolicyRevision(ctx, a.policyIntentStore, policyTypeID, policyID, latest.Params, latest.PolicyObject, false, owner)
+	if err != nil {
+		return nil, err
+	}
+	record.Revision = revision.Revision
+	log.Infof("Ownership of policy %v of type %v transferred from %q to %q", policyID, policyTypeID, latest.Owner, owner)
+
+	if err := a.auditLog.Append(ctx, record); err != nil {
+		log.Warn(err)
+	}
+	return &PolicyOwnership{
+		PolicyTypeID: policyTypeID,
+		PolicyID:     policyID,
+		Owner:        owner,
+		Revision:     revision.Revision,
+	}, nil
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallerOwner(t *testing.T) {
+	ctx := context.Background()
+	a := &a1pController{requireTenant: true}
+
+	_, _, err := a.callerOwner(WithOwner(ctx, ""))
+	assert.True(t, errors.IsForbidden(err))
+
+	owner, ok, err := a.callerOwner(WithOwner(ctx, "tenant-1"))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "tenant-1", owner)
+
+	// a context carrying no owner is not privileged
+	_, _, err = a.callerOwner(ctx)
+	assert.True(t, errors.IsForbidden(err))
+
+	_, ok, err = a.callerOwner(WithPrivileged(WithOwner(ctx, "")))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	a.requireTenant = false
+	owner, ok, err = a.callerOwner(WithOwner(ctx, ""))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "", owner)
+}
+
+func TestCheckReadOwner(t *testing.T) {
+	ctx := context.Background()
+	a := &a1pController{policyIntentStore: store.NewStore()}
+	_, err := store.AddPolicyRevision(ctx, a.policyIntentStore, "type-1", "owned", nil, map[string]interface{}{}, false, "tenant-1")
+	assert.NoError(t, err)
+	_, err = store.AddPolicyRevision(ctx, a.policyIntentStore, "type-1", "unowned", nil, map[string]interface{}{}, false, "")
+	assert.NoError(t, err)
+
+	assert.NoError(t, a.checkReadOwner(WithOwner(ctx, "tenant-1"), "type-1", "owned"))
+	assert.True(t, errors.IsNotFound(a.checkReadOwner(WithOwner(ctx, "tenant-2"), "type-1", "owned")))
+	assert.True(t, errors.IsNotFound(a.checkReadOwner(WithOwner(ctx, "tenant-2"), "type-1", "unowned")))
+	assert.True(t, errors.IsNotFound(a.checkReadOwner(WithOwner(ctx, "tenant-2"), "type-1", "missing")))
+	assert.NoError(t, a.checkReadOwner(WithPrivileged(ctx), "type-1", "owned"))
+	assert.NoError(t, a.checkReadOwner(ctx, "type-1", "owned"))
+
+	a.requireTenant = true
+	assert.True(t, errors.IsForbidden(a.checkReadOwner(ctx, "type-1", "unowned")))
+	assert.NoError(t, a.checkReadOwner(WithPrivileged(ctx), "type-1", "unowned"))
+}
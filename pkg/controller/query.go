@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+)
+
+// XAppQueryResult is the answer of a single xApp to a policy query; Payload is the JSON policy object or status, or
+// the JSON list of policy IDs if the query has no policy ID
+type XAppQueryResult struct {
+	XAppID  string
+	Payload []byte
+	Err     error
+}
+
+// HandleQueryXApps queries the policy object or status, or the policy IDs if policyID is empty, from every target
+// xApp; unlike HandleGetPolicy, the failure of an xApp does not fail the query
+func (a *a1pController) HandleQueryXApps(ctx context.Context, policyID, policyTypeID string, payloadType a1.PayloadType) ([]*XAppQueryResult, error) {
+	targetXAppIDs, err := a.rnibClient.GetXAppIDsForPolicyTypeID(ctx, policyTypeID)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	results := make([]*XAppQueryResult, 0, len(targetXAppIDs))
+	for _, targetXAppID := range targetXAppIDs {
+		result := &XAppQueryResult{
+			XAppID: targetXAppID,
+		}
+		reqMsg := newPolicyRequestMessage(targetXAppID, policyID, policyTypeID, payloadType)
+		resp, err := a.sendPolicyRequest(ctx, targetXAppID, stream.PolicyQuery, reqMsg)
+		if err != nil {
+			result.Err = err
+		} else {
+			result.Payload = resp.Message.Payload
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/policydiff"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+type PolicyRollbackAction int
+
+const (
+	RollbackNone PolicyRollbackAction = iota
+	RollbackCreate
+	RollbackUpdate
+	RollbackDelete
+)
+
+func (r PolicyRollbackAction) String() string {
+	return [...]string{"None", "Create", "Update", "Delete"}[r]
+}
+
+// PolicyRollback describes the rollback of a single policy; Err is set if applying it failed
+type PolicyRollback struct {
+	PolicyTypeID  string
+	PolicyID      string
+	Action        PolicyRollbackAction
+	FromRevision  uint64
+	ToRevision    uint64
+	CurrentObject map[string]interface{}
+	TargetObject  map[string]interface{}
+	ChangedFields []string
+	Err           error
+}
+
+// HandlePolicyRollback rolls the policy, or every policy of the type if policyID is empty, back to the given revision
+// or to the revision effective at the given timestamp. With dryRun, the rollbacks are only computed.
+func (a *a1pController) HandlePolicyRollback(ctx context.Context, policyTypeID, policyID string, revision uint64, timestamp time.Time, dryRun bool) ([]*PolicyRollback, error) {
+	if policyTypeID == "" {
+		return nil, errors.NewInvalid("policy type ID is required")
+	}
+	if revision == 0 && timestamp.IsZero() {
+		return nil, errors.NewInvalid("either revision or timestamp is required")
+	}
+	if policyID == "" && revision != 0 {
+		return nil, errors.NewInvalid("revisions are per policy - use a timestamp to roll back every policy of type %v", policyTypeID)
+	}
+
+	intents := make(map[store.PolicyIntentKey]*store.PolicyIntentValue)
+	if policyID == "" {
+		intents = store.ListPolicyIntents(ctx, a.policyIntentStore, policyTypeID)
+	} else {
+		intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, policyTypeID, policyID)
+		if err != nil {
+			return nil, err
+		}
+		intents[store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID}] = intent
+	}
+
+	rollbacks := make([]*PolicyRollback, 0)
+	for key, intent := range intents {
+		rollback, err := newPolicyRollback(key, intent, revision, timestamp)
+		if err != nil {
+			return nil, err
+		}
+		rollbacks = append(rollbacks, rollback)
+	}
+	sort.Slice(rollbacks, func(i, j int) bool {
+		return rollbacks[i].PolicyID < rollbacks[j].PolicyID
+	})
+
+	if dryRun {
+		return rollbacks, nil
+	}
+
+	for _, r := range rollbacks {
+		r.Err = a.applyPolicyRollback(ctx, r, intents[store.PolicyIntentKey{PolicyTypeID: r.PolicyTypeID, PolicyID: r.PolicyID}])
+		if r.Err != nil {
+			log.Warnf("Rollback of policy %v of type %v failed: %v", r.PolicyID, r.PolicyTypeID, r.Err)
+		}
+	}
+
+	return rollbacks, nil
+}
+
+func newPolicyRollback(key store.PolicyIntentKey, intent *store.PolicyIntentValue, revision uint64, timestamp time.Time) (*PolicyRollback, error) {
+	current := intent.Latest()
+	var target *store.PolicyRevision
+	if revision != 0 {
+		target = intent.Revision(revision)
+		if target == nil {
+			return nil, errors.NewNotFound("revision %v of policy %v of type %v not found", revision, key.PolicyID, key.PolicyTypeID)
+		}
+	} else {
+		target = intent.At(timestamp)
+	}
+
+	rollback := &PolicyRollback{
+		PolicyTypeID:  key.PolicyTypeID,
+		PolicyID:      key.PolicyID,
+		FromRevision:  current.Revision,
+		ChangedFields: make([]string, 0),
+	}
+	if !current.Deleted {
+		rollback.CurrentObject = current.PolicyObject
+	}
+	if target != nil {
+		rollback.ToRevision = target.Revision
+		if !target.Deleted {
+			rollback.TargetObject = target.PolicyObject
+		}
+	}
+
+	switch {
+	case rollback.CurrentObject == nil && rollback.TargetObject == nil:
+		rollback.Action = RollbackNone
+	case rollback.CurrentObject == nil:
+		rollback.Action = RollbackCreate
+	case rollback.TargetObject == nil:
+		rollback.Action = RollbackDelete
+	default:
+		diffs, err := policydiff.ForPolicyType(key.PolicyTypeID).Diff(rollback.CurrentObject, rollback.TargetObject)
+		if err != nil {
+			return nil, err
+		}
+		rollback.ChangedFields = policydiff.Paths(diffs)
+		if len(rollback.ChangedFields) == 0 {
+			rollback.Action = RollbackNone
+		} else {
+			rollback.Action = RollbackUpdate
+		}
+	}
+	return rollback, nil
+}
+
+func (a *a1pController) applyPolicyRollback(ctx context.Context, rollback *PolicyRollback, intent *store.PolicyIntentValue) error {
+	params := make(map[string]string)
+	if target := intent.Revision(rollback.ToRevision); target != nil && target.Params != nil {
+		params = target.Params
+	}
+
+	switch rollback.Action {
+	case RollbackCreate:
+		return a.auditPolicyOperation(ctx, audit.PolicyRollback, rollback.PolicyID, rollback.PolicyTypeID, params, rollback.TargetObject, func(ctx context.Context) error {
+			return a.policyCreate(ctx, rollback.PolicyID, rollback.PolicyTypeID, params, rollback.TargetObject)
+		})
+	case RollbackUpdate:
+		return a.auditPolicyOperation(ctx, audit.PolicyRollback, rollback.PolicyID, rollback.PolicyTypeID, params, rollback.TargetObject, func(ctx context.Context) error {
+			return a.policyUpdate(ctx, rollback.PolicyID, rollback.PolicyTypeID, params, rollback.TargetObject)
+		})
+	case RollbackDelete:
+		return a.auditPolicyOperation(ctx, audit.PolicyRollback, rollback.PolicyID, rollback.PolicyTypeID, nil, nil, func(ctx context.Context) error {
+			return a.policyDelete(ctx, rollback.PolicyID, rollback.PolicyTypeID)
+		})
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyRollbackDryRun(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewStore()
+	a := &a1pController{policyIntentStore: s}
+
+	_, err := store.AddPolicyRevision(ctx, s, "type-1", "updated", nil, map[string]interface{}{"priority": 1.0}, false, "")
+	require.NoError(t, err)
+	_, err = store.AddPolicyRevision(ctx, s, "type-1", "deleted", nil, map[string]interface{}{"priority": 1.0}, false, "")
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	before := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	_, err = store.AddPolicyRevision(ctx, s, "type-1", "updated", nil, map[string]interface{}{"priority": 2.0}, false, "")
+	require.NoError(t, err)
+	_, err = store.AddPolicyRevision(ctx, s, "type-1", "deleted", nil, nil, true, "")
+	require.NoError(t, err)
+	_, err = store.AddPolicyRevision(ctx, s, "type-1", "created", nil, map[string]interface{}{"priority": 3.0}, false, "")
+	require.NoError(t, err)
+
+	// a dry run computes the rollback of every policy of the type to the revisions effective at the timestamp
+	rollbacks, err := a.HandlePolicyRollback(ctx, "type-1", "", 0, before, true)
+	require.NoError(t, err)
+	actions := make(map[string]PolicyRollbackAction)
+	for _, r := range rollbacks {
+		actions[r.PolicyID] = r.Action
+		assert.NoError(t, r.Err)
+	}
+	assert.Equal(t, map[string]PolicyRollbackAction{
+		"created": RollbackDelete,
+		"deleted": RollbackCreate,
+		"updated": RollbackUpdate,
+	}, actions)
+
+	rollbacks, err = a.HandlePolicyRollback(ctx, "type-1", "updated", 1, time.Time{}, true)
+	require.NoError(t, err)
+	require.Len(t, rollbacks, 1)
+	assert.Equal(t, uint64(2), rollbacks[0].FromRevision)
+	assert.Equal(t, uint64(1), rollbacks[0].ToRevision)
+	assert.Equal(t, map[string]interface{}{"priority": 2.0}, rollbacks[0].CurrentObject)
+	assert.Equal(t, map[string]interface{}{"priority": 1.0}, rollbacks[0].TargetObject)
+	assert.Len(t, rollbacks[0].ChangedFields, 1)
+
+	rollbacks, err = a.HandlePolicyRollback(ctx, "type-1", "updated", 2, time.Time{}, true)
+	require.NoError(t, err)
+	assert.Equal(t, RollbackNone, rollbacks[0].Action)
+
+	_, err = a.HandlePolicyRollback(ctx, "type-1", "updated", 5, time.Time{}, true)
+	assert.True(t, errors.IsNotFound(err))
+	_, err = a.HandlePolicyRollback(ctx, "type-1", "", 1, time.Time{}, true)
+	assert.True(t, errors.IsInvalid(err))
+	_, err = a.HandlePolicyRollback(ctx, "type-1", "updated", 0, time.Time{}, true)
+	assert.True(t, errors.IsInvalid(err))
+}
@@ -0,0 +1,418 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// SchedulerCaller is the identity of the policy scheduler in the audit log
+const SchedulerCaller = "policy-scheduler"
+
+// maxSchedulerWait bounds the time between two passes of the scheduler, so that failed transitions are retried
+const maxSchedulerWait = time.Minute
+
+type Recurrence int
+
+const (
+	RecurrenceNone Recurrence = iota
+	RecurrenceDaily
+	RecurrenceWeekly
+)
+
+func (r Recurrence) String() string {
+	return [...]string{"none", "daily", "weekly"}[r]
+}
+
+func ParseRecurrence(s string) (Recurrence, error) {
+	switch s {
+	case "", "none":
+		return RecurrenceNone, nil
+	case "daily":
+		return RecurrenceDaily, nil
+	case "weekly":
+		return RecurrenceWeekly, nil
+	}
+	return RecurrenceNone, errors.NewInvalid("unknown recurrence %q", s)
+}
+
+func (r Recurrence) period() time.Duration {
+	switch r {
+	case RecurrenceDaily:
+		return 24 * time.Hour
+	case RecurrenceWeekly:
+		return 7 * 24 * time.Hour
+	}
+	return 0
+}
+
+// PolicySchedule is the validity window of a policy: the policy is sent to the xApps when the window opens and
+// withdrawn when it closes. With a recurrence, the window from ValidFrom to ValidUntil repeats every day or week
+// until RecurrenceUntil, or forever if it is zero.
+type PolicySchedule struct {
+	ValidFrom       time.Time
+	ValidUntil      time.Time
+	Recurrence      Recurrence
+	RecurrenceUntil time.Time
+}
+
+// ParsePolicySchedule returns the schedule carried by the policy parameters in RFC 3339 times; it returns nil if the
+// policy is not scheduled
+func ParsePolicySchedule(params map[string]string) (*PolicySchedule, error) {
+	_, hasFrom := params[utils.ValidFrom]
+	_, hasUntil := params[utils.ValidUntil]
+	_, hasRecurrence := params[utils.Recurrence]
+	if !hasFrom && !hasUntil && !hasRecurrence {
+		return nil, nil
+	}
+
+	schedule := &PolicySchedule{}
+	var err error
+	for key, t := range map[string]*time.Time{
+		utils.ValidFrom:       &schedule.ValidFrom,
+		utils.ValidUntil:      &schedule.ValidUntil,
+		utils.RecurrenceUntil: &schedule.RecurrenceUntil,
+	} {
+		if value, ok := params[key]; ok && value != "" {
+			if *t, err = time.Parse(time.RFC3339, value); err != nil {
+				return nil, errors.NewInvalid("invalid %v %q: %v", key, value, err)
+			}
+		}
+	}
+	if schedule.Recurrence, err = ParseRecurrence(params[utils.Recurrence]); err != nil {
+		return nil, err
+	}
+
+	if !schedule.ValidFrom.IsZero() && !schedule.ValidUntil.IsZero() && !schedule.ValidUntil.After(schedule.ValidFrom) {
+		return nil, errors.NewInvalid("%v must be after %v", utils.ValidUntil, utils.ValidFrom)
+	}
+	if schedule.Recurrence != RecurrenceNone {
+		if schedule.ValidFrom.IsZero() || schedule.ValidUntil.IsZero() {
+			return nil, errors.NewInvalid("a %v policy requires %v and %v", schedule.Recurrence, utils.ValidFrom, utils.ValidUntil)
+		}
+		if schedule.ValidUntil.Sub(schedule.ValidFrom) >= schedule.Recurrence.period() {
+			return nil, errors.NewInvalid("the validity window of a %v policy must be shorter than its period", schedule.Recurrence)
+		}
+	} else if !schedule.RecurrenceUntil.IsZero() {
+		return nil, errors.NewInvalid("%v requires a %v", utils.RecurrenceUntil, utils.Recurrence)
+	}
+	return schedule, nil
+}
+
+// window returns the occurrence of the validity window starting at or before t; ok is false if there is none,
+// i.e. t is before the first window or after the end of the recurrence
+func (s *PolicySchedule) window(t time.Time) (start, end time.Time, ok bool) {
+	if !s.ValidFrom.IsZero() && t.Before(s.ValidFrom) {
+		return time.Time{}, time.Time{}, false
+	}
+	if s.Recurrence == RecurrenceNone {
+		return s.ValidFrom, s.ValidUntil, true
+	}
+	period := s.Recurrence.period()
+	start = s.ValidFrom.Add(t.Sub(s.ValidFrom) / period * period)
+	if !s.RecurrenceUntil.IsZero() && !start.Before(s.RecurrenceUntil) {
+		return time.Time{}, time.Time{}, false
+	}
+	return start, start.Add(s.ValidUntil.Sub(s.ValidFrom)), true
+}
+
+// Active returns whether the policy is valid at t
+func (s *PolicySchedule) Active(t time.Time) bool {
+	_, end, ok := s.window(t)
+	return ok && (end.IsZero() || t.Before(end))
+}
+
+// Next returns the first time after t at which the policy becomes valid or stops being valid; ok is false if the
+// policy never changes again
+func (s *PolicySchedule) Next(t time.Time) (time.Time, bool) {
+	if !s.ValidFrom.IsZero() && t.Before(s.ValidFrom) {
+		return s.ValidFrom, true
+	}
+	start, end, ok := s.window(t)
+	if !ok {
+		return time.Time{}, false
+	}
+	if !end.IsZero() && t.Before(end) {
+		return end, true
+	}
+	if s.Recurrence == RecurrenceNone {
+		return time.Time{}, false
+	}
+	next := start.Add(s.Recurrence.period())
+	if !s.RecurrenceUntil.IsZero() && !next.Before(s.RecurrenceUntil) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// Expired returns whether the last validity window of the policy closed before t
+func (s *PolicySchedule) Expired(t time.Time) bool {
+	_, ok := s.Next(t)
+	return !ok && !s.Active(t)
+}
+
+// PolicyScheduleStatus is a scheduled policy; Deployed is whether the policy is currently held by the xApps
+type PolicyScheduleStatus struct {
+	PolicyTypeID   string
+	PolicyID       string
+	Owner          string
+	Schedule       *PolicySchedule
+	Deployed       bool
+	NextTransition time.Time
+}
+
+func newPolicySchedules(policyIntentStore store.Store) *policySchedules {
+	return &policySchedules{
+		store:    policyIntentStore,
+		deployed: make(map[store.PolicyIntentKey]bool),
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// policySchedules tracks whether the scheduled policies are held by the xApps, assuming at first that they hold the
+// policies whose window is open. The state is written through to the policy intent store and loaded back from it when
+// A1T starts, so that a restart does not send again, or fail to withdraw, the policies of the closed windows.
+type policySchedules struct {
+	store    store.Store
+	deployed map[store.PolicyIntentKey]bool
+	wake     chan struct{}
+	mu       sync.RWMutex
+}
+
+// load rebuilds the state from the store
+func (s *policySchedules) load(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, deployed := range store.ListPolicyDeployments(ctx, s.store) {
+		s.deployed[key] = deployed
+	}
+}
+
+func (s *policySchedules) get(key store.PolicyIntentKey) (bool, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	deployed, ok := s.deployed[key]
+	return deployed, ok
+}
+
+func (s *policySchedules) set(ctx context.Context, key store.PolicyIntentKey, deployed bool) {
+	s.mu.Lock()
+	s.deployed[key] = deployed
+	if err := store.SetPolicyDeployed(ctx, s.store, key, deployed); err != nil {
+		log.Warnf("Failed to store whether policy %v of type %v is deployed: %v", key.PolicyID, key.PolicyTypeID, err)
+	}
+	s.mu.Unlock()
+	s.notify()
+}
+
+func (s *policySchedules) forget(ctx context.Context, key store.PolicyIntentKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.deployed[key]; !ok {
+		return
+	}
+	delete(s.deployed, key)
+	if err := store.DeletePolicyDeployment(ctx, s.store, key); err != nil {
+		log.Warnf("Failed to forget whether policy %v of type %v is deployed: %v", key.PolicyID, key.PolicyTypeID, err)
+	}
+}
+
+// notify wakes the scheduler up to recompute its next transition
+func (s *policySchedules) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// isDeployed returns whether the xApps hold the policy; policies unknown to A1T are assumed to be held
+func (a *a1pController) isDeployed(ctx context.Context, policyTypeID, policyID string) bool {
+	if deployed, ok := a.policySchedules.get(store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID}); ok {
+		return deployed
+	}
+	intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, policyTypeID, policyID)
+	if err != nil || !intent.Exists() {
+		return true
+	}
+	schedule, err := ParsePolicySchedule(intent.Latest().Params)
+	if err != nil || schedule == nil {
+		return true
+	}
+	return schedule.Active(time.Now())
+}
+
+// runScheduler activates, withdraws and expires the scheduled policies until the context is done
+func (a *a1pController) runScheduler(ctx context.Context) {
+	log.Info("Start the policy scheduler")
+	ctx = WithPrivileged(ctx)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+		case <-a.policySchedules.wake:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		case <-ctx.Done():
+			return
+		}
+
+		wait := maxSchedulerWait
+		if next := a.reconcileSchedules(ctx, time.Now()); !next.IsZero() && time.Until(next) < wait {
+			wait = time.Until(next)
+		}
+		timer.Reset(wait)
+	}
+}
+
+// reconcileSchedules brings the xApps in line with the validity windows at now and returns the next transition
+func (a *a1pController) reconcileSchedules(ctx context.Context, now time.Time) time.Time {
+	var next time.Time
+	for key, intent := range store.ListPolicyIntents(ctx, a.policyIntentStore, "") {
+		if !intent.Exists() {
+			a.policySchedules.forget(ctx, key)
+			continue
+		}
+		latest := intent.Latest()
+		schedule, err := ParsePolicySchedule(latest.Params)
+		if err != nil || schedule == nil {
+			a.policySchedules.forget(ctx, key)
+			continue
+		}
+
+		active := schedule.Active(now)
+		deployed, ok := a.policySchedules.get(key)
+		if !ok {
+			deployed = active
+			a.policySchedules.set(ctx, key, deployed)
+		}
+		if active != deployed {
+			if err := a.applySchedule(ctx, key, latest.Revision, active); err != nil {
+				log.Warnf("Failed to apply the schedule of policy %v of type %v: %v", key.PolicyID, key.PolicyTypeID, err)
+				continue
+			}
+			// a concurrent write of the policy may have changed it instead
+			if deployed, ok = a.policySchedules.get(key); !ok {
+				continue
+			}
+		}
+
+		if !deployed && schedule.Expired(now) {
+			if err := a.expirePolicy(ctx, key, latest.Revision); err != nil {
+				log.Warnf("Failed to expire policy %v of type %v: %v", key.PolicyID, key.PolicyTypeID, err)
+			}
+			continue
+		}
+		if t, ok := schedule.Next(now); ok && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	return next
+}
+
+// applySchedule sends the policy to the xApps when its window opens and withdraws it when the window closes; the
+// policy intent is left unchanged. Nothing is sent if the policy was written since the scheduler read its revision, or
+// if the write already brought the xApps in line with the window.
+func (a *a1pController) applySchedule(ctx context.Context, key store.PolicyIntentKey, revision uint64, activate bool) error {
+	unlock := a.policyLocks.lock(key)
+	defer unlock()
+	intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, key.PolicyTypeID, key.PolicyID)
+	if err != nil || !intent.Exists() || intent.Latest().Revision != revision {
+		a.policySchedules.notify()
+		return nil
+	}
+	if deployed, ok := a.policySchedules.get(key); ok && deployed == activate {
+		return nil
+	}
+	latest := intent.Latest()
+
+	ctx = audit.WithCaller(ctx, SchedulerCaller, uuid.New().String())
+	caller, requestID := audit.CallerFromContext(ctx)
+	operation := audit.PolicyDeactivate
+	if activate {
+		operation = audit.PolicyActivate
+	}
+	record := audit.NewRecord(operation, key.PolicyTypeID, key.PolicyID, caller, requestID)
+	record.Revision = latest.Revision
+
+	if activate {
+		record.NewBody = latest.PolicyObject
+		err = a.policySetup(audit.NewContext(ctx, record), stream.PolicySetup, key.PolicyID, key.PolicyTypeID, latest.Params, latest.PolicyObject)
+	} else {
+		record.OldBody = latest.PolicyObject
+		err = a.policyDelete(audit.NewContext(ctx, record), key.PolicyID, key.PolicyTypeID)
+		if err == nil {
+			a.policySchedules.set(ctx, key, false)
+		}
+	}
+	if err == nil {
+		log.Infof("%v policy %v of type %v", operation, key.PolicyID, key.PolicyTypeID)
+	}
+
+	if auditErr := a.auditLog.Append(ctx, record); auditErr != nil {
+		log.Warn(auditErr)
+	}
+	return err
+}
+
+// expirePolicy deletes the intent of a policy whose last window closed, unless it was written since the revision; the
+// xApps no longer hold it
+func (a *a1pController) expirePolicy(ctx context.Context, key store.PolicyIntentKey, revision uint64) error {
+	ctx = audit.WithCaller(ctx, SchedulerCaller, uuid.New().String())
+	ctx = WithPrecondition(ctx, Precondition{IfMatch: []string{PolicyETag(revision)}})
+	err := a.auditPolicyOperation(ctx, audit.PolicyExpire, key.PolicyID, key.PolicyTypeID, nil, nil, func(ctx context.Context) error {
+		return nil
+	})
+	if err == nil {
+		a.policySchedules.forget(ctx, key)
+		log.Infof("Policy %v of type %v expired", key.PolicyID, key.PolicyTypeID)
+	}
+	return err
+}
+
+// HandleListPolicySchedules returns the scheduled policies of the type, or of every type if policyTypeID is empty
+func (a *a1pController) HandleListPolicySchedules(ctx context.Context, policyTypeID string) []*PolicyScheduleStatus {
+	now := time.Now()
+	results := make([]*PolicyScheduleStatus, 0)
+	for key, intent := range store.ListPolicyIntents(ctx, a.policyIntentStore, policyTypeID) {
+		if !intent.Exists() {
+			continue
+		}
+		latest := intent.Latest()
+		schedule, err := ParsePolicySchedule(latest.Params)
+		if err != nil || schedule == nil {
+			continue
+		}
+		status := &PolicyScheduleStatus{
+			PolicyTypeID: key.PolicyTypeID,
+			PolicyID:     key.PolicyID,
+			Owner:        latest.Owner,
+			Schedule:     schedule,
+			Deployed:     a.isDeployed(ctx, key.PolicyTypeID, key.PolicyID),
+		}
+		if next, ok := schedule.Next(now); ok {
+			status.NextTransition = next
+		}
+		results = append(results, status)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].PolicyTypeID != results[j].PolicyTypeID {
+			return results[i].PolicyTypeID < results[j].PolicyTypeID
+		}
+		return results[i].PolicyID < results[j].PolicyID
+	})
+	return results
+}
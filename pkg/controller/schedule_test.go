@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var scheduleDay = time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func at(day, hour int) time.Time {
+	return scheduleDay.Add(time.Duration(day)*24*time.Hour + time.Duration(hour)*time.Hour)
+}
+
+func TestParsePolicySchedule(t *testing.T) {
+	schedule, err := ParsePolicySchedule(map[string]string{"other": "value"})
+	assert.NoError(t, err)
+	assert.Nil(t, schedule)
+
+	schedule, err = ParsePolicySchedule(map[string]string{
+		utils.ValidFrom:       at(0, 10).Format(time.RFC3339),
+		utils.ValidUntil:      at(0, 12).Format(time.RFC3339),
+		utils.Recurrence:      "daily",
+		utils.RecurrenceUntil: at(7, 0).Format(time.RFC3339),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &PolicySchedule{ValidFrom: at(0, 10), ValidUntil: at(0, 12), Recurrence: RecurrenceDaily, RecurrenceUntil: at(7, 0)}, schedule)
+
+	schedule, err = ParsePolicySchedule(map[string]string{utils.ValidUntil: at(0, 12).Format(time.RFC3339)})
+	assert.NoError(t, err)
+	assert.Equal(t, &PolicySchedule{ValidUntil: at(0, 12)}, schedule)
+
+	for name, params := range map[string]map[string]string{
+		"invalid time":            {utils.ValidFrom: "tomorrow"},
+		"until before from":       {utils.ValidFrom: at(0, 12).Format(time.RFC3339), utils.ValidUntil: at(0, 10).Format(time.RFC3339)},
+		"unknown recurrence":      {utils.ValidFrom: at(0, 10).Format(time.RFC3339), utils.ValidUntil: at(0, 12).Format(time.RFC3339), utils.Recurrence: "monthly"},
+		"open recurring window":   {utils.ValidFrom: at(0, 10).Format(time.RFC3339), utils.Recurrence: "daily"},
+		"window longer than day":  {utils.ValidFrom: at(0, 10).Format(time.RFC3339), utils.ValidUntil: at(1, 10).Format(time.RFC3339), utils.Recurrence: "daily"},
+		"recurrence end only":     {utils.ValidFrom: at(0, 10).Format(time.RFC3339), utils.RecurrenceUntil: at(7, 0).Format(time.RFC3339)},
+		"window longer than week": {utils.ValidFrom: at(0, 10).Format(time.RFC3339), utils.ValidUntil: at(8, 10).Format(time.RFC3339), utils.Recurrence: "weekly"},
+	} {
+		_, err := ParsePolicySchedule(params)
+		assert.True(t, errors.IsInvalid(err), name)
+	}
+}
+
+func TestPolicyScheduleWindow(t *testing.T) {
+	never := time.Time{}
+	tests := []struct {
+		name     string
+		schedule *PolicySchedule
+		t        time.Time
+		active   bool
+		next     time.Time
+		expired  bool
+	}{
+		{"before the window", &PolicySchedule{ValidFrom: at(0, 10), ValidUntil: at(0, 12)}, at(0, 9), false, at(0, 10), false},
+		{"window opens", &PolicySchedule{ValidFrom: at(0, 10), ValidUntil: at(0, 12)}, at(0, 10), true, at(0, 12), false},
+		{"window closes", &PolicySchedule{ValidFrom: at(0, 10), ValidUntil: at(0, 12)}, at(0, 12), false, never, true},
+		{"until only", &PolicySchedule{ValidUntil: at(0, 12)}, at(0, 9), true, at(0, 12), false},
+		{"until only expired", &PolicySchedule{ValidUntil: at(0, 12)}, at(0, 13), false, never, true},
+		{"from only", &PolicySchedule{ValidFrom: at(0, 10)}, at(5, 0), true, never, false},
+		{"daily in window", &PolicySchedule{ValidFrom: at(0, 10), ValidUntil: at(0, 12), Recurrence: RecurrenceDaily}, at(3, 11), true, at(3, 12), false},
+		{"daily between windows", &PolicySchedule{ValidFrom: at(0, 10), ValidUntil: at(0, 12), Recurrence: RecurrenceDaily}, at(3, 13), false, at(4, 10), false},
+		{"daily before the first window", &PolicySchedule{ValidFrom: at(1, 10), ValidUntil: at(1, 12), Recurrence: RecurrenceDaily}, at(0, 11), false, at(1, 10), false},
+		{"daily last window", &PolicySchedule{ValidFrom: at(0, 10), ValidUntil: at(0, 12), Recurrence: RecurrenceDaily, RecurrenceUntil: at(2, 10)}, at(1, 11), true, at(1, 12), false},
+		{"daily recurrence ended", &PolicySchedule{ValidFrom: at(0, 10), ValidUntil: at(0, 12), Recurrence: RecurrenceDaily, RecurrenceUntil: at(2, 10)}, at(1, 13), false, never, true},
+		{"daily after the recurrence", &PolicySchedule{ValidFrom: at(0, 10), ValidUntil: at(0, 12), Recurrence: RecurrenceDaily, RecurrenceUntil: at(2, 10)}, at(2, 11), false, never, true},
+		{"weekly in window", &PolicySchedule{ValidFrom: at(0, 22), ValidUntil: at(1, 2), Recurrence: RecurrenceWeekly}, at(14, 23), true, at(15, 2), false},
+		{"weekly across midnight", &PolicySchedule{ValidFrom: at(0, 22), ValidUntil: at(1, 2), Recurrence: RecurrenceWeekly}, at(15, 1), true, at(15, 2), false},
+		{"weekly between windows", &PolicySchedule{ValidFrom: at(0, 22), ValidUntil: at(1, 2), Recurrence: RecurrenceWeekly}, at(3, 0), false, at(7, 22), false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.active, test.schedule.Active(test.t), test.name)
+		next, ok := test.schedule.Next(test.t)
+		assert.Equal(t, !test.next.IsZero(), ok, test.name)
+		assert.Equal(t, test.next, next, test.name)
+		assert.Equal(t, test.expired, test.schedule.Expired(test.t), test.name)
+	}
+}
+
+func TestPolicySchedulesReload(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewStore()
+	withdrawn := store.PolicyIntentKey{PolicyTypeID: "type-1", PolicyID: "withdrawn"}
+	deployed := store.PolicyIntentKey{PolicyTypeID: "type-1", PolicyID: "deployed"}
+	forgotten := store.PolicyIntentKey{PolicyTypeID: "type-1", PolicyID: "forgotten"}
+
+	schedules := newPolicySchedules(s)
+	schedules.set(ctx, withdrawn, false)
+	schedules.set(ctx, deployed, true)
+	schedules.set(ctx, forgotten, true)
+	schedules.forget(ctx, forgotten)
+
+	// a restarted A1T rebuilds the state from the store
+	schedules = newPolicySchedules(s)
+	schedules.load(ctx)
+	value, ok := schedules.get(withdrawn)
+	assert.True(t, ok)
+	assert.False(t, value)
+	value, ok = schedules.get(deployed)
+	assert.True(t, ok)
+	assert.True(t, value)
+	_, ok = schedules.get(forgotten)
+	assert.False(t, ok)
+}
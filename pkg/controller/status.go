@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PolicyStatusEvent is a policy status reported by an xApp
+type PolicyStatusEvent struct {
+	// ID increases with every status received, so that a watch can be resumed after it
+	ID           uint64
+	PolicyTypeID string
+	PolicyID     string
+	XAppID       string
+	Payload      []byte
+	Timestamp    time.Time
+}
+
+// PolicyStatusFilter selects the policy status events; empty fields match everything
+type PolicyStatusFilter struct {
+	PolicyTypeID string
+	PolicyID     string
+	XAppID       string
+}
+
+func (f PolicyStatusFilter) matches(event PolicyStatusEvent) bool {
+	return (f.PolicyTypeID == "" || f.PolicyTypeID == event.PolicyTypeID) &&
+		(f.PolicyID == "" || f.PolicyID == event.PolicyID) &&
+		(f.XAppID == "" || f.XAppID == event.XAppID)
+}
+
+// policyStatusBufferSize is the number of status events buffered for each watcher
+const policyStatusBufferSize = 256
+
+// PolicyStatusWatchOptions defines the status events sent to a watcher
+type PolicyStatusWatchOptions struct {
+	Filter PolicyStatusFilter
+	// Snapshot sends the last status reported by each xApp before the new ones
+	Snapshot bool
+	// ResumeAfter replays the buffered events that came after the event with this ID; 0 replays nothing
+	ResumeAfter uint64
+}
+
+type policyStatusKey struct {
+	policyTypeID string
+	policyID     string
+	xAppID       string
+}
+
+type policyStatusWatcher struct {
+	filter PolicyStatusFilter
+	ch     chan PolicyStatusEvent
+}
+
+// policyStatuses keeps the last status reported by each xApp for each policy and a bounded history of the status
+// events, and fans the new ones out to the watchers
+type policyStatuses struct {
+	latest      map[policyStatusKey]PolicyStatusEvent
+	history     []PolicyStatusEvent
+	historySize int
+	lastID      uint64
+	watchers    map[uuid.UUID]policyStatusWatcher
+	mu          sync.Mutex
+}
+
+func newPolicyStatuses(historySize int) *policyStatuses {
+	return &policyStatuses{
+		latest:      make(map[policyStatusKey]PolicyStatusEvent),
+		history:     make([]PolicyStatusEvent, 0),
+		historySize: historySize,
+		watchers:    make(map[uuid.UUID]policyStatusWatcher),
+	}
+}
+
+func (p *policyStatuses) update(event PolicyStatusEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastID++
+	event.ID = p.lastID
+	if p.historySize > 0 {
+		if len(p.history) >= p.historySize {
+			p.history = p.history[len(p.history)-p.historySize+1:]
+		}
+		p.history = append(p.history, event)
+	}
+	p.latest[policyStatusKey{policyTypeID: event.PolicyTypeID, policyID: event.PolicyID, xAppID: event.XAppID}] = event
+	for id, w := range p.watchers {
+		if !w.filter.matches(event) {
+			continue
+		}
+		select {
+		case w.ch <- event:
+		default:
+			log.Warnf("Policy status watcher %v is full - status of policy %v/%v from xApp %v dropped", id, event.PolicyTypeID, event.PolicyID, event.XAppID)
+		}
+	}
+}
+
+// get returns the last status the xApp reported for the policy
+func (p *policyStatuses) get(policyTypeID, policyID, xAppID string) (PolicyStatusEvent, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	event, ok := p.latest[policyStatusKey{policyTypeID: policyTypeID, policyID: policyID, xAppID: xAppID}]
+	return event, ok
+}
+
+// remove forgets the statuses of a deleted policy
+func (p *policyStatuses) remove(policyTypeID, policyID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for k := range p.latest {
+		if k.policyTypeID == policyTypeID && k.policyID == policyID {
+			delete(p.latest, k)
+		}
+	}
+}
+
+// watch sends the matching status events to ch until the context is done, starting with the snapshot and
+// the replayed events asked for by the options; if allowed is not nil, only the events it allows are sent
+func (p *policyStatuses) watch(ctx context.Context, options PolicyStatusWatchOptions, allowed func(PolicyStatusEvent) bool, ch chan<- PolicyStatusEvent) {
+	id := uuid.New()
+	in := make(chan PolicyStatusEvent, policyStatusBufferSize)
+	filter := options.Filter
+	events := make([]PolicyStatusEvent, 0)
+
+	// the first events are collected and the watcher added at once so that no status is missed in between
+	p.mu.Lock()
+	if options.Snapshot {
+		for _, event := range p.latest {
+			if filter.matches(event) {
+				events = append(events, event)
+			}
+		}
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].ID < events[j].ID
+		})
+	}
+	if options.ResumeAfter > 0 {
+		if len(p.history) > 0 && p.history[0].ID > options.ResumeAfter+1 {
+			log.Warnf("Status events %v to %v are no longer buffered - resuming with event %v", options.ResumeAfter+1, p.history[0].ID-1, p.history[0].ID)
+		}
+		for _, event := range p.history {
+			if event.ID > options.ResumeAfter && filter.matches(event) {
+				events = append(events, event)
+			}
+		}
+	}
+	p.watchers[id] = policyStatusWatcher{filter: filter, ch: in}
+	p.mu.Unlock()
+
+	go func() {
+		defer func() {
+			p.mu.Lock()
+			delete(p.watchers, id)
+			p.mu.Unlock()
+			close(ch)
+		}()
+		for _, event := range events {
+			if allowed != nil && !allowed(event) {
+				continue
+			}
+			select {
+			case ch <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+		for {
+			select {
+			case event := <-in:
+				if allowed != nil && !allowed(event) {
+					continue
+				}
+				select {
+				case ch <- event:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStatusEvent(policyID, xAppID string) PolicyStatusEvent {
+	return PolicyStatusEvent{PolicyTypeID: "type-1", PolicyID: policyID, XAppID: xAppID, Payload: []byte(policyID)}
+}
+
+// receiveStatusEvents returns the IDs of the policies of the events received until none comes for a while
+func receiveStatusEvents(ch <-chan PolicyStatusEvent) []string {
+	policyIDs := make([]string, 0)
+	for {
+		select {
+		case event := <-ch:
+			policyIDs = append(policyIDs, event.PolicyID)
+		case <-time.After(100 * time.Millisecond):
+			return policyIDs
+		}
+	}
+}
+
+func TestPolicyStatusWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := newPolicyStatuses(2)
+	p.update(newStatusEvent("policy-1", "xapp-1"))
+	p.update(newStatusEvent("policy-2", "xapp-1"))
+	p.update(newStatusEvent("policy-1", "xapp-2"))
+	p.update(newStatusEvent("policy-1", "xapp-1"))
+
+	// the history keeps the last two events, and the snapshot the last status of each xApp for each policy
+	ch := make(chan PolicyStatusEvent)
+	p.watch(ctx, PolicyStatusWatchOptions{ResumeAfter: 1}, nil, ch)
+	assert.Equal(t, []string{"policy-1", "policy-1"}, receiveStatusEvents(ch))
+
+	ch = make(chan PolicyStatusEvent)
+	p.watch(ctx, PolicyStatusWatchOptions{Snapshot: true, Filter: PolicyStatusFilter{XAppID: "xapp-1"}}, nil, ch)
+	assert.Equal(t, []string{"policy-2", "policy-1"}, receiveStatusEvents(ch))
+	p.update(newStatusEvent("policy-3", "xapp-2"))
+	p.update(newStatusEvent("policy-3", "xapp-1"))
+	assert.Equal(t, []string{"policy-3"}, receiveStatusEvents(ch))
+
+	event, ok := p.get("type-1", "policy-3", "xapp-1")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(6), event.ID)
+	p.remove("type-1", "policy-3")
+	_, ok = p.get("type-1", "policy-3", "xapp-1")
+	assert.False(t, ok)
+
+	cancel()
+	_, open := <-ch
+	assert.False(t, open)
+}
+
+func TestWatchPolicyStatusOwner(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := &a1pController{policyIntentStore: store.NewStore(), policyStatuses: newPolicyStatuses(16)}
+	_, err := store.AddPolicyRevision(ctx, a.policyIntentStore, "type-1", "owned", nil, map[string]interface{}{}, false, "tenant-1")
+	assert.NoError(t, err)
+	_, err = store.AddPolicyRevision(ctx, a.policyIntentStore, "type-1", "other", nil, map[string]interface{}{}, false, "tenant-2")
+	assert.NoError(t, err)
+
+	tenant := make(chan PolicyStatusEvent)
+	assert.NoError(t, a.WatchPolicyStatus(WithOwner(ctx, "tenant-1"), PolicyStatusWatchOptions{}, tenant))
+	privileged := make(chan PolicyStatusEvent)
+	assert.NoError(t, a.WatchPolicyStatus(WithPrivileged(ctx), PolicyStatusWatchOptions{}, privileged))
+
+	a.policyStatuses.update(newStatusEvent("owned", "xapp-1"))
+	a.policyStatuses.update(newStatusEvent("other", "xapp-1"))
+	a.policyStatuses.update(newStatusEvent("unknown", "xapp-1"))
+	assert.Equal(t, []string{"owned"}, receiveStatusEvents(tenant))
+	assert.Equal(t, []string{"owned", "other", "unknown"}, receiveStatusEvents(privileged))
+
+	a.requireTenant = true
+	err = a.WatchPolicyStatus(ctx, PolicyStatusWatchOptions{}, make(chan PolicyStatusEvent))
+	assert.True(t, errors.IsForbidden(err))
+}
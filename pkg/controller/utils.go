@@ -7,50 +7,86 @@ package controller
 import (
 	"context"
 	"fmt"
-	"github.com/google/uuid"
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/policydiff"
+	"github.com/onosproject/onos-a1t/pkg/southbound"
 	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-a1t/pkg/utils"
 	"github.com/onosproject/onos-api/go/onos/a1t/a1"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"reflect"
-	"time"
+	"strings"
 )
 
-func waitRespMsgWithTimer(id stream.ID, watcherID uuid.UUID, reqID string, respCh chan *stream.SBStreamMessage, outputCh chan interface{}, timeout time.Duration, streamBroker stream.Broker) {
-	defer streamBroker.DeleteWatcher(id, watcherID)
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	for {
-		select {
-		case resp := <-respCh:
-			switch msg := resp.Payload.(type) {
-			case *a1.PolicyResultMessage:
-				if msg.Message.Header.RequestId == reqID {
-					outputCh <- resp
-					return
-				}
-			}
-		case <-ctx.Done():
-			outputCh <- errors.NewTimeout("Could not receive PolicyResultMessage in time (timer: %v)", TimeoutTimer)
-			return
+// checkOutput returns the PolicyResultMessage carried by the response, or an error if the xApp reported a failure
+func checkOutput(resp *stream.SBStreamMessage) (*a1.PolicyResultMessage, error) {
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	switch msg := resp.Payload.(type) {
+	case *a1.PolicyResultMessage:
+		if !msg.Message.Result.Success {
+			return nil, fmt.Errorf(msg.Message.Result.Reason)
 		}
+		return msg, nil
+	default:
+		return nil, errors.NewNotSupported("the response message %v should not come into A1T", reflect.TypeOf(msg))
+	}
+}
+
+// deliveryFailure returns the error if it counts against the circuit of the xApp; a request rejected by the
+// xApp reached it and a request that expired in the local southbound queue never left A1T, so neither counts. The
+// requests the caller gave up on are not passed here.
+func deliveryFailure(err error) error {
+	if _, ok := southbound.IsOverloaded(err); ok {
+		return nil
 	}
+	return err
 }
 
-func checkOutput(output interface{}) (error, interface{}) {
-	switch o := output.(type) {
-	case error:
-		return o, nil
-	case *stream.SBStreamMessage:
-		switch resp := o.Payload.(type) {
-		case *a1.PolicyResultMessage:
-			if !resp.Message.Result.Success {
-				return fmt.Errorf(resp.Message.Result.Reason), nil
-			}
-			return nil, resp
-		default:
-			return errors.NewNotSupported("the response message %v should not come into A1T", reflect.TypeOf(resp)), nil
+func setAuditTargets(ctx context.Context, targetXAppIDs []string) {
+	if record, ok := audit.FromContext(ctx); ok {
+		record.SetTargetXApps(targetXAppIDs)
+	}
+}
+
+func recordAuditOutcome(ctx context.Context, targetXAppID string, err error) {
+	if record, ok := audit.FromContext(ctx); ok {
+		record.AddOutcome(targetXAppID, err)
+	}
+}
+
+// checkConsistency returns a Conflict error naming the first xApp whose policy IDs differ from the ones of the first xApp
+func checkConsistency(objs interface{}, xAppIDs []string) error {
+	i, err := utils.PolicyObjListDivergence(objs)
+	if err != nil {
+		return err
+	}
+	if i > 0 {
+		return errors.NewConflict("PolicyObject is inconsistent: xApp %v differs from xApp %v", xAppIDs[i], xAppIDs[0])
+	}
+	return nil
+}
+
+// checkObjectConsistency returns a Conflict error naming the xApps whose policy object or status differs from the one of
+// the first xApp, with the JSON pointers of the differences; the members equal to their schema default are ignored
+func checkObjectConsistency(policyTypeID string, payloadType a1.PayloadType, objs []map[string]interface{}, xAppIDs []string) error {
+	if len(objs) == 0 {
+		return errors.NewNotFound("there is no policy object")
+	}
+	comparator := policydiff.ForPayloadType(policyTypeID, payloadType)
+	conflicts := make([]string, 0)
+	for i := 1; i < len(objs); i++ {
+		diffs, err := comparator.Diff(objs[0], objs[i])
+		if err != nil {
+			return err
 		}
-	default:
-		return errors.NewNotSupported("the response message %v should not come into A1T", reflect.TypeOf(o)), nil
+		if len(diffs) > 0 {
+			conflicts = append(conflicts, fmt.Sprintf("xApp %v differs from xApp %v at %v", xAppIDs[i], xAppIDs[0], strings.Join(policydiff.Paths(diffs), ", ")))
+		}
+	}
+	if len(conflicts) > 0 {
+		return errors.NewConflict("PolicyObject is inconsistent: %v", strings.Join(conflicts, "; "))
 	}
+	return nil
 }
@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/policydiff"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// ReconcileResult is the result of pushing a policy intent to an xApp again
+type ReconcileResult struct {
+	PolicyTypeID string
+	PolicyID     string
+	// Action is PolicySetup if the xApp did not hold the policy, PolicyUpdate otherwise
+	Action stream.A1SBIRPCType
+	Err    error
+}
+
+// drainedXApps are the xApps new policies are not routed to
+type drainedXApps struct {
+	xAppIDs map[string]bool
+	mu      sync.RWMutex
+}
+
+func newDrainedXApps() *drainedXApps {
+	return &drainedXApps{
+		xAppIDs: make(map[string]bool),
+	}
+}
+
+func (d *drainedXApps) set(xAppID string, drained bool) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.xAppIDs[xAppID] == drained {
+		return false
+	}
+	if drained {
+		d.xAppIDs[xAppID] = true
+	} else {
+		delete(d.xAppIDs, xAppID)
+	}
+	return true
+}
+
+func (d *drainedXApps) contains(xAppID string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.xAppIDs[xAppID]
+}
+
+func (d *drainedXApps) list() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	xAppIDs := make([]string, 0, len(d.xAppIDs))
+	for xAppID := range d.xAppIDs {
+		xAppIDs = append(xAppIDs, xAppID)
+	}
+	sort.Strings(xAppIDs)
+	return xAppIDs
+}
+
+// filter returns the target xApps which are not drained
+func (d *drainedXApps) filter(targetXAppIDs []string) []string {
+	results := make([]string, 0, len(targetXAppIDs))
+	for _, xAppID := range targetXAppIDs {
+		if d.contains(xAppID) {
+			log.Infof("xApp %v is drained - skipping it", xAppID)
+			continue
+		}
+		results = append(results, xAppID)
+	}
+	return results
+}
+
+// HandleDrainXApp stops routing new policies to the xApp, or routes them again if drained is false; the policies the
+// xApp holds are still updated and deleted
+func (a *a1pController) HandleDrainXApp(ctx context.Context, xAppID string, drained bool) error {
+	if _, err := a.subscriptionStore.Get(ctx, store.SubscriptionKey{TargetXAppID: topoapi.ID(xAppID)}); err != nil && drained {
+		return err
+	}
+	if !a.drainedXApps.set(xAppID, drained) {
+		return nil
+	}
+	operation := audit.XAppUndrain
+	if drained {
+		operation = audit.XAppDrain
+		log.Infof("xApp %v drained", xAppID)
+	} else {
+		log.Infof("xApp %v no longer drained", xAppID)
+	}
+	caller, requestID := audit.CallerFromContext(ctx)
+	record := audit.NewRecord(operation, "", "", caller, requestID)
+	record.SetTargetXApps([]string{xAppID})
+	record.AddOutcome(xAppID, nil)
+	if err := a.auditLog.Append(ctx, record); err != nil {
+		log.Warn(err)
+	}
+	return nil
+}
+
+func (a *a1pController) DrainedXApps() []string {
+	return a.drainedXApps.list()
+}
+
+// HandleReconcileXApp pushes the deployed policy intents of the policy types of the xApp to it again, with
+// PolicySetup for the policies it lost and PolicyUpdate for the ones whose body differs; each policy pushed is audited
+func (a *a1pController) HandleReconcileXApp(ctx context.Context, xAppID string) ([]*ReconcileResult, error) {
+	if a.drainedXApps.contains(xAppID) {
+		return nil, errors.NewConflict("xApp %v is drained", xAppID)
+	}
+	entry, err := a.subscriptionStore.Get(ctx, store.SubscriptionKey{TargetXAppID: topoapi.ID(xAppID)})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*ReconcileResult, 0)
+	for _, c := range entry.Value.(*store.SubscriptionValue).A1ServiceCapabilities {
+		if c.A1Service != store.PolicyManagement {
+			continue
+		}
+		held, err := a.queryXAppPolicyIDs(ctx, xAppID, c.TypeID)
+		if err != nil {
+			return nil, err
+		}
+		intents := store.ListPolicyIntents(ctx, a.policyIntentStore, c.TypeID)
+		keys := make([]store.PolicyIntentKey, 0, len(intents))
+		for key, intent := range intents {
+			if intent.Exists() {
+				keys = append(keys, key)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].PolicyID < keys[j].PolicyID
+		})
+		for _, key := range keys {
+			if result := a.reconcilePolicy(ctx, xAppID, key, held[key.PolicyID]); result != nil {
+				results = append(results, result)
+			}
+		}
+	}
+	log.Infof("Reconciled %d policies with xApp %v", len(results), xAppID)
+	return results, nil
+}
+
+// reconcilePolicy pushes the latest revision of the policy to the xApp; it returns nil if the policy is not deployed or
+// the xApp already holds an equivalent body
+func (a *a1pController) reconcilePolicy(ctx context.Context, xAppID string, key store.PolicyIntentKey, held bool) *ReconcileResult {
+	unlock := a.policyLocks.lock(key)
+	defer unlock()
+	intent, err := store.GetPolicyIntent(ctx, a.policyIntentStore, key.PolicyTypeID, key.PolicyID)
+	if err != nil || !intent.Exists() || !a.isDeployed(ctx, key.PolicyTypeID, key.PolicyID) {
+		return nil
+	}
+	latest := intent.Latest()
+	result := &ReconcileResult{
+		PolicyTypeID: key.PolicyTypeID,
+		PolicyID:     key.PolicyID,
+		Action:       stream.PolicySetup,
+	}
+	if held {
+		if a.holdsPolicyObject(ctx, xAppID, key, latest.PolicyObject) {
+			return nil
+		}
+		result.Action = stream.PolicyUpdate
+	}
+
+	caller, requestID := audit.CallerFromContext(ctx)
+	record := audit.NewRecord(audit.PolicyReconcile, key.PolicyTypeID, key.PolicyID, caller, requestID)
+	record.Revision = latest.Revision
+	record.NewBody = latest.PolicyObject
+	record.SetTargetXApps([]string{xAppID})
+
+	obj, err := json.Marshal(latest.PolicyObject)
+	if err == nil {
+		reqMsg := newPolicyRequestMessage(xAppID, key.PolicyID, key.PolicyTypeID, a1.PayloadType_POLICY)
+		reqMsg.Message.Payload = obj
+		if callbackURI, ok := latest.Params[utils.NotificationDestination]; ok {
+			reqMsg.NotificationDestination = callbackURI
+		}
+		_, err = a.sendPolicyRequest(ctx, xAppID, result.Action, reqMsg)
+	}
+	result.Err = err
+	record.AddOutcome(xAppID, err)
+	if err := a.auditLog.Append(ctx, record); err != nil {
+		log.Warn(err)
+	}
+	return result
+}
+
+// holdsPolicyObject returns whether the body of the policy held by the xApp is equivalent to the policy object
+func (a *a1pController) holdsPolicyObject(ctx context.Context, xAppID string, key store.PolicyIntentKey, policyObject map[string]interface{}) bool {
+	reqMsg := newPolicyRequestMessage(xAppID, key.PolicyID, key.PolicyTypeID, a1.PayloadType_POLICY)
+	resp, err := a.sendPolicyRequest(ctx, xAppID, stream.PolicyQuery, reqMsg)
+	if err != nil {
+		return false
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(resp.Message.Payload, &body); err != nil {
+		return false
+	}
+	equal, err := policydiff.ForPolicyType(key.PolicyTypeID).Equal(body, policyObject)
+	return err == nil && equal
+}
+
+// queryXAppPolicyIDs returns the IDs of the policies of the type the xApp holds
+func (a *a1pController) queryXAppPolicyIDs(ctx context.Context, xAppID, policyTypeID string) (map[string]bool, error) {
+	reqMsg := newPolicyRequestMessage(xAppID, "", policyTypeID, a1.PayloadType_POLICY)
+	resp, err := a.sendPolicyRequest(ctx, xAppID, stream.PolicyQuery, reqMsg)
+	if err != nil {
+		return nil, err
+	}
+	var policyIDs []string
+	if err := json.Unmarshal(resp.Message.Payload, &policyIDs); err != nil {
+		return nil, err
+	}
+	held := make(map[string]bool, len(policyIDs))
+	for _, policyID := range policyIDs {
+		held[policyID] = true
+	}
+	return held, nil
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dme
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// Client is the A1-DME consumer client of the Non-RT RIC
+type Client interface {
+	GetInfoTypes(ctx context.Context) ([]string, error)
+	GetInfoType(ctx context.Context, infoTypeID string) (InfoTypeObject, error)
+	// GetInfoJobs returns the IDs of the jobs of the type and owner; empty values match every job
+	GetInfoJobs(ctx context.Context, infoTypeID, owner string) ([]string, error)
+	GetInfoJob(ctx context.Context, infoJobID string) (*InfoJobObject, error)
+	// PutInfoJob creates or updates the job and returns whether it was created
+	PutInfoJob(ctx context.Context, infoJobID string, job *InfoJobObject) (bool, error)
+	DeleteInfoJob(ctx context.Context, infoJobID string) error
+	GetInfoJobStatus(ctx context.Context, infoJobID string) (*InfoJobStatusObject, error)
+}
+
+// NewClient returns the client of the A1-DME API served at the address of the Non-RT RIC, e.g. 127.0.0.1:9640
+func NewClient(address string) Client {
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+	return &client{
+		baseURL:    strings.TrimSuffix(address, "/") + BasePath,
+		httpClient: http.DefaultClient,
+	}
+}
+
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func (c *client) GetInfoTypes(ctx context.Context) ([]string, error) {
+	infoTypeIDs := make([]string, 0)
+	_, err := c.do(ctx, http.MethodGet, "/info-types", nil, &infoTypeIDs)
+	return infoTypeIDs, err
+}
+
+func (c *client) GetInfoType(ctx context.Context, infoTypeID string) (InfoTypeObject, error) {
+	infoType := make(InfoTypeObject)
+	_, err := c.do(ctx, http.MethodGet, "/info-types/"+url.PathEscape(infoTypeID), nil, &infoType)
+	return infoType, err
+}
+
+func (c *client) GetInfoJobs(ctx context.Context, infoTypeID, owner string) ([]string, error) {
+	query := url.Values{}
+	if infoTypeID != "" {
+		query.Set("infoTypeId", infoTypeID)
+	}
+	if owner != "" {
+		query.Set("owner", owner)
+	}
+	path := "/info-jobs"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	infoJobIDs := make([]string, 0)
+	_, err := c.do(ctx, http.MethodGet, path, nil, &infoJobIDs)
+	return infoJobIDs, err
+}
+
+func (c *client) GetInfoJob(ctx context.Context, infoJobID string) (*InfoJobObject, error) {
+	job := &InfoJobObject{}
+	_, err := c.do(ctx, http.MethodGet, "/info-jobs/"+url.PathEscape(infoJobID), nil, job)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (c *client) PutInfoJob(ctx context.Context, infoJobID string, job *InfoJobObject) (bool, error) {
+	status, err := c.do(ctx, http.MethodPut, "/info-jobs/"+url.PathEscape(infoJobID), job, nil)
+	return status == http.StatusCreated, err
+}
+
+func (c *client) DeleteInfoJob(ctx context.Context, infoJobID string) error {
+	_, err := c.do(ctx, http.MethodDelete, "/info-jobs/"+url.PathEscape(infoJobID), nil, nil)
+	return err
+}
+
+func (c *client) GetInfoJobStatus(ctx context.Context, infoJobID string) (*InfoJobStatusObject, error) {
+	status := &InfoJobStatusObject{}
+	_, err := c.do(ctx, http.MethodGet, "/info-jobs/"+url.PathEscape(infoJobID)+"/status", nil, status)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// do sends the request with the JSON body and decodes the JSON response into result; it returns the HTTP status
+func (c *client) do(ctx context.Context, method, path string, body interface{}, result interface{}) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, errors.NewUnavailable("A1-DME %v %v failed: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp.StatusCode, newStatusError(resp.StatusCode, method, path, b)
+	}
+	if result != nil && len(b) > 0 {
+		if err := json.Unmarshal(b, result); err != nil {
+			return resp.StatusCode, errors.NewInvalid("A1-DME %v %v returned an invalid body: %v", method, path, err)
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+// newStatusError maps the error status of the Non-RT RIC to an onos-lib-go error carrying the problem details
+func newStatusError(status int, method, path string, body []byte) error {
+	detail := strings.TrimSpace(string(body))
+	problem := &ProblemDetails{}
+	if json.Unmarshal(body, problem) == nil && problem.Detail != "" {
+		detail = problem.Detail
+	}
+	switch status {
+	case http.StatusNotFound:
+		return errors.NewNotFound("A1-DME %v %v: %v", method, path, detail)
+	case http.StatusBadRequest:
+		return errors.NewInvalid("A1-DME %v %v: %v", method, path, detail)
+	case http.StatusConflict:
+		return errors.NewConflict("A1-DME %v %v: %v", method, path, detail)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return errors.NewForbidden("A1-DME %v %v: %v", method, path, detail)
+	case http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return errors.NewUnavailable("A1-DME %v %v: %v", method, path, detail)
+	}
+	return errors.NewUnknown("A1-DME %v %v returned %d: %v", method, path, status, detail)
+}
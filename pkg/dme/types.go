@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dme
+
+// BasePath is the path of the A1-DME API, at the Non-RT RIC and at A1T
+const BasePath = "/A1-DME/v1"
+
+const (
+	StatusEnabled  = "ENABLED"
+	StatusDisabled = "DISABLED"
+)
+
+// InfoTypeObject describes an information type, e.g. the JSON schema of its job definitions
+type InfoTypeObject map[string]interface{}
+
+// InfoJobObject is an information job: the data of the information type defined by JobDefinition is delivered to
+// JobResultURI until the job is deleted
+type InfoJobObject struct {
+	InfoTypeID               string                 `json:"infoTypeId"`
+	JobOwner                 string                 `json:"jobOwner,omitempty"`
+	JobDefinition            map[string]interface{} `json:"jobDefinition"`
+	JobResultURI             string                 `json:"jobResultUri"`
+	JobStatusNotificationURI string                 `json:"jobStatusNotificationUri,omitempty"`
+}
+
+// InfoJobStatusObject is the status of an information job, ENABLED if the producers are able to deliver its data
+type InfoJobStatusObject struct {
+	InfoJobStatus string `json:"infoJobStatus"`
+}
+
+// ProblemDetails is the error body of the A1-DME API, as defined by RFC 7807
+type ProblemDetails struct {
+	Cause  string `json:"cause,omitempty"`
+	Detail string `json:"detail,omitempty"`
+	Status int    `json:"status,omitempty"`
+}
@@ -19,18 +19,17 @@ import (
 )
 
 type a1pWraper struct {
-	version       string
+	version       *APIVersion
 	a1pController controller.A1PController
 }
 
 var log = logging.GetLogger()
 
-func SetRESTA1PWraper(e *echo.Echo, version string, a1pController controller.A1PController) {
-	wraper := &a1pWraper{
+func newA1PWraper(version *APIVersion, a1pController controller.A1PController) *a1pWraper {
+	return &a1pWraper{
 		version:       version,
 		a1pController: a1pController,
 	}
-	a1p.RegisterHandlers(e, wraper)
 }
 
 // (GET /policytypes)
@@ -44,7 +43,7 @@ func (a1pw *a1pWraper) GetPolicytypesPolicyTypeId(ctx echo.Context, policyTypeId
 	policyTypeSchema, statusSchema, err := a1pw.a1pController.HandleGetPolicytypesPolicyTypeId(ctx.Request().Context(), string(policyTypeId))
 	if err != nil {
 		log.Error(err)
-		return ctx.JSONPretty(http.StatusBadRequest, err.Error(), "  ")
+		return errorResponse(ctx, http.StatusBadRequest, err)
 	}
 
 	policyTypeObject := a1p.PolicyTypeObject{
@@ -57,10 +56,10 @@ func (a1pw *a1pWraper) GetPolicytypesPolicyTypeId(ctx echo.Context, policyTypeId
 
 // (GET /policytypes/{policyTypeId}/policies)
 func (a1pw *a1pWraper) GetPolicytypesPolicyTypeIdPolicies(ctx echo.Context, policyTypeId a1p.PolicyTypeId) error {
-	a1pEntriesValues, err := a1pw.a1pController.HandleGetPolicytypesPolicyTypeIdPolicies(ctx.Request().Context(), string(policyTypeId))
+	a1pEntriesValues, err := a1pw.a1pController.HandleGetPolicytypesPolicyTypeIdPolicies(getRequestContext(ctx), string(policyTypeId))
 	if err != nil {
 		log.Error(err)
-		return ctx.JSONPretty(http.StatusBadRequest, err.Error(), "  ")
+		return errorResponse(ctx, http.StatusBadRequest, err)
 	}
 
 	return ctx.JSONPretty(http.StatusOK, a1pEntriesValues, "  ")
@@ -68,11 +67,12 @@ func (a1pw *a1pWraper) GetPolicytypesPolicyTypeIdPolicies(ctx echo.Context, poli
 
 // (DELETE /policytypes/{policyTypeId}/policies/{policyId})
 func (a1pw *a1pWraper) DeletePolicytypesPolicyTypeIdPoliciesPolicyId(ctx echo.Context, policyTypeId a1p.PolicyTypeId, policyId a1p.PolicyId) error {
-	err := a1pw.a1pController.HandlePolicyDelete(ctx.Request().Context(), string(policyId), string(policyTypeId))
+	reqCtx := controller.WithPrecondition(getRequestContext(ctx), getPrecondition(ctx))
+	err := a1pw.a1pController.HandlePolicyDelete(reqCtx, string(policyId), string(policyTypeId))
 
 	if err != nil {
 		log.Error(err)
-		return ctx.JSONPretty(http.StatusBadRequest, err.Error(), "  ")
+		return errorResponse(ctx, http.StatusBadRequest, err)
 	}
 
 	return ctx.JSONPretty(http.StatusNoContent, nil, "  ")
@@ -80,13 +80,18 @@ func (a1pw *a1pWraper) DeletePolicytypesPolicyTypeIdPoliciesPolicyId(ctx echo.Co
 
 // (GET /policytypes/{policyTypeId}/policies/{policyId})
 func (a1pw *a1pWraper) GetPolicytypesPolicyTypeIdPoliciesPolicyId(ctx echo.Context, policyTypeId a1p.PolicyTypeId, policyId a1p.PolicyId) error {
-	a1pEntryValue, err := a1pw.a1pController.HandleGetPolicy(ctx.Request().Context(), string(policyId), string(policyTypeId))
+	reqCtx := getRequestContext(ctx)
+	a1pEntryValue, err := a1pw.a1pController.HandleGetPolicy(reqCtx, string(policyId), string(policyTypeId))
 	if err != nil {
 		log.Error(err)
-		return ctx.JSONPretty(http.StatusBadRequest, err.Error(), "  ")
+		return errorResponse(ctx, http.StatusBadRequest, err)
+	}
+	// policies written to the xApps without A1T have no revision, hence no entity tag
+	if etag, err := a1pw.a1pController.HandleGetPolicyETag(reqCtx, string(policyId), string(policyTypeId)); err == nil {
+		ctx.Response().Header().Set(ETagHeader, etag)
 	}
 
-	return ctx.JSONPretty(http.StatusOK, a1pEntryValue, "  ")
+	return ctx.JSONPretty(http.StatusOK, a1pw.version.Translator.PolicyObjectOut(a1pEntryValue), "  ")
 }
 
 // (PUT /policytypes/{policyTypeId}/policies/{policyId})
@@ -98,17 +103,28 @@ func (a1pw *a1pWraper) PutPolicytypesPolicyTypeIdPoliciesPolicyId(ctx echo.Conte
 		paramsMap[utils.NotificationDestination] = string(*params.NotificationDestination)
 	}
 
+	// the validity window is an A1T extension of the A1-P API
+	for _, key := range []string{utils.ValidFrom, utils.ValidUntil, utils.Recurrence, utils.RecurrenceUntil} {
+		if value := ctx.QueryParam(key); value != "" {
+			paramsMap[key] = value
+		}
+	}
+	if _, err := controller.ParsePolicySchedule(paramsMap); err != nil {
+		log.Error(err)
+		return errorResponse(ctx, http.StatusBadRequest, err)
+	}
+
 	if err := ctx.Bind(&policyObject); err != nil {
 		log.Error(err)
-		return ctx.JSONPretty(http.StatusOK, err.Error(), "  ")
+		return errorResponse(ctx, http.StatusOK, err)
 	}
 
-	policyObject = utils.GetPolicyObject(policyObject)
+	policyObject = a1pw.version.Translator.PolicyObjectIn(policyObject)
 
 	obj, err := json.Marshal(policyObject)
 	if err != nil {
 		log.Error(err)
-		return ctx.JSONPretty(http.StatusServiceUnavailable, err.Error(), "  ")
+		return errorResponse(ctx, http.StatusServiceUnavailable, err)
 	}
 
 	if !utils.JsonValidateWithTypeID(string(policyTypeId), string(obj)) {
@@ -116,44 +132,38 @@ func (a1pw *a1pWraper) PutPolicytypesPolicyTypeIdPoliciesPolicyId(ctx echo.Conte
 		return ctx.JSONPretty(http.StatusServiceUnavailable, errors.NewInvalid("PolicyObject validation failed: policyObject %v", policyObject).Error(), "  ")
 	}
 
-	a1pEntriesValues, err := a1pw.a1pController.HandleGetPolicytypesPolicyTypeIdPolicies(ctx.Request().Context(), string(policyTypeId))
+	reqCtx, conflicts := controller.WithConflictReport(getRequestContext(ctx))
+	created, etag, err := a1pw.a1pController.HandlePolicyPut(controller.WithPrecondition(reqCtx, getPrecondition(ctx)), string(policyId), string(policyTypeId), paramsMap, policyObject)
 	if err != nil {
 		log.Error(err)
-		return ctx.JSONPretty(http.StatusServiceUnavailable, err.Error(), "  ")
+		return errorResponse(ctx, http.StatusServiceUnavailable, err)
 	}
-
-	hasPolicyID := false
-
-	for _, v := range a1pEntriesValues {
-		if v == string(policyId) {
-			hasPolicyID = true
-		}
+	setConflictHeaders(ctx, conflicts)
+	ctx.Response().Header().Set(ETagHeader, etag)
+	if created {
+		return ctx.JSONPretty(http.StatusCreated, a1pw.version.Translator.PolicyObjectOut(policyObject), "  ")
 	}
+	return ctx.JSONPretty(http.StatusOK, a1pw.version.Translator.PolicyObjectOut(policyObject), "  ")
+}
 
-	if hasPolicyID {
-		err = a1pw.a1pController.HandlePolicyUpdate(ctx.Request().Context(), string(policyId), string(policyTypeId), paramsMap, policyObject)
-		if err != nil {
-			log.Error(err)
-			return ctx.JSONPretty(http.StatusServiceUnavailable, err.Error(), "  ")
-		}
-		return ctx.JSONPretty(http.StatusOK, policyObject, "  ")
+// (GET /policytypes/{policyTypeId}/policies/{policyId}/status)
+func (a1pw *a1pWraper) GetPolicytypesPolicyTypeIdPoliciesPolicyIdStatus(ctx echo.Context, policyTypeId a1p.PolicyTypeId, policyId a1p.PolicyId) error {
+	aggregated, err := a1pw.a1pController.HandleGetAggregatedPolicyStatus(getRequestContext(ctx), string(policyId), string(policyTypeId))
+	if err != nil {
+		log.Error(err)
+		return errorResponse(ctx, http.StatusBadRequest, err)
 	}
-
-	err = a1pw.a1pController.HandlePolicyCreate(ctx.Request().Context(), string(policyId), string(policyTypeId), paramsMap, policyObject)
+	a1pPolicyStatus, err := aggregated.StatusObject()
 	if err != nil {
 		log.Error(err)
-		return ctx.JSONPretty(http.StatusServiceUnavailable, err.Error(), "  ")
+		return errorResponse(ctx, http.StatusServiceUnavailable, err)
 	}
-	return ctx.JSONPretty(http.StatusCreated, policyObject, "  ")
-}
-
-// (GET /policytypes/{policyTypeId}/policies/{policyId}/status)
-func (a1pw *a1pWraper) GetPolicytypesPolicyTypeIdPoliciesPolicyIdStatus(ctx echo.Context, policyTypeId a1p.PolicyTypeId, policyId a1p.PolicyId) error {
-	a1pPolicyStatus, err := a1pw.a1pController.HandleGetPolicyStatus(ctx.Request().Context(), string(policyId), string(policyTypeId))
+	statusObject, err := a1pw.version.Translator.PolicyStatusOut(a1pPolicyStatus)
 	if err != nil {
 		log.Error(err)
-		return ctx.JSONPretty(http.StatusBadRequest, err.Error(), "  ")
+		return errorResponse(ctx, http.StatusServiceUnavailable, err)
 	}
+	setXAppPolicyStatusHeaders(ctx, aggregated)
 
-	return ctx.JSONPretty(http.StatusOK, a1pPolicyStatus, "  ")
+	return ctx.JSONPretty(http.StatusOK, statusObject, "  ")
 }
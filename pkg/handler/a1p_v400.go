@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"github.com/labstack/echo/v4"
+
+	a1p "github.com/onosproject/onos-a1t/pkg/northbound/a1ap/policy_management"
+	a1pv400 "github.com/onosproject/onos-a1t/pkg/northbound/a1ap/v400/policy_management"
+)
+
+// a1pV400Wraper serves the A1-P of A1AP v04.00, whose paths and parameters are the ones of v03.01; the bodies are
+// converted by the translator of the version
+type a1pV400Wraper struct {
+	*a1pWraper
+}
+
+// (GET /policytypes/{policyTypeId})
+func (w *a1pV400Wraper) GetPolicytypesPolicyTypeId(ctx echo.Context, policyTypeId a1pv400.PolicyTypeId) error {
+	return w.a1pWraper.GetPolicytypesPolicyTypeId(ctx, a1p.PolicyTypeId(policyTypeId))
+}
+
+// (GET /policytypes/{policyTypeId}/policies)
+func (w *a1pV400Wraper) GetPolicytypesPolicyTypeIdPolicies(ctx echo.Context, policyTypeId a1pv400.PolicyTypeId) error {
+	return w.a1pWraper.GetPolicytypesPolicyTypeIdPolicies(ctx, a1p.PolicyTypeId(policyTypeId))
+}
+
+// (DELETE /policytypes/{policyTypeId}/policies/{policyId})
+func (w *a1pV400Wraper) DeletePolicytypesPolicyTypeIdPoliciesPolicyId(ctx echo.Context, policyTypeId a1pv400.PolicyTypeId, policyId a1pv400.PolicyId) error {
+	return w.a1pWraper.DeletePolicytypesPolicyTypeIdPoliciesPolicyId(ctx, a1p.PolicyTypeId(policyTypeId), a1p.PolicyId(policyId))
+}
+
+// (GET /policytypes/{policyTypeId}/policies/{policyId})
+func (w *a1pV400Wraper) GetPolicytypesPolicyTypeIdPoliciesPolicyId(ctx echo.Context, policyTypeId a1pv400.PolicyTypeId, policyId a1pv400.PolicyId) error {
+	return w.a1pWraper.GetPolicytypesPolicyTypeIdPoliciesPolicyId(ctx, a1p.PolicyTypeId(policyTypeId), a1p.PolicyId(policyId))
+}
+
+// (PUT /policytypes/{policyTypeId}/policies/{policyId})
+func (w *a1pV400Wraper) PutPolicytypesPolicyTypeIdPoliciesPolicyId(ctx echo.Context, policyTypeId a1pv400.PolicyTypeId, policyId a1pv400.PolicyId, params a1pv400.PutPolicytypesPolicyTypeIdPoliciesPolicyIdParams) error {
+	return w.a1pWraper.PutPolicytypesPolicyTypeIdPoliciesPolicyId(ctx, a1p.PolicyTypeId(policyTypeId), a1p.PolicyId(policyId), a1p.PutPolicytypesPolicyTypeIdPoliciesPolicyIdParams{
+		NotificationDestination: (*a1p.NotificationDestination)(params.NotificationDestination),
+	})
+}
+
+// (GET /policytypes/{policyTypeId}/policies/{policyId}/status)
+func (w *a1pV400Wraper) GetPolicytypesPolicyTypeIdPoliciesPolicyIdStatus(ctx echo.Context, policyTypeId a1pv400.PolicyTypeId, policyId a1pv400.PolicyId) error {
+	return w.a1pWraper.GetPolicytypesPolicyTypeIdPoliciesPolicyIdStatus(ctx, a1p.PolicyTypeId(policyTypeId), a1p.PolicyId(policyId))
+}
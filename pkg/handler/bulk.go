@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+type BulkPolicyOperation struct {
+	// Action is one of put, create, update or delete; put creates or updates the policy
+	Action                  string                 `json:"action"`
+	PolicyTypeId            string                 `json:"policyTypeId"`
+	PolicyId                string                 `json:"policyId"`
+	NotificationDestination string                 `json:"notificationDestination,omitempty"`
+	PolicyObject            map[string]interface{} `json:"policyObject,omitempty"`
+}
+
+type BulkPolicyRequest struct {
+	// Atomic compensates the applied operations if any operation fails
+	Atomic     bool                  `json:"atomic,omitempty"`
+	Operations []BulkPolicyOperation `json:"operations"`
+}
+
+type BulkPolicyResult struct {
+	Index        int    `json:"index"`
+	PolicyTypeId string `json:"policyTypeId"`
+	PolicyId     string `json:"policyId"`
+	Action       string `json:"action"`
+	Applied      bool   `json:"applied"`
+	Compensated  bool   `json:"compensated,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+type BulkPolicyResponse struct {
+	Error   string             `json:"error,omitempty"`
+	Results []BulkPolicyResult `json:"results"`
+}
+
+// (POST /policies/bulk)
+func (a1pw *a1pWraper) PostPoliciesBulk(ctx echo.Context) error {
+	request := &BulkPolicyRequest{}
+	if err := ctx.Bind(request); err != nil {
+		log.Error(err)
+		return errorResponse(ctx, http.StatusBadRequest, err)
+	}
+
+	operations := make([]controller.BulkOperation, 0, len(request.Operations))
+	for i, op := range request.Operations {
+		action, err := controller.ParseBulkAction(op.Action)
+		if err != nil {
+			return errorResponse(ctx, http.StatusBadRequest, errors.NewInvalid("operation %d: %v", i, err))
+		}
+		params := make(map[string]string)
+		if op.NotificationDestination != "" {
+			params[utils.NotificationDestination] = op.NotificationDestination
+		}
+		var policyObject map[string]interface{}
+		if op.PolicyObject != nil {
+			policyObject = utils.GetPolicyObject(op.PolicyObject)
+		}
+		operations = append(operations, controller.BulkOperation{
+			Action:       action,
+			PolicyTypeID: op.PolicyTypeId,
+			PolicyID:     op.PolicyId,
+			Params:       params,
+			PolicyObject: policyObject,
+		})
+	}
+
+	results, err := a1pw.a1pController.HandlePolicyBulk(getRequestContext(ctx), operations, request.Atomic)
+	resp := BulkPolicyResponse{
+		Results: make([]BulkPolicyResult, 0, len(results)),
+	}
+	for _, r := range results {
+		result := BulkPolicyResult{
+			Index:        r.Index,
+			PolicyTypeId: r.PolicyTypeID,
+			PolicyId:     r.PolicyID,
+			Action:       r.Action.String(),
+			Applied:      r.Applied,
+			Compensated:  r.Compensated,
+		}
+		if r.Err != nil {
+			result.Error = r.Err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	status := http.StatusOK
+	if err != nil {
+		log.Error(err)
+		resp.Error = err.Error()
+		status = http.StatusMultiStatus
+		if errors.IsInvalid(err) {
+			status = http.StatusBadRequest
+		}
+	}
+	return ctx.JSONPretty(status, resp, "  ")
+}
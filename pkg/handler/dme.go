@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/dme"
+	a1dme "github.com/onosproject/onos-a1t/pkg/northbound/a1ap/v400/data_management"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+type dmeWraper struct {
+	a1eiController controller.A1EIController
+}
+
+// registerDMEHandlers adds the A1-DME routes: the consumer API, served by the Non-RT RIC through A1T, and the
+// callbacks of the Non-RT RIC for the jobs set up by A1T
+func registerDMEHandlers(e *echo.Echo, baseURL string, a1eiController controller.A1EIController) {
+	wraper := &dmeWraper{
+		a1eiController: a1eiController,
+	}
+	a1dme.RegisterHandlersWithBaseURL(e, wraper, baseURL+dme.BasePath)
+}
+
+// (GET /A1-DME/v1/info-types)
+func (w *dmeWraper) GetInfoTypes(ctx echo.Context) error {
+	infoTypeIDs, err := w.a1eiController.HandleGetInfoTypes(ctx.Request().Context())
+	if err != nil {
+		log.Error(err)
+		return errorResponse(ctx, dmeErrorStatus(err), err)
+	}
+	return ctx.JSONPretty(http.StatusOK, infoTypeIDs, "  ")
+}
+
+// (GET /A1-DME/v1/info-types/{infoTypeId})
+func (w *dmeWraper) GetInfoTypesInfoTypeId(ctx echo.Context, infoTypeId a1dme.InfoTypeId) error {
+	infoType, err := w.a1eiController.HandleGetInfoType(ctx.Request().Context(), string(infoTypeId))
+	if err != nil {
+		log.Error(err)
+		return errorResponse(ctx, dmeErrorStatus(err), err)
+	}
+	return ctx.JSONPretty(http.StatusOK, a1dme.InfoTypeObject(infoType), "  ")
+}
+
+// (GET /A1-DME/v1/info-jobs)
+func (w *dmeWraper) GetInfoJobs(ctx echo.Context, params a1dme.GetInfoJobsParams) error {
+	var infoTypeID, owner string
+	if params.InfoTypeId != nil {
+		infoTypeID = string(*params.InfoTypeId)
+	}
+	if params.Owner != nil {
+		owner = *params.Owner
+	}
+	infoJobIDs := w.a1eiController.HandleGetInfoJobs(ctx.Request().Context(), infoTypeID, owner)
+	return ctx.JSONPretty(http.StatusOK, infoJobIDs, "  ")
+}
+
+// (GET /A1-DME/v1/info-jobs/{infoJobId})
+func (w *dmeWraper) GetInfoJobsInfoJobId(ctx echo.Context, infoJobId a1dme.InfoJobId) error {
+	job, err := w.a1eiController.HandleGetInfoJob(ctx.Request().Context(), string(infoJobId))
+	if err != nil {
+		log.Error(err)
+		return errorResponse(ctx, dmeErrorStatus(err), err)
+	}
+	return ctx.JSONPretty(http.StatusOK, infoJobObjectOut(job), "  ")
+}
+
+// (PUT /A1-DME/v1/info-jobs/{infoJobId})
+func (w *dmeWraper) PutInfoJobsInfoJobId(ctx echo.Context, infoJobId a1dme.InfoJobId) error {
+	body := a1dme.InfoJobObject{}
+	if err := ctx.Bind(&body); err != nil {
+		log.Error(err)
+		return errorResponse(ctx, http.StatusBadRequest, err)
+	}
+	job := infoJobObjectIn(body)
+	created, err := w.a1eiController.HandleInfoJobPut(ctx.Request().Context(), string(infoJobId), job)
+	if err != nil {
+		log.Error(err)
+		return errorResponse(ctx, dmeErrorStatus(err), err)
+	}
+	if created {
+		return ctx.JSONPretty(http.StatusCreated, infoJobObjectOut(job), "  ")
+	}
+	return ctx.JSONPretty(http.StatusOK, infoJobObjectOut(job), "  ")
+}
+
+// (DELETE /A1-DME/v1/info-jobs/{infoJobId})
+func (w *dmeWraper) DeleteInfoJobsInfoJobId(ctx echo.Context, infoJobId a1dme.InfoJobId) error {
+	if err := w.a1eiController.HandleInfoJobDelete(ctx.Request().Context(), string(infoJobId)); err != nil {
+		log.Error(err)
+		return errorResponse(ctx, dmeErrorStatus(err), err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// (GET /A1-DME/v1/info-jobs/{infoJobId}/status)
+func (w *dmeWraper) GetInfoJobsInfoJobIdStatus(ctx echo.Context, infoJobId a1dme.InfoJobId) error {
+	status, err := w.a1eiController.HandleGetInfoJobStatus(ctx.Request().Context(), string(infoJobId))
+	if err != nil {
+		log.Error(err)
+		return errorResponse(ctx, dmeErrorStatus(err), err)
+	}
+	return ctx.JSONPretty(http.StatusOK, a1dme.InfoJobStatusObject{InfoJobStatus: a1dme.InfoJobStatus(status.InfoJobStatus)}, "  ")
+}
+
+// (POST /A1-DME/v1/info-jobs/{infoJobId}/results) - the data delivered by the producers of the Non-RT RIC
+func (w *dmeWraper) PostInfoJobsInfoJobIdResults(ctx echo.Context, infoJobId a1dme.InfoJobId) error {
+	result, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		log.Error(err)
+		return errorResponse(ctx, http.StatusBadRequest, err)
+	}
+	if err := w.a1eiController.HandleInfoJobResult(ctx.Request().Context(), string(infoJobId), result); err != nil {
+		log.Error(err)
+		return errorResponse(ctx, dmeErrorStatus(err), err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// (POST /A1-DME/v1/info-jobs/{infoJobId}/status-notifications)
+func (w *dmeWraper) PostInfoJobsInfoJobIdStatusNotifications(ctx echo.Context, infoJobId a1dme.InfoJobId) error {
+	body := a1dme.InfoJobStatusObject{}
+	if err := ctx.Bind(&body); err != nil {
+		log.Error(err)
+		return errorResponse(ctx, http.StatusBadRequest, err)
+	}
+	switch body.InfoJobStatus {
+	case a1dme.InfoJobStatusENABLED, a1dme.InfoJobStatusDISABLED:
+	default:
+		err := errors.NewInvalid("invalid infoJobStatus %q", body.InfoJobStatus)
+		log.Error(err)
+		return errorResponse(ctx, http.StatusBadRequest, err)
+	}
+	status := &dme.InfoJobStatusObject{InfoJobStatus: string(body.InfoJobStatus)}
+	if err := w.a1eiController.HandleInfoJobStatusNotify(ctx.Request().Context(), string(infoJobId), status); err != nil {
+		log.Error(err)
+		return errorResponse(ctx, dmeErrorStatus(err), err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// infoJobObjectIn converts an information job of a request to the representation of the controller
+func infoJobObjectIn(body a1dme.InfoJobObject) *dme.InfoJobObject {
+	job := &dme.InfoJobObject{
+		InfoTypeID:    string(body.InfoTypeId),
+		JobDefinition: body.JobDefinition,
+		JobResultURI:  string(body.JobResultUri),
+	}
+	if body.JobOwner != nil {
+		job.JobOwner = *body.JobOwner
+	}
+	if body.JobStatusNotificationUri != nil {
+		job.JobStatusNotificationURI = string(*body.JobStatusNotificationUri)
+	}
+	return job
+}
+
+// infoJobObjectOut converts an information job of the controller to the representation of a response
+func infoJobObjectOut(job *dme.InfoJobObject) a1dme.InfoJobObject {
+	body := a1dme.InfoJobObject{
+		InfoTypeId:    a1dme.InfoTypeId(job.InfoTypeID),
+		JobDefinition: job.JobDefinition,
+		JobResultUri:  a1dme.CallbackUri(job.JobResultURI),
+	}
+	if job.JobOwner != "" {
+		body.JobOwner = &job.JobOwner
+	}
+	if job.JobStatusNotificationURI != "" {
+		uri := a1dme.CallbackUri(job.JobStatusNotificationURI)
+		body.JobStatusNotificationUri = &uri
+	}
+	return body
+}
+
+// dmeErrorStatus returns the status of the A1-DME error responses the errors of errorResponse do not cover
+func dmeErrorStatus(err error) int {
+	switch {
+	case errors.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.IsInvalid(err):
+		return http.StatusBadRequest
+	case errors.IsUnavailable(err), errors.IsTimeout(err):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadGateway
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+)
+
+// registerEITypeHandlers adds the routes of the EI type catalogue, through which the Non-RT RIC learns the EI types
+// the xApps consume
+func registerEITypeHandlers(e *echo.Echo, a1eiController controller.A1EIController) {
+	e.GET("/eitypes", func(ctx echo.Context) error {
+		return ctx.JSONPretty(http.StatusOK, a1eiController.HandleGetEITypeCatalogue(ctx.Request().Context()), "  ")
+	})
+	e.GET("/eitypes/:eiTypeId", func(ctx echo.Context) error {
+		info, err := a1eiController.HandleGetEITypeInfo(ctx.Request().Context(), ctx.Param("eiTypeId"))
+		if err != nil {
+			log.Error(err)
+			return errorResponse(ctx, dmeErrorStatus(err), err)
+		}
+		return ctx.JSONPretty(http.StatusOK, info, "  ")
+	})
+}
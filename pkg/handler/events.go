@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+)
+
+const (
+	LastEventIDHeader = "Last-Event-ID"
+
+	// statusEventKeepAlive is how often a comment is sent on an idle status stream to keep proxies from closing it
+	statusEventKeepAlive = 15 * time.Second
+)
+
+// registerStatusEventHandlers adds the Server-Sent Events endpoints streaming the policy status notifications,
+// for the clients that cannot be reached through a notificationDestination
+func (a1pw *a1pWraper) registerStatusEventHandlers(e *echo.Echo) {
+	e.GET(a1pw.version.BaseURL+"/policytypes/:policyTypeId/status/events", a1pw.GetPolicytypesPolicyTypeIdStatusEvents)
+	e.GET(a1pw.version.BaseURL+"/policytypes/:policyTypeId/policies/:policyId/status/events", a1pw.GetPolicytypesPolicyTypeIdPoliciesPolicyIdStatusEvents)
+}
+
+// (GET /policytypes/{policyTypeId}/status/events)
+func (a1pw *a1pWraper) GetPolicytypesPolicyTypeIdStatusEvents(ctx echo.Context) error {
+	return a1pw.streamStatusEvents(ctx, controller.PolicyStatusFilter{
+		PolicyTypeID: ctx.Param("policyTypeId"),
+	})
+}
+
+// (GET /policytypes/{policyTypeId}/policies/{policyId}/status/events)
+func (a1pw *a1pWraper) GetPolicytypesPolicyTypeIdPoliciesPolicyIdStatusEvents(ctx echo.Context) error {
+	return a1pw.streamStatusEvents(ctx, controller.PolicyStatusFilter{
+		PolicyTypeID: ctx.Param("policyTypeId"),
+		PolicyID:     ctx.Param("policyId"),
+	})
+}
+
+// streamStatusEvents sends each status as an event whose data is the payload A1T would POST to the notificationDestination,
+// whose type is the policy ID and whose ID can be given back in Last-Event-ID to resume the stream
+func (a1pw *a1pWraper) streamStatusEvents(ctx echo.Context, filter controller.PolicyStatusFilter) error {
+	options := controller.PolicyStatusWatchOptions{
+		Filter: filter,
+	}
+	if lastEventID := ctx.Request().Header.Get(LastEventIDHeader); lastEventID != "" {
+		resumeAfter, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return errorResponse(ctx, http.StatusBadRequest, fmt.Errorf("invalid %v %v", LastEventIDHeader, lastEventID))
+		}
+		options.ResumeAfter = resumeAfter
+	}
+
+	// the tenant of the caller only receives the statuses of its policies
+	reqCtx := getRequestContext(ctx)
+	ch := make(chan controller.PolicyStatusEvent)
+	err := a1pw.a1pController.WatchPolicyStatus(reqCtx, options, ch)
+	if err != nil {
+		log.Error(err)
+		return errorResponse(ctx, http.StatusInternalServerError, err)
+	}
+
+	resp := ctx.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.Header().Set("Connection", "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	resp.Flush()
+
+	keepAlive := time.NewTicker(statusEventKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if _, err := resp.Write(formatStatusEvent(event)); err != nil {
+				log.Warn(err)
+				return nil
+			}
+		case <-keepAlive.C:
+			if _, err := resp.Write([]byte(":\n\n")); err != nil {
+				return nil
+			}
+		}
+		resp.Flush()
+	}
+}
+
+func formatStatusEvent(event controller.PolicyStatusEvent) []byte {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "id: %d\n", event.ID)
+	fmt.Fprintf(&sb, "event: %s\n", event.PolicyID)
+	for _, line := range strings.Split(string(event.Payload), "\n") {
+		fmt.Fprintf(&sb, "data: %s\n", strings.TrimSuffix(line, "\r"))
+	}
+	sb.WriteString("\n")
+	return []byte(sb.String())
+}
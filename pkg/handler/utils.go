@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/southbound"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+const (
+	RequestIDHeader  = "X-Request-ID"
+	RetryAfterHeader = "Retry-After"
+	// PolicyConflictHeader lists the existing policies a written policy overlaps with, one header per policy
+	PolicyConflictHeader = "X-Policy-Conflict"
+	// XAppPolicyStatusHeader is the status of a policy in one of the xApps its aggregated status is computed from,
+	// one header per xApp; the A1-P policy status schema does not allow extension fields in the body
+	XAppPolicyStatusHeader = "X-XApp-Policy-Status"
+	ETagHeader             = "ETag"
+	IfMatchHeader          = "If-Match"
+	IfNoneMatchHeader      = "If-None-Match"
+)
+
+// getCaller returns the identity of the REST caller: the client certificate subject if mTLS is used, otherwise the
+// client address, which the REST server only takes from X-Forwarded-For for trusted proxies
+func getCaller(ctx echo.Context) string {
+	if tenant := getTenant(ctx); tenant != "" {
+		return tenant
+	}
+	return ctx.RealIP()
+}
+
+// getRequestID returns the request ID given by the caller or a new one, and echoes it in the response
+func getRequestID(ctx echo.Context) string {
+	requestID := ctx.Request().Header.Get(RequestIDHeader)
+	if requestID == "" {
+		requestID = uuid.New().String()
+		ctx.Request().Header.Set(RequestIDHeader, requestID)
+	}
+	ctx.Response().Header().Set(RequestIDHeader, requestID)
+	return requestID
+}
+
+// getTenant returns the tenant of the REST caller, i.e. the client certificate subject; callers that are not
+// authenticated with mTLS have no tenant and are rejected by the controller if tenants are required
+func getTenant(ctx echo.Context) string {
+	if tlsState := ctx.Request().TLS; tlsState != nil && len(tlsState.PeerCertificates) > 0 {
+		return tlsState.PeerCertificates[0].Subject.CommonName
+	}
+	return ""
+}
+
+// getRequestContext returns the request context carrying the caller identity and request ID for auditing, and the
+// tenant owning the policies written by the request
+func getRequestContext(ctx echo.Context) context.Context {
+	reqCtx := audit.WithCaller(ctx.Request().Context(), getCaller(ctx), getRequestID(ctx))
+	return controller.WithOwner(reqCtx, getTenant(ctx))
+}
+
+// getPrecondition returns the entity tags of the If-Match and If-None-Match headers
+func getPrecondition(ctx echo.Context) controller.Precondition {
+	return controller.Precondition{
+		IfMatch:     parseETags(ctx.Request().Header.Values(IfMatchHeader)),
+		IfNoneMatch: parseETags(ctx.Request().Header.Values(IfNoneMatchHeader)),
+	}
+}
+
+func parseETags(values []string) []string {
+	etags := make([]string, 0)
+	for _, value := range values {
+		for _, etag := range strings.Split(value, ",") {
+			if etag = strings.TrimSpace(etag); etag != "" {
+				etags = append(etags, etag)
+			}
+		}
+	}
+	return etags
+}
+
+// errorResponse answers with the error and the given status, or with 503 and Retry-After if the request expired
+// in the southbound queue of an overloaded xApp or was rejected by an open circuit, with 403 if the policy is owned
+// by someone else, with 409 if the policy was rejected by a conflict rule, or with 412 if the precondition of the
+// request failed
+func errorResponse(ctx echo.Context, status int, err error) error {
+	if overloadErr, ok := southbound.IsOverloaded(err); ok {
+		setRetryAfter(ctx, overloadErr.RetryAfter)
+		status = http.StatusServiceUnavailable
+	} else if circuitErr, ok := err.(*controller.CircuitOpenError); ok {
+		setRetryAfter(ctx, time.Until(circuitErr.RetryAt))
+		status = http.StatusServiceUnavailable
+	} else if errors.IsForbidden(err) {
+		status = http.StatusForbidden
+	} else if errors.IsConflict(err) {
+		status = http.StatusConflict
+	} else if controller.IsPreconditionFailed(err) {
+		status = http.StatusPreconditionFailed
+	}
+	return ctx.JSONPretty(status, err.Error(), "  ")
+}
+
+func setRetryAfter(ctx echo.Context, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	ctx.Response().Header().Set(RetryAfterHeader, strconv.Itoa(seconds))
+}
+
+// setConflictHeaders reports the conflicts the policy was written with despite the overlap
+func setConflictHeaders(ctx echo.Context, report *controller.ConflictReport) {
+	for _, c := range report.Conflicts() {
+		ctx.Response().Header().Add(PolicyConflictHeader, c.String())
+	}
+}
+
+// setXAppPolicyStatusHeaders reports the status of the policy in each xApp behind the aggregated status
+func setXAppPolicyStatusHeaders(ctx echo.Context, status *controller.AggregatedPolicyStatus) {
+	for _, s := range status.XApps {
+		ctx.Response().Header().Add(XAppPolicyStatusHeader, s.String())
+	}
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"sort"
+
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	a1p "github.com/onosproject/onos-a1t/pkg/northbound/a1ap/policy_management"
+	a1pv400 "github.com/onosproject/onos-a1t/pkg/northbound/a1ap/v400/policy_management"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// DefaultAPIVersion is the A1AP spec version served when none is configured
+const DefaultAPIVersion = "v301"
+
+// Translator converts the bodies of an A1AP spec version from and to the representation of the controllers
+type Translator interface {
+	// PolicyObjectIn converts the policy object of a request
+	PolicyObjectIn(policyObject map[string]interface{}) map[string]interface{}
+	// PolicyObjectOut converts the policy object of a response
+	PolicyObjectOut(policyObject map[string]interface{}) map[string]interface{}
+	// PolicyStatusOut converts the policy status of a response; it fails if the status has no representation in the version
+	PolicyStatusOut(status map[string]interface{}) (interface{}, error)
+}
+
+// APIVersion is an A1AP spec version of the REST surface; the enabled versions are served side by side from the
+// same controllers
+type APIVersion struct {
+	// Name is the A1AP spec version, e.g. v301 for A1AP v03.01
+	Name string
+	// BaseURL is prepended to the paths of the version; the enabled versions must have distinct base URLs
+	BaseURL string
+	// A1EIVersion is the version in the A1-EI paths, e.g. v1 in /A1-EI/v1; empty if the version has no A1-EI
+	A1EIVersion string
+	// Translator is nil if the version has no A1-P
+	Translator Translator
+	// register adds the routes of the version
+	register func(e *echo.Echo, version *APIVersion, a1pController controller.A1PController, a1eiController controller.A1EIController)
+}
+
+var apiVersions = map[string]*APIVersion{
+	"v301": {
+		Name:        "v301",
+		A1EIVersion: "v1",
+		Translator:  &v301Translator{},
+		register: func(e *echo.Echo, version *APIVersion, a1pController controller.A1PController, a1eiController controller.A1EIController) {
+			wraper := newA1PWraper(version, a1pController)
+			a1p.RegisterHandlersWithBaseURL(e, wraper, version.BaseURL)
+			wraper.registerStatusEventHandlers(e)
+			SetRESTA1EIWraper(e, version.A1EIVersion, a1eiController)
+		},
+	},
+	// A1AP v04.00 types the policy status and replaces A1-EI with A1-DME
+	"v400": {
+		Name:       "v400",
+		BaseURL:    "/v400",
+		Translator: &v400Translator{},
+		register: func(e *echo.Echo, version *APIVersion, a1pController controller.A1PController, a1eiController controller.A1EIController) {
+			wraper := newA1PWraper(version, a1pController)
+			a1pv400.RegisterHandlersWithBaseURL(e, &a1pV400Wraper{a1pWraper: wraper}, version.BaseURL)
+			wraper.registerStatusEventHandlers(e)
+			registerDMEHandlers(e, version.BaseURL, a1eiController)
+		},
+	},
+}
+
+// APIVersions returns the names of the supported A1AP spec versions
+func APIVersions() []string {
+	names := make([]string, 0, len(apiVersions))
+	for name := range apiVersions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetRESTHandlers registers the routes of the given A1AP spec versions and the A1T extensions shared by all versions
+func SetRESTHandlers(e *echo.Echo, versions []string, a1pController controller.A1PController, a1eiController controller.A1EIController) error {
+	if len(versions) == 0 {
+		versions = []string{DefaultAPIVersion}
+	}
+	baseURLs := make(map[string]string)
+	for _, name := range versions {
+		version, ok := apiVersions[name]
+		if !ok {
+			return errors.NewInvalid("unsupported A1AP version %v - supported versions are %v", name, APIVersions())
+		}
+		if other, ok := baseURLs[version.BaseURL]; ok {
+			return errors.NewInvalid("A1AP versions %v and %v are both served at %q", other, name, version.BaseURL)
+		}
+		baseURLs[version.BaseURL] = name
+		log.Infof("Serving A1AP %v at %q", name, version.BaseURL)
+		version.register(e, version, a1pController, a1eiController)
+	}
+
+	wraper := newA1PWraper(apiVersions[DefaultAPIVersion], a1pController)
+	e.POST("/policies/bulk", wraper.PostPoliciesBulk)
+	registerEITypeHandlers(e, a1eiController)
+	return nil
+}
+
+// v301Translator is the translator of A1AP v03.01, whose bodies are the ones of the controllers
+type v301Translator struct{}
+
+func (t *v301Translator) PolicyObjectIn(policyObject map[string]interface{}) map[string]interface{} {
+	return utils.GetPolicyObject(policyObject)
+}
+
+func (t *v301Translator) PolicyObjectOut(policyObject map[string]interface{}) map[string]interface{} {
+	return policyObject
+}
+
+func (t *v301Translator) PolicyStatusOut(status map[string]interface{}) (interface{}, error) {
+	return status, nil
+}
+
+// v400Translator is the translator of A1AP v04.00, whose policy status is an enforceStatus with an optional
+// enforceReason rather than a free-form object
+type v400Translator struct{}
+
+func (t *v400Translator) PolicyObjectIn(policyObject map[string]interface{}) map[string]interface{} {
+	return utils.GetPolicyObject(policyObject)
+}
+
+func (t *v400Translator) PolicyObjectOut(policyObject map[string]interface{}) map[string]interface{} {
+	return policyObject
+}
+
+func (t *v400Translator) PolicyStatusOut(status map[string]interface{}) (interface{}, error) {
+	enforceStatus, _ := status["enforceStatus"].(string)
+	switch a1pv400.EnforceStatus(enforceStatus) {
+	case a1pv400.EnforceStatusENFORCED, a1pv400.EnforceStatusNOTENFORCED:
+	default:
+		return nil, errors.NewInvalid("policy status %v has no A1AP v04.00 enforceStatus", status)
+	}
+	statusObject := &a1pv400.PolicyStatusObject{
+		EnforceStatus: a1pv400.EnforceStatus(enforceStatus),
+	}
+	if value, ok := status["enforceReason"]; ok && value != nil {
+		enforceReason, _ := value.(string)
+		switch a1pv400.EnforceReason(enforceReason) {
+		case a1pv400.EnforceReasonSCOPENOTAPPLICABLE, a1pv400.EnforceReasonSTATEMENTNOTAPPLICABLE, a1pv400.EnforceReasonOTHERREASON:
+		default:
+			return nil, errors.NewInvalid("policy status %v has no A1AP v04.00 enforceReason", status)
+		}
+		reason := a1pv400.EnforceReason(enforceReason)
+		statusObject.EnforceReason = &reason
+	}
+	return statusObject, nil
+}
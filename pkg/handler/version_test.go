@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/dme"
+	a1pv400 "github.com/onosproject/onos-a1t/pkg/northbound/a1ap/v400/policy_management"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeA1EIController keeps the information jobs put through A1-DME
+type fakeA1EIController struct {
+	controller.A1EIController
+	jobs map[string]*dme.InfoJobObject
+}
+
+func (c *fakeA1EIController) HandleInfoJobPut(ctx context.Context, infoJobID string, job *dme.InfoJobObject) (bool, error) {
+	_, ok := c.jobs[infoJobID]
+	c.jobs[infoJobID] = job
+	return !ok, nil
+}
+
+func (c *fakeA1EIController) HandleGetInfoJob(ctx context.Context, infoJobID string) (*dme.InfoJobObject, error) {
+	job, ok := c.jobs[infoJobID]
+	if !ok {
+		return nil, errors.NewNotFound("information job %v not found", infoJobID)
+	}
+	return job, nil
+}
+
+func TestSetRESTHandlers(t *testing.T) {
+	e := echo.New()
+	assert.NoError(t, SetRESTHandlers(e, []string{"v301", "v400"}, nil, &fakeA1EIController{}))
+	routes := make(map[string]bool)
+	for _, route := range e.Routes() {
+		routes[route.Method+" "+route.Path] = true
+	}
+	for _, route := range []string{
+		"GET /policytypes/:policyTypeId/policies/:policyId/status",
+		"GET /v400/policytypes/:policyTypeId/policies/:policyId/status",
+		"GET /v400/policytypes/:policyTypeId/status/events",
+		"PUT /v400/A1-DME/v1/info-jobs/:infoJobId",
+		"POST /v400/A1-DME/v1/info-jobs/:infoJobId/results",
+	} {
+		assert.True(t, routes[route], route)
+	}
+	assert.False(t, routes["PUT /A1-DME/v1/info-jobs/:infoJobId"])
+
+	err := SetRESTHandlers(echo.New(), []string{"v200"}, nil, nil)
+	assert.True(t, errors.IsInvalid(err))
+}
+
+func TestV400PolicyStatusOut(t *testing.T) {
+	translator := &v400Translator{}
+	status, err := translator.PolicyStatusOut(map[string]interface{}{"enforceStatus": "ENFORCED"})
+	assert.NoError(t, err)
+	assert.Equal(t, &a1pv400.PolicyStatusObject{EnforceStatus: a1pv400.EnforceStatusENFORCED}, status)
+
+	status, err = translator.PolicyStatusOut(map[string]interface{}{"enforceStatus": "NOT_ENFORCED", "enforceReason": "SCOPE_NOT_APPLICABLE"})
+	assert.NoError(t, err)
+	reason := a1pv400.EnforceReasonSCOPENOTAPPLICABLE
+	assert.Equal(t, &a1pv400.PolicyStatusObject{EnforceStatus: a1pv400.EnforceStatusNOTENFORCED, EnforceReason: &reason}, status)
+
+	_, err = translator.PolicyStatusOut(map[string]interface{}{"enforced": true})
+	assert.True(t, errors.IsInvalid(err))
+	_, err = translator.PolicyStatusOut(map[string]interface{}{"enforceStatus": "NOT_ENFORCED", "enforceReason": "UNKNOWN"})
+	assert.True(t, errors.IsInvalid(err))
+}
+
+func TestV400InfoJob(t *testing.T) {
+	e := echo.New()
+	a1ei := &fakeA1EIController{jobs: make(map[string]*dme.InfoJobObject)}
+	assert.NoError(t, SetRESTHandlers(e, []string{"v400"}, nil, a1ei))
+
+	req := httptest.NewRequest(http.MethodPut, "/v400/A1-DME/v1/info-jobs/job-1", strings.NewReader(
+		`{"infoTypeId": "type-1", "jobDefinition": {"period": 10}, "jobResultUri": "http://xapp/results"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, &dme.InfoJobObject{
+		InfoTypeID:    "type-1",
+		JobDefinition: map[string]interface{}{"period": 10.0},
+		JobResultURI:  "http://xapp/results",
+	}, a1ei.jobs["job-1"])
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v400/A1-DME/v1/info-jobs/job-1", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"infoTypeId": "type-1", "jobDefinition": {"period": 10}, "jobResultUri": "http://xapp/results"}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v400/A1-DME/v1/info-jobs/job-2", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,367 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/policydiff"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+)
+
+var log = logging.GetLogger()
+
+// Caller is the identity of the inventory in the audit log
+const Caller = "policy-inventory"
+
+type Config struct {
+	// Interval is the period of the scans; 0 disables the periodic scans
+	Interval time.Duration
+	// AutoRepair reconciles the xApps which miss policies or hold stale ones after each scan
+	AutoRepair bool
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Interval: 5 * time.Minute,
+	}
+}
+
+// DivergenceKind is how the policies held by an xApp differ from the policy intents
+type DivergenceKind int
+
+const (
+	// Missing is a deployed policy intent the xApp does not hold
+	Missing DivergenceKind = iota
+	// Stale is a policy the xApp holds with another body than the intent
+	Stale
+	// Unknown is a policy the xApp holds without a policy intent, e.g. written to the xApp without A1T
+	Unknown
+	// Unreachable is a policy type whose policies could not be read from the xApp
+	Unreachable
+)
+
+func (k DivergenceKind) String() string {
+	return [...]string{"Missing", "Stale", "Unknown", "Unreachable"}[k]
+}
+
+// Divergence is a difference between an xApp and the policy intents; Repaired is set if the auto-repair fixed it
+type Divergence struct {
+	XAppID       string
+	PolicyTypeID string
+	// PolicyID is empty for Unreachable
+	PolicyID string
+	Kind     DivergenceKind
+	Reason   string
+	Repaired bool
+}
+
+// XAppPolicy is a policy held by an xApp; PolicyObject is nil if its body could not be read
+type XAppPolicy struct {
+	PolicyTypeID string
+	PolicyID     string
+	PolicyObject map[string]interface{}
+}
+
+// XAppInventory is what the last scan found in an xApp
+type XAppInventory struct {
+	XAppID    string
+	Timestamp time.Time
+	Policies  []*XAppPolicy
+	// Errors are the reasons the policies of some types could not be read, by policy type
+	Errors map[string]string
+}
+
+// Report is the result of a scan
+type Report struct {
+	Timestamp   time.Time
+	Duration    time.Duration
+	XApps       []*XAppInventory
+	Divergences []*Divergence
+}
+
+type Inventory interface {
+	// Run scans the xApps every interval until the context is done
+	Run(ctx context.Context)
+	// Scan queries the policies of every xApp and computes the divergences from the policy intents
+	Scan(ctx context.Context) *Report
+	// Report returns the report of the last scan; nil if no scan ran yet
+	Report() *Report
+}
+
+func NewInventory(config Config, policyIntentStore store.Store, a1pController controller.A1PController) Inventory {
+	return &inventory{
+		config:            config,
+		policyIntentStore: policyIntentStore,
+		a1pController:     a1pController,
+	}
+}
+
+type inventory struct {
+	config            Config
+	policyIntentStore store.Store
+	a1pController     controller.A1PController
+	report            *Report
+	scanMu            sync.Mutex
+	reportMu          sync.RWMutex
+}
+
+func (i *inventory) Run(ctx context.Context) {
+	if i.config.Interval <= 0 {
+		return
+	}
+	log.Infof("Start scanning the policies of the xApps every %v (auto-repair: %v)", i.config.Interval, i.config.AutoRepair)
+	ticker := time.NewTicker(i.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		i.Scan(ctx)
+	}
+}
+
+func (i *inventory) Report() *Report {
+	i.reportMu.RLock()
+	defer i.reportMu.RUnlock()
+	return i.report
+}
+
+func (i *inventory) Scan(ctx context.Context) *Report {
+	i.scanMu.Lock()
+	defer i.scanMu.Unlock()
+
+	ctx = controller.WithPrivileged(ctx)
+	start := time.Now()
+	xApps := make(map[string]*XAppInventory)
+	divergences := make([]*Divergence, 0)
+	policyTypeIDs := i.a1pController.HandleGetPolicyTypes(ctx)
+	sort.Strings(policyTypeIDs)
+	for _, policyTypeID := range policyTypeIDs {
+		divergences = append(divergences, i.scanPolicyType(ctx, policyTypeID, xApps)...)
+	}
+
+	if i.config.AutoRepair {
+		i.repair(ctx, divergences)
+	}
+
+	report := &Report{
+		Timestamp:   start,
+		Duration:    time.Since(start),
+		XApps:       make([]*XAppInventory, 0, len(xApps)),
+		Divergences: divergences,
+	}
+	for _, xApp := range xApps {
+		report.XApps = append(report.XApps, xApp)
+	}
+	sort.Slice(report.XApps, func(a, b int) bool {
+		return report.XApps[a].XAppID < report.XApps[b].XAppID
+	})
+	updateMetrics(report)
+	log.Infof("Policy inventory scan: %d xApps, %d divergences in %v", len(report.XApps), len(report.Divergences), report.Duration)
+
+	i.reportMu.Lock()
+	i.report = report
+	i.reportMu.Unlock()
+	return report
+}
+
+// scanPolicyType reads the policies of the type from its xApps and compares them with the policy intents
+func (i *inventory) scanPolicyType(ctx context.Context, policyTypeID string, xApps map[string]*XAppInventory) []*Divergence {
+	divergences := make([]*Divergence, 0)
+	results, err := i.a1pController.HandleQueryXApps(ctx, "", policyTypeID, a1.PayloadType_POLICY)
+	if err != nil {
+		log.Warnf("Failed to get the xApps of policy type %v: %v", policyTypeID, err)
+		return divergences
+	}
+
+	intents := i.deployedIntents(ctx, policyTypeID)
+	// the bodies are queried from all the xApps at once, hence once per policy
+	bodies := make(map[string][]*controller.XAppQueryResult)
+	drained := make(map[string]bool)
+	for _, xAppID := range i.a1pController.DrainedXApps() {
+		drained[xAppID] = true
+	}
+
+	for _, r := range results {
+		xApp := getXAppInventory(xApps, r.XAppID)
+		var policyIDs []string
+		if r.Err == nil {
+			r.Err = json.Unmarshal(r.Payload, &policyIDs)
+		}
+		if r.Err != nil {
+			xApp.Errors[policyTypeID] = r.Err.Error()
+			divergences = append(divergences, &Divergence{
+				XAppID:       r.XAppID,
+				PolicyTypeID: policyTypeID,
+				Kind:         Unreachable,
+				Reason:       r.Err.Error(),
+			})
+			continue
+		}
+
+		held := make(map[string]bool)
+		sort.Strings(policyIDs)
+		for _, policyID := range policyIDs {
+			held[policyID] = true
+			policy := &XAppPolicy{
+				PolicyTypeID: policyTypeID,
+				PolicyID:     policyID,
+			}
+			xApp.Policies = append(xApp.Policies, policy)
+			body, err := i.queryPolicyObject(ctx, bodies, r.XAppID, policyTypeID, policyID)
+			if err != nil {
+				xApp.Errors[policyTypeID] = err.Error()
+			}
+			policy.PolicyObject = body
+			intent, ok := intents[policyID]
+			if !ok {
+				divergences = append(divergences, newDivergence(r.XAppID, policyTypeID, policyID, Unknown, "no deployed policy intent"))
+				continue
+			}
+			if err != nil {
+				continue
+			}
+			diffs, err := policydiff.ForPolicyType(policyTypeID).Diff(intent.PolicyObject, body)
+			if err != nil {
+				xApp.Errors[policyTypeID] = err.Error()
+			} else if len(diffs) > 0 {
+				reason := fmt.Sprintf("the body differs from revision %d at %v", intent.Revision, strings.Join(policydiff.Paths(diffs), ", "))
+				divergences = append(divergences, newDivergence(r.XAppID, policyTypeID, policyID, Stale, reason))
+			}
+		}
+
+		// drained xApps get no new policies
+		if drained[r.XAppID] {
+			continue
+		}
+		missing := make([]string, 0)
+		for policyID := range intents {
+			if !held[policyID] {
+				missing = append(missing, policyID)
+			}
+		}
+		sort.Strings(missing)
+		for _, policyID := range missing {
+			divergences = append(divergences, newDivergence(r.XAppID, policyTypeID, policyID, Missing, "not held by the xApp"))
+		}
+	}
+	return divergences
+}
+
+// queryPolicyObject returns the body of the policy held by the xApp
+func (i *inventory) queryPolicyObject(ctx context.Context, bodies map[string][]*controller.XAppQueryResult, xAppID, policyTypeID, policyID string) (map[string]interface{}, error) {
+	results, ok := bodies[policyID]
+	if !ok {
+		var err error
+		results, err = i.a1pController.HandleQueryXApps(ctx, policyID, policyTypeID, a1.PayloadType_POLICY)
+		if err != nil {
+			return nil, err
+		}
+		bodies[policyID] = results
+	}
+	for _, r := range results {
+		if r.XAppID != xAppID {
+			continue
+		}
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		body := make(map[string]interface{})
+		if err := json.Unmarshal(r.Payload, &body); err != nil {
+			return nil, err
+		}
+		return body, nil
+	}
+	return nil, errors.NewNotFound("xApp %v does not hold policy %v of type %v", xAppID, policyID, policyTypeID)
+}
+
+// deployedIntents returns the latest revisions of the policies of the type the xApps should hold
+func (i *inventory) deployedIntents(ctx context.Context, policyTypeID string) map[string]*store.PolicyRevision {
+	undeployed := make(map[string]bool)
+	for _, s := range i.a1pController.HandleListPolicySchedules(ctx, policyTypeID) {
+		if !s.Deployed {
+			undeployed[s.PolicyID] = true
+		}
+	}
+	intents := make(map[string]*store.PolicyRevision)
+	for key, intent := range store.ListPolicyIntents(ctx, i.policyIntentStore, policyTypeID) {
+		if intent.Exists() && !undeployed[key.PolicyID] {
+			intents[key.PolicyID] = intent.Latest()
+		}
+	}
+	return intents
+}
+
+// repair reconciles the xApps with Missing or Stale policies; Unknown policies are left alone, as they may have been
+// written to the xApps on purpose
+func (i *inventory) repair(ctx context.Context, divergences []*Divergence) {
+	byXApp := make(map[string][]*Divergence)
+	for _, d := range divergences {
+		if d.Kind == Missing || d.Kind == Stale {
+			byXApp[d.XAppID] = append(byXApp[d.XAppID], d)
+		}
+	}
+	repairCtx := audit.WithCaller(ctx, Caller, uuid.New().String())
+	for xAppID, ds := range byXApp {
+		results, err := i.a1pController.HandleReconcileXApp(repairCtx, xAppID)
+		if err != nil {
+			log.Warnf("Failed to repair xApp %v: %v", xAppID, err)
+			repairs.WithLabelValues("failure").Add(float64(len(ds)))
+			continue
+		}
+		repaired := make(map[store.PolicyIntentKey]bool)
+		for _, r := range results {
+			repaired[store.PolicyIntentKey{PolicyTypeID: r.PolicyTypeID, PolicyID: r.PolicyID}] = r.Err == nil
+		}
+		for _, d := range ds {
+			d.Repaired = repaired[store.PolicyIntentKey{PolicyTypeID: d.PolicyTypeID, PolicyID: d.PolicyID}]
+			if d.Repaired {
+				repairs.WithLabelValues("success").Inc()
+			} else {
+				repairs.WithLabelValues("failure").Inc()
+			}
+		}
+		log.Infof("Repaired xApp %v", xAppID)
+	}
+}
+
+func getXAppInventory(xApps map[string]*XAppInventory, xAppID string) *XAppInventory {
+	xApp, ok := xApps[xAppID]
+	if !ok {
+		xApp = &XAppInventory{
+			XAppID:    xAppID,
+			Timestamp: time.Now(),
+			Policies:  make([]*XAppPolicy, 0),
+			Errors:    make(map[string]string),
+		}
+		xApps[xAppID] = xApp
+	}
+	return xApp
+}
+
+func newDivergence(xAppID, policyTypeID, policyID string, kind DivergenceKind, reason string) *Divergence {
+	return &Divergence{
+		XAppID:       xAppID,
+		PolicyTypeID: policyTypeID,
+		PolicyID:     policyID,
+		Kind:         kind,
+		Reason:       reason,
+	}
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeA1PController answers the queries with the policies held by each xApp, nil policies meaning an unreachable
+// xApp, and fails the reconciliation of the policies in failing
+type fakeA1PController struct {
+	controller.A1PController
+	policies   map[string]map[string]map[string]interface{}
+	drained    []string
+	schedules  []*controller.PolicyScheduleStatus
+	failing    map[string]bool
+	reconciled []string
+	mu         sync.Mutex
+}
+
+func (c *fakeA1PController) HandleGetPolicyTypes(ctx context.Context) []string {
+	return []string{"type-1"}
+}
+
+func (c *fakeA1PController) HandleQueryXApps(ctx context.Context, policyID, policyTypeID string, payloadType a1.PayloadType) ([]*controller.XAppQueryResult, error) {
+	xAppIDs := make([]string, 0, len(c.policies))
+	for xAppID := range c.policies {
+		xAppIDs = append(xAppIDs, xAppID)
+	}
+	sort.Strings(xAppIDs)
+	results := make([]*controller.XAppQueryResult, 0, len(xAppIDs))
+	for _, xAppID := range xAppIDs {
+		result := &controller.XAppQueryResult{XAppID: xAppID}
+		policies := c.policies[xAppID]
+		switch {
+		case policies == nil:
+			result.Err = errors.NewTimeout("no answer")
+		case policyID == "":
+			policyIDs := make([]string, 0, len(policies))
+			for id := range policies {
+				policyIDs = append(policyIDs, id)
+			}
+			result.Payload, _ = json.Marshal(policyIDs)
+		case policies[policyID] != nil:
+			result.Payload, _ = json.Marshal(policies[policyID])
+		default:
+			result.Err = errors.NewNotFound("policy %v not found", policyID)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (c *fakeA1PController) DrainedXApps() []string {
+	return c.drained
+}
+
+func (c *fakeA1PController) HandleListPolicySchedules(ctx context.Context, policyTypeID string) []*controller.PolicyScheduleStatus {
+	return c.schedules
+}
+
+func (c *fakeA1PController) HandleReconcileXApp(ctx context.Context, xAppID string) ([]*controller.ReconcileResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reconciled = append(c.reconciled, xAppID)
+	results := make([]*controller.ReconcileResult, 0)
+	for _, policyID := range []string{"policy-1", "policy-2"} {
+		result := &controller.ReconcileResult{PolicyTypeID: "type-1", PolicyID: policyID}
+		if c.failing[xAppID+"/"+policyID] {
+			result.Err = errors.NewUnavailable("xApp %v unavailable", xAppID)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func TestInventoryScan(t *testing.T) {
+	ctx := context.Background()
+	policyIntentStore := store.NewStore()
+	for _, intent := range []struct {
+		policyID string
+		object   map[string]interface{}
+	}{
+		{"policy-1", map[string]interface{}{"priority": 1.0}},
+		{"policy-2", map[string]interface{}{"priority": 2.0}},
+		{"scheduled", map[string]interface{}{"priority": 3.0}},
+		{"deleted", map[string]interface{}{"priority": 4.0}},
+		{"deleted", nil},
+	} {
+		_, err := store.AddPolicyRevision(ctx, policyIntentStore, "type-1", intent.policyID, nil, intent.object, intent.object == nil, "")
+		require.NoError(t, err)
+	}
+	a1pController := &fakeA1PController{
+		policies: map[string]map[string]map[string]interface{}{
+			"xapp-1": {
+				"policy-1": {"priority": 1.0},
+				"policy-2": {"priority": 9.0},
+				"manual":   {"priority": 5.0},
+			},
+			"xapp-2": {"policy-1": {"priority": 1.0}},
+			"xapp-3": nil,
+			"xapp-4": {},
+		},
+		drained:   []string{"xapp-4"},
+		schedules: []*controller.PolicyScheduleStatus{{PolicyTypeID: "type-1", PolicyID: "scheduled"}},
+		failing:   map[string]bool{"xapp-2/policy-2": true},
+	}
+	inv := NewInventory(Config{AutoRepair: true}, policyIntentStore, a1pController)
+	assert.Nil(t, inv.Report())
+
+	// undeployed and deleted intents are not expected in the xApps, nor are the new policies in the drained xApps
+	report := inv.Scan(ctx)
+	assert.Equal(t, report, inv.Report())
+	divergences := make([]Divergence, 0)
+	for _, d := range report.Divergences {
+		d.Reason = ""
+		divergences = append(divergences, *d)
+	}
+	assert.Equal(t, []Divergence{
+		{XAppID: "xapp-1", PolicyTypeID: "type-1", PolicyID: "manual", Kind: Unknown},
+		{XAppID: "xapp-1", PolicyTypeID: "type-1", PolicyID: "policy-2", Kind: Stale, Repaired: true},
+		{XAppID: "xapp-2", PolicyTypeID: "type-1", PolicyID: "policy-2", Kind: Missing},
+		{XAppID: "xapp-3", PolicyTypeID: "type-1", Kind: Unreachable},
+	}, divergences)
+	sort.Strings(a1pController.reconciled)
+	assert.Equal(t, []string{"xapp-1", "xapp-2"}, a1pController.reconciled)
+
+	require.Len(t, report.XApps, 4)
+	assert.Equal(t, "xapp-1", report.XApps[0].XAppID)
+	assert.Len(t, report.XApps[0].Policies, 3)
+	assert.Contains(t, report.XApps[2].Errors["type-1"], "no answer")
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	divergences = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "a1t",
+		Subsystem: "inventory",
+		Name:      "divergences",
+		Help:      "Number of policies of an xApp which diverge from the policy intents at the last scan, by kind",
+	}, []string{"xapp_id", "kind"})
+
+	xAppPolicies = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "a1t",
+		Subsystem: "inventory",
+		Name:      "xapp_policies",
+		Help:      "Number of policies held by an xApp at the last scan",
+	}, []string{"xapp_id"})
+
+	lastScan = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "a1t",
+		Subsystem: "inventory",
+		Name:      "last_scan_timestamp_seconds",
+		Help:      "Time of the last scan of the policies of the xApps",
+	})
+
+	repairs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "a1t",
+		Subsystem: "inventory",
+		Name:      "repairs_total",
+		Help:      "Number of divergences the auto-repair tried to fix, by result",
+	}, []string{"result"})
+)
+
+func updateMetrics(report *Report) {
+	divergences.Reset()
+	xAppPolicies.Reset()
+	for _, xApp := range report.XApps {
+		xAppPolicies.WithLabelValues(xApp.XAppID).Set(float64(len(xApp.Policies)))
+		for _, kind := range []DivergenceKind{Missing, Stale, Unknown, Unreachable} {
+			divergences.WithLabelValues(xApp.XAppID, kind.String()).Set(0)
+		}
+	}
+	for _, d := range report.Divergences {
+		if !d.Repaired {
+			divergences.WithLabelValues(d.XAppID, d.Kind.String()).Inc()
+		}
+	}
+	lastScan.Set(float64(report.Timestamp.Unix()))
+}
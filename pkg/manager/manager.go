@@ -9,7 +9,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/inventory"
 	"github.com/onosproject/onos-a1t/pkg/northbound/cli"
+	"github.com/onosproject/onos-a1t/pkg/policysource"
 	"github.com/onosproject/onos-a1t/pkg/southbound"
 	"github.com/onosproject/onos-a1t/pkg/store"
 	"github.com/onosproject/onos-a1t/pkg/stream"
@@ -21,22 +24,39 @@ import (
 
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/onosproject/onos-lib-go/pkg/northbound"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 var log = logging.GetLogger()
 
 type Config struct {
-	CAPath      string
-	KeyPath     string
-	CertPath    string
-	GRPCPort    int
-	ConfigPath  string
-	BaseURL     string
-	NonRTRICURL string
+	CAPath     string
+	KeyPath    string
+	CertPath   string
+	GRPCPort   int
+	ConfigPath string
+	BaseURL    string
+	// TrustedProxies are the CIDRs of the proxies whose X-Forwarded-For header gives the address of the REST callers
+	TrustedProxies []string
+	// MetricsAddress is the address of the Prometheus metrics server; empty disables it
+	MetricsAddress string
+	// A1APVersions are the A1AP spec versions served by the REST server
+	A1APVersions     []string
+	NonRTRICURL      string
+	AuditRetention   int
+	StreamConfig     stream.BrokerConfig
+	SouthboundConfig southbound.Config
+	ControllerConfig controller.Config
+	// PolicySourceConfig enables the policy source if its directory is set
+	PolicySourceConfig policysource.Config
+	InventoryConfig    inventory.Config
+	// AdminAuthorization grants the write RPCs of the admin API; nil denies them all
+	AdminAuthorization *cli.Authorization
 }
 
 type Manager struct {
 	restServer        *nbirest.Server
+	metricsServer     *nbirest.MetricsServer
 	subManager        *subs.Manager
 	sbManager         southbound.Manager
 	broker            controller.Broker
@@ -44,23 +64,32 @@ type Manager struct {
 	config            Config
 	subscriptionStore store.Store
 	policyStore       store.Store
+	policyIntentStore store.Store
 	eijobsStore       store.Store
 	rnibClient        rnib.TopoClient
+	auditLog          audit.Log
+	policySource      policysource.Source
+	inventory         inventory.Inventory
 }
 
 func NewManager(config Config) (*Manager, error) {
 	subscriptionStore := store.NewStore()
 	policyStore := store.NewStore()
+	policyIntentStore := store.NewStore()
 	eijobsStore := store.NewStore()
+	auditLog := audit.NewLog(config.AuditRetention)
 
 	rnibClient, err := rnib.NewClient()
 	if err != nil {
 		return nil, err
 	}
 
-	streamBroker := stream.NewBroker()
+	streamBroker := stream.NewBroker(config.StreamConfig)
+	if err := prometheus.Register(stream.NewStatsCollector(streamBroker)); err != nil {
+		return nil, err
+	}
 
-	broker := controller.NewBroker(config.NonRTRICURL, subscriptionStore, eijobsStore, rnibClient, streamBroker)
+	broker := controller.NewBroker(config.NonRTRICURL, subscriptionStore, policyIntentStore, eijobsStore, rnibClient, streamBroker, auditLog, config.ControllerConfig)
 	err = broker.Run(context.Background())
 	if err != nil {
 		return nil, err
@@ -71,24 +100,41 @@ func NewManager(config Config) (*Manager, error) {
 		return nil, err
 	}
 
-	sbManager := southbound.NewSouthboundManager(streamBroker, subscriptionStore)
+	sbManager := southbound.NewSouthboundManager(streamBroker, subscriptionStore, config.SouthboundConfig)
 
-	restServer, err := nbirest.NewRestServer(config.BaseURL, broker)
+	restServer, err := nbirest.NewRestServer(config.BaseURL, config.A1APVersions, config.TrustedProxies, broker)
 	if err != nil {
 		return nil, err
 	}
 
+	var metricsServer *nbirest.MetricsServer
+	if config.MetricsAddress != "" {
+		metricsServer = nbirest.NewMetricsServer(config.MetricsAddress)
+	}
+
+	var policySource policysource.Source
+	if config.PolicySourceConfig.Dir != "" {
+		policySource = policysource.NewSource(config.PolicySourceConfig, policyIntentStore, broker.A1PController())
+	}
+
+	policyInventory := inventory.NewInventory(config.InventoryConfig, policyIntentStore, broker.A1PController())
+
 	return &Manager{
 		restServer:        restServer,
+		metricsServer:     metricsServer,
 		subManager:        subManager,
 		sbManager:         sbManager,
 		broker:            broker,
 		streamBroker:      streamBroker,
 		subscriptionStore: subscriptionStore,
 		policyStore:       policyStore,
+		policyIntentStore: policyIntentStore,
 		eijobsStore:       eijobsStore,
 		config:            config,
 		rnibClient:        rnibClient,
+		auditLog:          auditLog,
+		policySource:      policySource,
+		inventory:         policyInventory,
 	}, nil
 }
 
@@ -101,7 +147,7 @@ func (m *Manager) startNorthboundServer() error {
 		true,
 		northbound.SecurityConfig{}))
 
-	s.AddService(cli.NewService(m.subscriptionStore, m.policyStore, m.eijobsStore, m.broker, m.rnibClient))
+	s.AddService(cli.NewService(m.subscriptionStore, m.policyStore, m.eijobsStore, m.broker, m.rnibClient, m.auditLog, m.sbManager, m.policySource, m.inventory, m.config.AdminAuthorization))
 
 	doneCh := make(chan error)
 	go func() {
@@ -148,6 +194,16 @@ func (m *Manager) start() error {
 		return err
 	}
 
+	if m.policySource != nil {
+		go m.policySource.Run(context.Background())
+	}
+
+	go m.inventory.Run(context.Background())
+
+	if m.metricsServer != nil {
+		m.metricsServer.Start()
+	}
+
 	m.restServer.Start()
 
 	return nil
@@ -0,0 +1,1643 @@
+// Package A1apDataManagement provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.9.0 DO NOT EDIT.
+package A1apDataManagement
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+)
+
+// Defines values for InfoJobStatus.
+const (
+	InfoJobStatusDISABLED InfoJobStatus = "DISABLED"
+
+	InfoJobStatusENABLED InfoJobStatus = "ENABLED"
+)
+
+// A complete callback URI defined according to IETF RFC 3986
+type CallbackUri string
+
+// Information job identifier assigned by the A1-DME Consumer when a job is created
+type InfoJobId string
+
+// An information job: the data of the information type defined by the job definition is delivered to the job result URI until the job is deleted
+type InfoJobObject struct {
+	// Information type identifier assigned by the A1-DME Provider
+	InfoTypeId InfoTypeId `json:"infoTypeId"`
+
+	// The definition of an information job, valid according to the schema of its information type
+	JobDefinition JobDefinition `json:"jobDefinition"`
+	JobOwner      *string       `json:"jobOwner,omitempty"`
+
+	// A complete callback URI defined according to IETF RFC 3986
+	JobResultUri CallbackUri `json:"jobResultUri"`
+
+	// A complete callback URI defined according to IETF RFC 3986
+	JobStatusNotificationUri *CallbackUri `json:"jobStatusNotificationUri,omitempty"`
+}
+
+// The data delivered for an information job, valid according to its information type
+type InfoJobResult map[string]interface{}
+
+// ENABLED if the producers are able to deliver the data of the information job
+type InfoJobStatus string
+
+// The status of an information job
+type InfoJobStatusObject struct {
+	// ENABLED if the producers are able to deliver the data of the information job
+	InfoJobStatus InfoJobStatus `json:"infoJobStatus"`
+}
+
+// Information type identifier assigned by the A1-DME Provider
+type InfoTypeId string
+
+// A definition of an information type, e.g. the JSON schema of its job definitions
+type InfoTypeObject map[string]interface{}
+
+// The definition of an information job, valid according to the schema of its information type
+type JobDefinition map[string]interface{}
+
+// A problem detail to carry details in a HTTP response according to RFC 7807
+type ProblemDetails struct {
+	Detail   *string  `json:"detail,omitempty"`
+	Instance *string  `json:"instance,omitempty"`
+	Status   *float32 `json:"status,omitempty"`
+	Title    *string  `json:"title,omitempty"`
+	Type     *string  `json:"type,omitempty"`
+}
+
+// GetInfoJobsParams defines parameters for GetInfoJobs.
+type GetInfoJobsParams struct {
+	InfoTypeId *InfoTypeId `json:"infoTypeId,omitempty"`
+	Owner      *string     `json:"owner,omitempty"`
+}
+
+// PutInfoJobsInfoJobIdJSONBody defines parameters for PutInfoJobsInfoJobId.
+type PutInfoJobsInfoJobIdJSONBody InfoJobObject
+
+// PostInfoJobsInfoJobIdResultsJSONBody defines parameters for PostInfoJobsInfoJobIdResults.
+type PostInfoJobsInfoJobIdResultsJSONBody InfoJobResult
+
+// PostInfoJobsInfoJobIdStatusNotificationsJSONBody defines parameters for PostInfoJobsInfoJobIdStatusNotifications.
+type PostInfoJobsInfoJobIdStatusNotificationsJSONBody InfoJobStatusObject
+
+// PutInfoJobsInfoJobIdJSONRequestBody defines body for PutInfoJobsInfoJobId for application/json ContentType.
+type PutInfoJobsInfoJobIdJSONRequestBody PutInfoJobsInfoJobIdJSONBody
+
+// PostInfoJobsInfoJobIdResultsJSONRequestBody defines body for PostInfoJobsInfoJobIdResults for application/json ContentType.
+type PostInfoJobsInfoJobIdResultsJSONRequestBody PostInfoJobsInfoJobIdResultsJSONBody
+
+// PostInfoJobsInfoJobIdStatusNotificationsJSONRequestBody defines body for PostInfoJobsInfoJobIdStatusNotifications for application/json ContentType.
+type PostInfoJobsInfoJobIdStatusNotificationsJSONRequestBody PostInfoJobsInfoJobIdStatusNotificationsJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetInfoJobs request
+	GetInfoJobs(ctx context.Context, params *GetInfoJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteInfoJobsInfoJobId request
+	DeleteInfoJobsInfoJobId(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInfoJobsInfoJobId request
+	GetInfoJobsInfoJobId(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutInfoJobsInfoJobId request with any body
+	PutInfoJobsInfoJobIdWithBody(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutInfoJobsInfoJobId(ctx context.Context, infoJobId InfoJobId, body PutInfoJobsInfoJobIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostInfoJobsInfoJobIdResults request with any body
+	PostInfoJobsInfoJobIdResultsWithBody(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostInfoJobsInfoJobIdResults(ctx context.Context, infoJobId InfoJobId, body PostInfoJobsInfoJobIdResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInfoJobsInfoJobIdStatus request
+	GetInfoJobsInfoJobIdStatus(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostInfoJobsInfoJobIdStatusNotifications request with any body
+	PostInfoJobsInfoJobIdStatusNotificationsWithBody(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostInfoJobsInfoJobIdStatusNotifications(ctx context.Context, infoJobId InfoJobId, body PostInfoJobsInfoJobIdStatusNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInfoTypes request
+	GetInfoTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInfoTypesInfoTypeId request
+	GetInfoTypesInfoTypeId(ctx context.Context, infoTypeId InfoTypeId, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetInfoJobs(ctx context.Context, params *GetInfoJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInfoJobsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteInfoJobsInfoJobId(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInfoJobsInfoJobIdRequest(c.Server, infoJobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInfoJobsInfoJobId(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInfoJobsInfoJobIdRequest(c.Server, infoJobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutInfoJobsInfoJobIdWithBody(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutInfoJobsInfoJobIdRequestWithBody(c.Server, infoJobId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutInfoJobsInfoJobId(ctx context.Context, infoJobId InfoJobId, body PutInfoJobsInfoJobIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutInfoJobsInfoJobIdRequest(c.Server, infoJobId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInfoJobsInfoJobIdResultsWithBody(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInfoJobsInfoJobIdResultsRequestWithBody(c.Server, infoJobId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInfoJobsInfoJobIdResults(ctx context.Context, infoJobId InfoJobId, body PostInfoJobsInfoJobIdResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInfoJobsInfoJobIdResultsRequest(c.Server, infoJobId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInfoJobsInfoJobIdStatus(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInfoJobsInfoJobIdStatusRequest(c.Server, infoJobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInfoJobsInfoJobIdStatusNotificationsWithBody(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInfoJobsInfoJobIdStatusNotificationsRequestWithBody(c.Server, infoJobId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostInfoJobsInfoJobIdStatusNotifications(ctx context.Context, infoJobId InfoJobId, body PostInfoJobsInfoJobIdStatusNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInfoJobsInfoJobIdStatusNotificationsRequest(c.Server, infoJobId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInfoTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInfoTypesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInfoTypesInfoTypeId(ctx context.Context, infoTypeId InfoTypeId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInfoTypesInfoTypeIdRequest(c.Server, infoTypeId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetInfoJobsRequest generates requests for GetInfoJobs
+func NewGetInfoJobsRequest(server string, params *GetInfoJobsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/info-jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.InfoTypeId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "infoTypeId", runtime.ParamLocationQuery, *params.InfoTypeId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Owner != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "owner", runtime.ParamLocationQuery, *params.Owner); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteInfoJobsInfoJobIdRequest generates requests for DeleteInfoJobsInfoJobId
+func NewDeleteInfoJobsInfoJobIdRequest(server string, infoJobId InfoJobId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, infoJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/info-jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInfoJobsInfoJobIdRequest generates requests for GetInfoJobsInfoJobId
+func NewGetInfoJobsInfoJobIdRequest(server string, infoJobId InfoJobId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, infoJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/info-jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutInfoJobsInfoJobIdRequest calls the generic PutInfoJobsInfoJobId builder with application/json body
+func NewPutInfoJobsInfoJobIdRequest(server string, infoJobId InfoJobId, body PutInfoJobsInfoJobIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutInfoJobsInfoJobIdRequestWithBody(server, infoJobId, "application/json", bodyReader)
+}
+
+// NewPutInfoJobsInfoJobIdRequestWithBody generates requests for PutInfoJobsInfoJobId with any type of body
+func NewPutInfoJobsInfoJobIdRequestWithBody(server string, infoJobId InfoJobId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, infoJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/info-jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostInfoJobsInfoJobIdResultsRequest calls the generic PostInfoJobsInfoJobIdResults builder with application/json body
+func NewPostInfoJobsInfoJobIdResultsRequest(server string, infoJobId InfoJobId, body PostInfoJobsInfoJobIdResultsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostInfoJobsInfoJobIdResultsRequestWithBody(server, infoJobId, "application/json", bodyReader)
+}
+
+// NewPostInfoJobsInfoJobIdResultsRequestWithBody generates requests for PostInfoJobsInfoJobIdResults with any type of body
+func NewPostInfoJobsInfoJobIdResultsRequestWithBody(server string, infoJobId InfoJobId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, infoJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/info-jobs/%s/results", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInfoJobsInfoJobIdStatusRequest generates requests for GetInfoJobsInfoJobIdStatus
+func NewGetInfoJobsInfoJobIdStatusRequest(server string, infoJobId InfoJobId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, infoJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/info-jobs/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostInfoJobsInfoJobIdStatusNotificationsRequest calls the generic PostInfoJobsInfoJobIdStatusNotifications builder with application/json body
+func NewPostInfoJobsInfoJobIdStatusNotificationsRequest(server string, infoJobId InfoJobId, body PostInfoJobsInfoJobIdStatusNotificationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostInfoJobsInfoJobIdStatusNotificationsRequestWithBody(server, infoJobId, "application/json", bodyReader)
+}
+
+// NewPostInfoJobsInfoJobIdStatusNotificationsRequestWithBody generates requests for PostInfoJobsInfoJobIdStatusNotifications with any type of body
+func NewPostInfoJobsInfoJobIdStatusNotificationsRequestWithBody(server string, infoJobId InfoJobId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, infoJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/info-jobs/%s/status-notifications", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInfoTypesRequest generates requests for GetInfoTypes
+func NewGetInfoTypesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/info-types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInfoTypesInfoTypeIdRequest generates requests for GetInfoTypesInfoTypeId
+func NewGetInfoTypesInfoTypeIdRequest(server string, infoTypeId InfoTypeId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "infoTypeId", runtime.ParamLocationPath, infoTypeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/info-types/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetInfoJobs request
+	GetInfoJobsWithResponse(ctx context.Context, params *GetInfoJobsParams, reqEditors ...RequestEditorFn) (*GetInfoJobsResponse, error)
+
+	// DeleteInfoJobsInfoJobId request
+	DeleteInfoJobsInfoJobIdWithResponse(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*DeleteInfoJobsInfoJobIdResponse, error)
+
+	// GetInfoJobsInfoJobId request
+	GetInfoJobsInfoJobIdWithResponse(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*GetInfoJobsInfoJobIdResponse, error)
+
+	// PutInfoJobsInfoJobId request with any body
+	PutInfoJobsInfoJobIdWithBodyWithResponse(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutInfoJobsInfoJobIdResponse, error)
+
+	PutInfoJobsInfoJobIdWithResponse(ctx context.Context, infoJobId InfoJobId, body PutInfoJobsInfoJobIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutInfoJobsInfoJobIdResponse, error)
+
+	// PostInfoJobsInfoJobIdResults request with any body
+	PostInfoJobsInfoJobIdResultsWithBodyWithResponse(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInfoJobsInfoJobIdResultsResponse, error)
+
+	PostInfoJobsInfoJobIdResultsWithResponse(ctx context.Context, infoJobId InfoJobId, body PostInfoJobsInfoJobIdResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInfoJobsInfoJobIdResultsResponse, error)
+
+	// GetInfoJobsInfoJobIdStatus request
+	GetInfoJobsInfoJobIdStatusWithResponse(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*GetInfoJobsInfoJobIdStatusResponse, error)
+
+	// PostInfoJobsInfoJobIdStatusNotifications request with any body
+	PostInfoJobsInfoJobIdStatusNotificationsWithBodyWithResponse(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInfoJobsInfoJobIdStatusNotificationsResponse, error)
+
+	PostInfoJobsInfoJobIdStatusNotificationsWithResponse(ctx context.Context, infoJobId InfoJobId, body PostInfoJobsInfoJobIdStatusNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInfoJobsInfoJobIdStatusNotificationsResponse, error)
+
+	// GetInfoTypes request
+	GetInfoTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInfoTypesResponse, error)
+
+	// GetInfoTypesInfoTypeId request
+	GetInfoTypesInfoTypeIdWithResponse(ctx context.Context, infoTypeId InfoTypeId, reqEditors ...RequestEditorFn) (*GetInfoTypesInfoTypeIdResponse, error)
+}
+
+type GetInfoJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InfoJobId
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInfoJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInfoJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInfoJobsInfoJobIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInfoJobsInfoJobIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInfoJobsInfoJobIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInfoJobsInfoJobIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InfoJobObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInfoJobsInfoJobIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInfoJobsInfoJobIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutInfoJobsInfoJobIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InfoJobObject
+	JSON201      *InfoJobObject
+}
+
+// Status returns HTTPResponse.Status
+func (r PutInfoJobsInfoJobIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutInfoJobsInfoJobIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostInfoJobsInfoJobIdResultsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostInfoJobsInfoJobIdResultsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostInfoJobsInfoJobIdResultsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInfoJobsInfoJobIdStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InfoJobStatusObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInfoJobsInfoJobIdStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInfoJobsInfoJobIdStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostInfoJobsInfoJobIdStatusNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostInfoJobsInfoJobIdStatusNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostInfoJobsInfoJobIdStatusNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInfoTypesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InfoTypeId
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInfoTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInfoTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInfoTypesInfoTypeIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InfoTypeObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInfoTypesInfoTypeIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInfoTypesInfoTypeIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetInfoJobsWithResponse request returning *GetInfoJobsResponse
+func (c *ClientWithResponses) GetInfoJobsWithResponse(ctx context.Context, params *GetInfoJobsParams, reqEditors ...RequestEditorFn) (*GetInfoJobsResponse, error) {
+	rsp, err := c.GetInfoJobs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInfoJobsResponse(rsp)
+}
+
+// DeleteInfoJobsInfoJobIdWithResponse request returning *DeleteInfoJobsInfoJobIdResponse
+func (c *ClientWithResponses) DeleteInfoJobsInfoJobIdWithResponse(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*DeleteInfoJobsInfoJobIdResponse, error) {
+	rsp, err := c.DeleteInfoJobsInfoJobId(ctx, infoJobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInfoJobsInfoJobIdResponse(rsp)
+}
+
+// GetInfoJobsInfoJobIdWithResponse request returning *GetInfoJobsInfoJobIdResponse
+func (c *ClientWithResponses) GetInfoJobsInfoJobIdWithResponse(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*GetInfoJobsInfoJobIdResponse, error) {
+	rsp, err := c.GetInfoJobsInfoJobId(ctx, infoJobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInfoJobsInfoJobIdResponse(rsp)
+}
+
+// PutInfoJobsInfoJobIdWithBodyWithResponse request with arbitrary body returning *PutInfoJobsInfoJobIdResponse
+func (c *ClientWithResponses) PutInfoJobsInfoJobIdWithBodyWithResponse(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutInfoJobsInfoJobIdResponse, error) {
+	rsp, err := c.PutInfoJobsInfoJobIdWithBody(ctx, infoJobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutInfoJobsInfoJobIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutInfoJobsInfoJobIdWithResponse(ctx context.Context, infoJobId InfoJobId, body PutInfoJobsInfoJobIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutInfoJobsInfoJobIdResponse, error) {
+	rsp, err := c.PutInfoJobsInfoJobId(ctx, infoJobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutInfoJobsInfoJobIdResponse(rsp)
+}
+
+// PostInfoJobsInfoJobIdResultsWithBodyWithResponse request with arbitrary body returning *PostInfoJobsInfoJobIdResultsResponse
+func (c *ClientWithResponses) PostInfoJobsInfoJobIdResultsWithBodyWithResponse(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInfoJobsInfoJobIdResultsResponse, error) {
+	rsp, err := c.PostInfoJobsInfoJobIdResultsWithBody(ctx, infoJobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInfoJobsInfoJobIdResultsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostInfoJobsInfoJobIdResultsWithResponse(ctx context.Context, infoJobId InfoJobId, body PostInfoJobsInfoJobIdResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInfoJobsInfoJobIdResultsResponse, error) {
+	rsp, err := c.PostInfoJobsInfoJobIdResults(ctx, infoJobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInfoJobsInfoJobIdResultsResponse(rsp)
+}
+
+// GetInfoJobsInfoJobIdStatusWithResponse request returning *GetInfoJobsInfoJobIdStatusResponse
+func (c *ClientWithResponses) GetInfoJobsInfoJobIdStatusWithResponse(ctx context.Context, infoJobId InfoJobId, reqEditors ...RequestEditorFn) (*GetInfoJobsInfoJobIdStatusResponse, error) {
+	rsp, err := c.GetInfoJobsInfoJobIdStatus(ctx, infoJobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInfoJobsInfoJobIdStatusResponse(rsp)
+}
+
+// PostInfoJobsInfoJobIdStatusNotificationsWithBodyWithResponse request with arbitrary body returning *PostInfoJobsInfoJobIdStatusNotificationsResponse
+func (c *ClientWithResponses) PostInfoJobsInfoJobIdStatusNotificationsWithBodyWithResponse(ctx context.Context, infoJobId InfoJobId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInfoJobsInfoJobIdStatusNotificationsResponse, error) {
+	rsp, err := c.PostInfoJobsInfoJobIdStatusNotificationsWithBody(ctx, infoJobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInfoJobsInfoJobIdStatusNotificationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostInfoJobsInfoJobIdStatusNotificationsWithResponse(ctx context.Context, infoJobId InfoJobId, body PostInfoJobsInfoJobIdStatusNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInfoJobsInfoJobIdStatusNotificationsResponse, error) {
+	rsp, err := c.PostInfoJobsInfoJobIdStatusNotifications(ctx, infoJobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInfoJobsInfoJobIdStatusNotificationsResponse(rsp)
+}
+
+// GetInfoTypesWithResponse request returning *GetInfoTypesResponse
+func (c *ClientWithResponses) GetInfoTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInfoTypesResponse, error) {
+	rsp, err := c.GetInfoTypes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInfoTypesResponse(rsp)
+}
+
+// GetInfoTypesInfoTypeIdWithResponse request returning *GetInfoTypesInfoTypeIdResponse
+func (c *ClientWithResponses) GetInfoTypesInfoTypeIdWithResponse(ctx context.Context, infoTypeId InfoTypeId, reqEditors ...RequestEditorFn) (*GetInfoTypesInfoTypeIdResponse, error) {
+	rsp, err := c.GetInfoTypesInfoTypeId(ctx, infoTypeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInfoTypesInfoTypeIdResponse(rsp)
+}
+
+// ParseGetInfoJobsResponse parses an HTTP response from a GetInfoJobsWithResponse call
+func ParseGetInfoJobsResponse(rsp *http.Response) (*GetInfoJobsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInfoJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InfoJobId
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteInfoJobsInfoJobIdResponse parses an HTTP response from a DeleteInfoJobsInfoJobIdWithResponse call
+func ParseDeleteInfoJobsInfoJobIdResponse(rsp *http.Response) (*DeleteInfoJobsInfoJobIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteInfoJobsInfoJobIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetInfoJobsInfoJobIdResponse parses an HTTP response from a GetInfoJobsInfoJobIdWithResponse call
+func ParseGetInfoJobsInfoJobIdResponse(rsp *http.Response) (*GetInfoJobsInfoJobIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInfoJobsInfoJobIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InfoJobObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutInfoJobsInfoJobIdResponse parses an HTTP response from a PutInfoJobsInfoJobIdWithResponse call
+func ParsePutInfoJobsInfoJobIdResponse(rsp *http.Response) (*PutInfoJobsInfoJobIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutInfoJobsInfoJobIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InfoJobObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InfoJobObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostInfoJobsInfoJobIdResultsResponse parses an HTTP response from a PostInfoJobsInfoJobIdResultsWithResponse call
+func ParsePostInfoJobsInfoJobIdResultsResponse(rsp *http.Response) (*PostInfoJobsInfoJobIdResultsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostInfoJobsInfoJobIdResultsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetInfoJobsInfoJobIdStatusResponse parses an HTTP response from a GetInfoJobsInfoJobIdStatusWithResponse call
+func ParseGetInfoJobsInfoJobIdStatusResponse(rsp *http.Response) (*GetInfoJobsInfoJobIdStatusResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInfoJobsInfoJobIdStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InfoJobStatusObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostInfoJobsInfoJobIdStatusNotificationsResponse parses an HTTP response from a PostInfoJobsInfoJobIdStatusNotificationsWithResponse call
+func ParsePostInfoJobsInfoJobIdStatusNotificationsResponse(rsp *http.Response) (*PostInfoJobsInfoJobIdStatusNotificationsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostInfoJobsInfoJobIdStatusNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetInfoTypesResponse parses an HTTP response from a GetInfoTypesWithResponse call
+func ParseGetInfoTypesResponse(rsp *http.Response) (*GetInfoTypesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInfoTypesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InfoTypeId
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInfoTypesInfoTypeIdResponse parses an HTTP response from a GetInfoTypesInfoTypeIdWithResponse call
+func ParseGetInfoTypesInfoTypeIdResponse(rsp *http.Response) (*GetInfoTypesInfoTypeIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInfoTypesInfoTypeIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InfoTypeObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /info-jobs)
+	GetInfoJobs(ctx echo.Context, params GetInfoJobsParams) error
+
+	// (DELETE /info-jobs/{infoJobId})
+	DeleteInfoJobsInfoJobId(ctx echo.Context, infoJobId InfoJobId) error
+
+	// (GET /info-jobs/{infoJobId})
+	GetInfoJobsInfoJobId(ctx echo.Context, infoJobId InfoJobId) error
+
+	// (PUT /info-jobs/{infoJobId})
+	PutInfoJobsInfoJobId(ctx echo.Context, infoJobId InfoJobId) error
+
+	// (POST /info-jobs/{infoJobId}/results)
+	PostInfoJobsInfoJobIdResults(ctx echo.Context, infoJobId InfoJobId) error
+
+	// (GET /info-jobs/{infoJobId}/status)
+	GetInfoJobsInfoJobIdStatus(ctx echo.Context, infoJobId InfoJobId) error
+
+	// (POST /info-jobs/{infoJobId}/status-notifications)
+	PostInfoJobsInfoJobIdStatusNotifications(ctx echo.Context, infoJobId InfoJobId) error
+
+	// (GET /info-types)
+	GetInfoTypes(ctx echo.Context) error
+
+	// (GET /info-types/{infoTypeId})
+	GetInfoTypesInfoTypeId(ctx echo.Context, infoTypeId InfoTypeId) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetInfoJobs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInfoJobs(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInfoJobsParams
+	// ------------- Optional query parameter "infoTypeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "infoTypeId", ctx.QueryParams(), &params.InfoTypeId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter infoTypeId: %s", err))
+	}
+
+	// ------------- Optional query parameter "owner" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "owner", ctx.QueryParams(), &params.Owner)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter owner: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetInfoJobs(ctx, params)
+	return err
+}
+
+// DeleteInfoJobsInfoJobId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteInfoJobsInfoJobId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "infoJobId" -------------
+	var infoJobId InfoJobId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, ctx.Param("infoJobId"), &infoJobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter infoJobId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteInfoJobsInfoJobId(ctx, infoJobId)
+	return err
+}
+
+// GetInfoJobsInfoJobId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInfoJobsInfoJobId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "infoJobId" -------------
+	var infoJobId InfoJobId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, ctx.Param("infoJobId"), &infoJobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter infoJobId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetInfoJobsInfoJobId(ctx, infoJobId)
+	return err
+}
+
+// PutInfoJobsInfoJobId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutInfoJobsInfoJobId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "infoJobId" -------------
+	var infoJobId InfoJobId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, ctx.Param("infoJobId"), &infoJobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter infoJobId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutInfoJobsInfoJobId(ctx, infoJobId)
+	return err
+}
+
+// PostInfoJobsInfoJobIdResults converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInfoJobsInfoJobIdResults(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "infoJobId" -------------
+	var infoJobId InfoJobId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, ctx.Param("infoJobId"), &infoJobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter infoJobId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostInfoJobsInfoJobIdResults(ctx, infoJobId)
+	return err
+}
+
+// GetInfoJobsInfoJobIdStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInfoJobsInfoJobIdStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "infoJobId" -------------
+	var infoJobId InfoJobId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, ctx.Param("infoJobId"), &infoJobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter infoJobId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetInfoJobsInfoJobIdStatus(ctx, infoJobId)
+	return err
+}
+
+// PostInfoJobsInfoJobIdStatusNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInfoJobsInfoJobIdStatusNotifications(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "infoJobId" -------------
+	var infoJobId InfoJobId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "infoJobId", runtime.ParamLocationPath, ctx.Param("infoJobId"), &infoJobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter infoJobId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostInfoJobsInfoJobIdStatusNotifications(ctx, infoJobId)
+	return err
+}
+
+// GetInfoTypes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInfoTypes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetInfoTypes(ctx)
+	return err
+}
+
+// GetInfoTypesInfoTypeId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInfoTypesInfoTypeId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "infoTypeId" -------------
+	var infoTypeId InfoTypeId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "infoTypeId", runtime.ParamLocationPath, ctx.Param("infoTypeId"), &infoTypeId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter infoTypeId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetInfoTypesInfoTypeId(ctx, infoTypeId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/info-jobs", wrapper.GetInfoJobs)
+	router.DELETE(baseURL+"/info-jobs/:infoJobId", wrapper.DeleteInfoJobsInfoJobId)
+	router.GET(baseURL+"/info-jobs/:infoJobId", wrapper.GetInfoJobsInfoJobId)
+	router.PUT(baseURL+"/info-jobs/:infoJobId", wrapper.PutInfoJobsInfoJobId)
+	router.POST(baseURL+"/info-jobs/:infoJobId/results", wrapper.PostInfoJobsInfoJobIdResults)
+	router.GET(baseURL+"/info-jobs/:infoJobId/status", wrapper.GetInfoJobsInfoJobIdStatus)
+	router.POST(baseURL+"/info-jobs/:infoJobId/status-notifications", wrapper.PostInfoJobsInfoJobIdStatusNotifications)
+	router.GET(baseURL+"/info-types", wrapper.GetInfoTypes)
+	router.GET(baseURL+"/info-types/:infoTypeId", wrapper.GetInfoTypesInfoTypeId)
+
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/9xZzXLjuBF+lS4kt1CU7HGyu7ppbM9GroztaDSVw44PENkS4ZAAFwDlUU3pgfIaebJU",
+	"A5RIitSPp+L1lG8k8dc/X3/daH5jkcpyJVFaw4bfmEaTK2nQvVwMBr33PJ7g7wUaS18iJS1K98jzPBUR",
+	"t0LJfq7VLMXsL49GSRozUYIZp6c/a5yzIftTvzqm70dN/96vukLLRWrYer0OWIwm0iKnXdmQ3c0eMbIg",
+	"JOR8lSoeg1QWcq1y1OkK5kpnRcotxqC0G9LoX60CmyBkaBMVs3XALgYXvVtlP6hCxq+gyK0CjUYVOkKY",
+	"kwzArZPw82TsxDv/pTdV6iOXq9La5hWknCoFGZcr0KUMkPAlwgxRgkHpHMFhIZYogWeqkBbUHKzIkHT4",
+	"6+Bd7xPqpYjws+RLLlI+S/E11EiQMLIUMWoQBqJCa5Q2XUEhSSRCR8JlTE8JbpSFuHAjHCxmudJcr0At",
+	"URPsGB1SCkDyXfI0nfHo35+1oNfm8SMgqVO0CFE5j9wMMc6FxBh4FCkdC7mg08bX0w8w+XAJ7375+W8s",
+	"YHaVIxsyY7WQC7LqWM7VjZqN4/ZBNKQzZ0t4VDMQMUor5gI1cGPEgg6brZyOo7Pe1cdruFTSFBlqeErI",
+	"hX6VgUgjRc2B430gdugqQTSlGLrzYm65w0aCjQm0/9YQpWwkhPsk3BRhIMZULFFXcUxTNJoitc6ShbQi",
+	"3Q74BegV8NxghScwOnq6ynEcH0PVuJq5Dtijml1tJTq29KYx2a++e5KoaWHLoI9qNnGalNg5tHMdZn7p",
+	"J8ttYW4VudlH0XO3WQeMAC80xmz4W91Cu2rvyPqwRYfyYKjQ4Se10THdIKFy6Fxp4C3QBLDkqdgJDWFN",
+	"CztsvxDeNG0hrm9H7/9xfQXCozHXKi4i1Aa4RtjQQSngQew+qhkLGMoiI8OV27KAXY0/+ceH/fHjhdsX",
+	"RWQn42bQuW3zdOK6ofIxaFeTuwBQje5zchVE+ynIxfZxDrovmXkf29BRe+mmThRtU9F+AWC4CN2BN5/u",
+	"bsFbgSYTnppcY7rgdLMb+x2YPiTEPjiTRE1hTgH3TrbrsEiZRSF2U+ikiGu9Kt+Nz9l/n07vYVPbNeWi",
+	"5PPTz4OfWiDzG3SSmJDGchlh56DZorIckkU2Q01DVti0e5H/0BpYt0xCn/CrRS15eqWiDpPc9Saj2/Bf",
+	"v56Ho7PRfW85uAgHAxidgZAW9ZxHOIRRVYYQIK2KVMoCVuiUDVlibW6G/f7T01OoeprLUOlF3+QYbVnX",
+	"eCvMVYdH7seO5a6IRj5yyReYobTAZQzXX3NlCo1Q1kkBcAMG9dJHyehs6tNntMnTtFk3FRm3oU3wi0QZ",
+	"50pIa6iofEpElLgFt0r2JlOYjC839GbKeofY2q+vWKfMpiSOhSIvxQm/yP/+B84H5+8CcHaFUZoKcn34",
+	"RY7SFLRYJNbQnk6L8ItkWzezMuJPsQQLGAnoTXgWDsIBWVjlKHku2JC9CwfhGUGU28T5vE/26JHE9LbA",
+	"Drb4FS3wNN21XI2jDHNnaDc2jv2aki+NO03zDC1NHP5GtMuG7PcC9YoFTPKMdGwk0NMq2HqtsQ66t1Wu",
+	"gKjvuBsZD0HzsnY+GByotds1trCYnZo7fFVUSsC15quuuntEA44RjxjdX3j2nb3Vqt91K/IXjeNr99xG",
+	"HKPwBfmTEX7rCexGzWBcE/OBJlc4638TG2usPdio6GzD7sp9707jTbD5mRu8VbZuefaiOw/t2viJV6Ww",
+	"v/OeYOP6xfgHccxYxmIp4oK3/MMe1kF3sP+T4ucUo9ci/IDFnxdLJ4TQXS1/tT1Z3kExbon/hv3Yxa7E",
+	"701yrRy0KVqtLjB4nvUd1T4ELC86oHPprr8B9ZCKPHaPJ+DovtiDI2fH9ypevSSEmtZYvzZ+u5jI29KB",
+	"8Xxw9vrybLocLqIGp0RUo/v5ZgNxb5Lrl5UiifAasaqM7cyurZt6O1qbVWw7dpVpB++k1PZFY9gfcloM",
+	"XxzoqJSp3ndVvhObDYA0y6BNy+hgEdSvbnoHUrI93tw4nqDL9sTL01yjUfPcZF3q+UapAjY9otdI3cdA",
+	"2JO1nuiPxViuW7sCPlOF3URClHC5QPP/I692Y/iFiawdKN9BZ3V5QWOEYvlHJ+jnkiBdgp/Xctjpi+7t",
+	"OUzdzn/Urb762fHd1/qWYj/ovZ5U3Xexd+70fOItsj7oW3us+1v2cfc7eFzvF71oNqs10k+okqs/c9v/",
+	"WG8th5FBnpG9al763tyw7fI5xLkWaXmm7zR/47mYKGXXfd8q7S+px7nkWpBOxmPAzfBwnHP3n23bocav",
+	"nP40h5HK2K6Dy4XAzfaHq5AQpbwwCBfheUgNcbibjG57vlceju4JKA/r/w0AT2VqPBQiAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	var res = make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	var resolvePath = PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		var pathToFile = url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
+
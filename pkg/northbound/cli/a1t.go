@@ -6,17 +6,22 @@ package cli
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/onosproject/onos-a1t/pkg/audit"
 	"github.com/onosproject/onos-a1t/pkg/controller"
-	a1p "github.com/onosproject/onos-a1t/pkg/northbound/a1ap/policy_management"
+	"github.com/onosproject/onos-a1t/pkg/inventory"
+	"github.com/onosproject/onos-a1t/pkg/policysource"
 	"github.com/onosproject/onos-a1t/pkg/rnib"
+	"github.com/onosproject/onos-a1t/pkg/southbound"
 	"github.com/onosproject/onos-a1t/pkg/store"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
 	a1tadminapi "github.com/onosproject/onos-api/go/onos/a1t/admin"
 	"github.com/onosproject/onos-lib-go/pkg/logging/service"
 )
@@ -26,13 +31,18 @@ const TimeoutTimer = time.Second * 5
 var log = logging.GetLogger()
 
 // NewService returns a new A1T interface service.
-func NewService(subscriptionStore store.Store, policiesStore store.Store, eijobsStore store.Store, controllerBroker controller.Broker, rnibClient rnib.TopoClient) service.Service {
+func NewService(subscriptionStore store.Store, policiesStore store.Store, eijobsStore store.Store, controllerBroker controller.Broker, rnibClient rnib.TopoClient, auditLog audit.Log, sbManager southbound.Manager, policySource policysource.Source, inventory inventory.Inventory, authz *Authorization) service.Service {
 	return &Service{
 		subscriptionStore: subscriptionStore,
 		policiesStore:     policiesStore,
 		eijobsStore:       eijobsStore,
 		rnibClient:        rnibClient,
 		ctrlBroker:        controllerBroker,
+		auditLog:          auditLog,
+		sbManager:         sbManager,
+		policySource:      policySource,
+		inventory:         inventory,
+		authz:             authz,
 	}
 }
 
@@ -44,6 +54,11 @@ type Service struct {
 	eijobsStore       store.Store
 	rnibClient        rnib.TopoClient
 	ctrlBroker        controller.Broker
+	auditLog          audit.Log
+	sbManager         southbound.Manager
+	policySource      policysource.Source
+	inventory         inventory.Inventory
+	authz             *Authorization
 }
 
 func (s Service) Register(r *grpc.Server) {
@@ -53,8 +68,14 @@ func (s Service) Register(r *grpc.Server) {
 		eijobsStore:       s.eijobsStore,
 		rnibClient:        s.rnibClient,
 		ctrlBroker:        s.ctrlBroker,
+		auditLog:          s.auditLog,
+		sbManager:         s.sbManager,
+		policySource:      s.policySource,
+		inventory:         s.inventory,
+		authz:             s.authz,
 	}
 	a1tadminapi.RegisterA1TAdminServiceServer(r, server)
+	RegisterA1TAdminExtServiceServer(r, server)
 }
 
 type Server struct {
@@ -63,6 +84,11 @@ type Server struct {
 	eijobsStore       store.Store
 	rnibClient        rnib.TopoClient
 	ctrlBroker        controller.Broker
+	auditLog          audit.Log
+	sbManager         southbound.Manager
+	policySource      policysource.Source
+	inventory         inventory.Inventory
+	authz             *Authorization
 }
 
 func (s *Server) GetXAppConnections(request *a1tadminapi.GetXAppConnectionsRequest, server a1tadminapi.A1TAdminService_GetXAppConnectionsServer) error {
@@ -79,17 +105,18 @@ func (s *Server) GetXAppConnections(request *a1tadminapi.GetXAppConnectionsReque
 			continue
 		}
 
-		endPoint := fmt.Sprintf("%s:%d", sValue.A1EndpointIP, sValue.A1EndpointPort)
-		for _, c := range sValue.A1ServiceCapabilities {
-			resp := &a1tadminapi.GetXAppConnectionResponse{
-				XappId:                   string(sKey.TargetXAppID),
-				SupportedA1Service:       c.A1Service.String(),
-				SupportedA1ServiceTypeId: c.TypeID,
-				XappA1Endpoint:           endPoint,
-			}
-			err := server.Send(resp)
-			if err != nil {
-				return err
+		for _, endpoint := range sValue.A1Endpoints {
+			for _, c := range sValue.A1ServiceCapabilities {
+				resp := &a1tadminapi.GetXAppConnectionResponse{
+					XappId:                   string(sKey.TargetXAppID),
+					SupportedA1Service:       c.A1Service.String(),
+					SupportedA1ServiceTypeId: c.TypeID,
+					XappA1Endpoint:           endpoint.String(),
+				}
+				err := server.Send(resp)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -97,44 +124,32 @@ func (s *Server) GetXAppConnections(request *a1tadminapi.GetXAppConnectionsReque
 	return nil
 }
 
+// EntryErrorTrailer is the trailer of the legacy policy streams carrying, as "<policy type ID>[/<policy ID>]: <error>",
+// the error of each entry which could not be fully read, as the legacy responses have no field for it
+const EntryErrorTrailer = "a1t-entry-error"
+
+// GetPolicyTypeObject streams the policy types like ListPolicyTypeObjects; a type whose schema or policies cannot be
+// fully read is still sent, and its error reported in the EntryErrorTrailer
 func (s *Server) GetPolicyTypeObject(request *a1tadminapi.GetPolicyTypeObjectRequest, server a1tadminapi.A1TAdminService_GetPolicyTypeObjectServer) error {
 	log.Info("Get policy type object")
-	ctx, cancel := context.WithTimeout(context.Background(), TimeoutTimer)
-	defer cancel()
-
-	policyTypes := s.ctrlBroker.A1PController().HandleGetPolicyTypes(ctx)
-	for _, pt := range policyTypes {
-		policyTypeSchema, statusSchema, err := s.ctrlBroker.A1PController().HandleGetPolicytypesPolicyTypeId(ctx, pt)
-		if err != nil {
-			return err
+	ctx := getAdminContext(server.Context())
+
+	entryErrors := make([]string, 0)
+	defer func() {
+		setEntryErrors(server, entryErrors)
+	}()
+	for _, pt := range s.listPolicyTypeIDs(ctx, request.PolicyTypeId) {
+		entry := s.getPolicyTypeObject(ctx, pt)
+		if entry.Error != "" {
+			log.Warnf("Policy type %v: %v", pt, entry.Error)
+			entryErrors = append(entryErrors, fmt.Sprintf("%v: %v", pt, entry.Error))
 		}
-
-		if request.PolicyTypeId != "" && request.PolicyTypeId != pt {
-			continue
-		}
-
-		policyTypeObject := a1p.PolicyTypeObject{
-			PolicySchema: policyTypeSchema,
-			StatusSchema: (*a1p.JsonSchema)(&statusSchema),
-		}
-		pto, err := json.Marshal(policyTypeObject)
-		if err != nil {
-			return err
-		}
-
-		oIDs, err := s.ctrlBroker.A1PController().HandleGetPolicytypesPolicyTypeIdPolicies(ctx, pt)
-		if err != nil {
-			return err
-		}
-
 		resp := &a1tadminapi.GetPolicyTypeObjectResponse{
 			PolicyTypeId:     pt,
-			PolicyIds:        oIDs,
-			PolicyTypeObject: string(pto),
+			PolicyIds:        entry.PolicyIds,
+			PolicyTypeObject: entry.PolicyTypeObject,
 		}
-
-		err = server.Send(resp)
-		if err != nil {
+		if err := server.Send(resp); err != nil {
 			return err
 		}
 	}
@@ -142,47 +157,61 @@ func (s *Server) GetPolicyTypeObject(request *a1tadminapi.GetPolicyTypeObjectReq
 	return nil
 }
 
+// GetPolicyObject streams the policy objects like ListPolicyObjects; a policy which cannot be fully read is still
+// sent, and its error reported in the EntryErrorTrailer
 func (s *Server) GetPolicyObject(request *a1tadminapi.GetPolicyObjectRequest, server a1tadminapi.A1TAdminService_GetPolicyObjectServer) error {
 	log.Info("Get policy object")
-	ctx, cancel := context.WithTimeout(context.Background(), TimeoutTimer)
-	defer cancel()
-
-	var err error
-	tIDs := make([]string, 0)
-	pIDs := make([]string, 0)
+	ctx := getAdminContext(server.Context())
+
+	return s.streamPolicyObjects(ctx, server, request.PolicyTypeId, request.PolicyObjectId, a1.PayloadType_POLICY, func(entry *PolicyObject) error {
+		return server.Send(&a1tadminapi.GetPolicyObjectResponse{
+			PolicyTypeId:   entry.PolicyTypeId,
+			PolicyObjectId: entry.PolicyObjectId,
+			PolicyObject:   entry.PolicyObject,
+		})
+	})
+}
 
-	if request.PolicyTypeId == "" {
-		tIDs = s.ctrlBroker.A1PController().HandleGetPolicyTypes(ctx)
-	} else {
-		tIDs = append(tIDs, request.PolicyTypeId)
-	}
+// GetPolicyObjectStatus streams the policy statuses like ListPolicyObjectStatuses; a status which cannot be fully
+// read is still sent, and its error reported in the EntryErrorTrailer
+func (s *Server) GetPolicyObjectStatus(request *a1tadminapi.GetPolicyObjectStatusRequest, server a1tadminapi.A1TAdminService_GetPolicyObjectStatusServer) error {
+	log.Info("Get policy type object status")
+	ctx := getAdminContext(server.Context())
+
+	return s.streamPolicyObjects(ctx, server, request.PolicyTypeId, request.PolicyObjectId, a1.PayloadType_STATUS, func(entry *PolicyObject) error {
+		return server.Send(&a1tadminapi.GetPolicyObjectStatusResponse{
+			PolicyTypeId:       entry.PolicyTypeId,
+			PolicyObjectId:     entry.PolicyObjectId,
+			PolicyObjectStatus: entry.PolicyObject,
+		})
+	})
+}
 
-	for _, t := range tIDs {
-		if request.PolicyObjectId == "" {
-			pIDs, err = s.ctrlBroker.A1PController().HandleGetPolicytypesPolicyTypeIdPolicies(ctx, t)
+// streamPolicyObjects sends the policies of the types with send, reading each of them as listPolicyObjects does; the
+// errors of the types whose policies cannot be fully listed and of the policies are set in the EntryErrorTrailer
+func (s *Server) streamPolicyObjects(ctx context.Context, server grpc.ServerStream, policyTypeID, policyID string, payloadType a1.PayloadType, send func(entry *PolicyObject) error) error {
+	entryErrors := make([]string, 0)
+	defer func() {
+		setEntryErrors(server, entryErrors)
+	}()
+	for _, pt := range s.listPolicyTypeIDs(ctx, policyTypeID) {
+		policyIDs := []string{policyID}
+		if policyID == "" {
+			var err error
+			policyIDs, _, err = s.queryPolicyIDs(ctx, pt)
 			if err != nil {
-				return err
+				log.Warnf("Policies of type %v: %v", pt, err)
+				entryErrors = append(entryErrors, fmt.Sprintf("%v: %v", pt, err))
 			}
-		} else {
-			pIDs = append(pIDs, request.PolicyObjectId)
 		}
-
-		for _, i := range pIDs {
-			obj, err := s.ctrlBroker.A1PController().HandleGetPolicy(ctx, i, t)
-			if err != nil {
-				return err
-			}
-			objJson, err := json.Marshal(obj)
-			if err != nil {
-				return err
+		for _, i := range policyIDs {
+			entry := &PolicyObject{PolicyTypeId: pt, PolicyObjectId: i}
+			s.getPolicyObject(ctx, entry, payloadType)
+			if entry.Error != "" {
+				log.Warnf("Policy %v of type %v: %v", i, pt, entry.Error)
+				entryErrors = append(entryErrors, fmt.Sprintf("%v/%v: %v", pt, i, entry.Error))
 			}
-			resp := &a1tadminapi.GetPolicyObjectResponse{
-				PolicyTypeId:   t,
-				PolicyObjectId: i,
-				PolicyObject:   string(objJson),
-			}
-			err = server.Send(resp)
-			if err != nil {
+			if err := send(entry); err != nil {
 				return err
 			}
 		}
@@ -191,51 +220,19 @@ func (s *Server) GetPolicyObject(request *a1tadminapi.GetPolicyObjectRequest, se
 	return nil
 }
 
-func (s *Server) GetPolicyObjectStatus(request *a1tadminapi.GetPolicyObjectStatusRequest, server a1tadminapi.A1TAdminService_GetPolicyObjectStatusServer) error {
-	log.Info("Get policy type object status")
-	ctx, cancel := context.WithTimeout(context.Background(), TimeoutTimer)
-	defer cancel()
-
-	var err error
-	tIDs := make([]string, 0)
-	pIDs := make([]string, 0)
-
-	if request.PolicyTypeId == "" {
-		tIDs = s.ctrlBroker.A1PController().HandleGetPolicyTypes(ctx)
-	} else {
-		tIDs = append(tIDs, request.PolicyTypeId)
+// setEntryErrors sets the EntryErrorTrailer of a legacy policy stream, if any entry failed
+func setEntryErrors(server grpc.ServerStream, entryErrors []string) {
+	if len(entryErrors) > 0 {
+		server.SetTrailer(metadata.MD{EntryErrorTrailer: entryErrors})
 	}
+}
 
-	for _, t := range tIDs {
-		if request.PolicyObjectId == "" {
-			pIDs, err = s.ctrlBroker.A1PController().HandleGetPolicytypesPolicyTypeIdPolicies(ctx, t)
-			if err != nil {
-				return err
-			}
-		} else {
-			pIDs = append(pIDs, request.PolicyObjectId)
-		}
-
-		for _, i := range pIDs {
-			obj, err := s.ctrlBroker.A1PController().HandleGetPolicyStatus(ctx, i, t)
-			if err != nil {
-				return err
-			}
-			objJson, err := json.Marshal(obj)
-			if err != nil {
-				return err
-			}
-			resp := &a1tadminapi.GetPolicyObjectStatusResponse{
-				PolicyTypeId:       t,
-				PolicyObjectId:     i,
-				PolicyObjectStatus: string(objJson),
-			}
-			err = server.Send(resp)
-			if err != nil {
-				return err
-			}
-		}
+// listPolicyTypeIDs returns the sorted policy types, or only the given one if it is not empty
+func (s *Server) listPolicyTypeIDs(ctx context.Context, policyTypeID string) []string {
+	if policyTypeID != "" {
+		return []string{policyTypeID}
 	}
-
-	return nil
+	policyTypeIDs := s.ctrlBroker.A1PController().HandleGetPolicyTypes(ctx)
+	sort.Strings(policyTypeIDs)
+	return policyTypeIDs
 }
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	a1tadminapi "github.com/onosproject/onos-api/go/onos/a1t/admin"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeA1PController answers the queries of the policies held by two xApps, xapp-2 failing for the policy "broken"
+type fakeA1PController struct {
+	controller.A1PController
+}
+
+func (c *fakeA1PController) HandleGetPolicyTypes(ctx context.Context) []string {
+	return []string{"type-1", "type-2"}
+}
+
+func (c *fakeA1PController) HandleGetPolicytypesPolicyTypeId(ctx context.Context, policyTypeID string) (map[string]interface{}, map[string]interface{}, error) {
+	if policyTypeID == "type-2" {
+		return nil, nil, errors.NewNotFound("policy type %v not found", policyTypeID)
+	}
+	return map[string]interface{}{"type": "object"}, map[string]interface{}{}, nil
+}
+
+func (c *fakeA1PController) HandleQueryXApps(ctx context.Context, policyID, policyTypeID string, payloadType a1.PayloadType) ([]*controller.XAppQueryResult, error) {
+	switch {
+	case policyTypeID == "type-2":
+		return []*controller.XAppQueryResult{}, nil
+	case policyID == "":
+		return []*controller.XAppQueryResult{
+			{XAppID: "xapp-1", Payload: []byte(`["ok", "broken"]`)},
+			{XAppID: "xapp-2", Payload: []byte(`["broken", "ok"]`)},
+		}, nil
+	case policyID == "broken":
+		return []*controller.XAppQueryResult{
+			{XAppID: "xapp-1", Payload: []byte(`{"priority": 1}`)},
+			{XAppID: "xapp-2", Err: errors.NewTimeout("no answer")},
+		}, nil
+	}
+	return []*controller.XAppQueryResult{
+		{XAppID: "xapp-1", Payload: []byte(`{"priority": 1}`)},
+		{XAppID: "xapp-2", Payload: []byte(`{"priority": 1}`)},
+	}, nil
+}
+
+type fakeBroker struct {
+	controller.Broker
+	a1pController controller.A1PController
+}
+
+func (b *fakeBroker) A1PController() controller.A1PController {
+	return b.a1pController
+}
+
+func newTestAdminClient(t *testing.T, server *Server) a1tadminapi.A1TAdminServiceClient {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	a1tadminapi.RegisterA1TAdminServiceServer(s, server)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return a1tadminapi.NewA1TAdminServiceClient(conn)
+}
+
+func TestLegacyPolicyStreams(t *testing.T) {
+	client := newTestAdminClient(t, &Server{ctrlBroker: &fakeBroker{a1pController: &fakeA1PController{}}})
+	ctx := context.Background()
+
+	types, err := client.GetPolicyTypeObject(ctx, &a1tadminapi.GetPolicyTypeObjectRequest{})
+	require.NoError(t, err)
+	policyTypeIDs := make([]string, 0)
+	for {
+		resp, err := types.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		policyTypeIDs = append(policyTypeIDs, resp.PolicyTypeId)
+		if resp.PolicyTypeId == "type-1" {
+			assert.Equal(t, []string{"broken", "ok"}, resp.PolicyIds)
+		}
+	}
+	assert.Equal(t, []string{"type-1", "type-2"}, policyTypeIDs)
+	entryErrors := types.Trailer().Get(EntryErrorTrailer)
+	if assert.Len(t, entryErrors, 1) {
+		assert.Contains(t, entryErrors[0], "type-2: ")
+	}
+
+	policies, err := client.GetPolicyObject(ctx, &a1tadminapi.GetPolicyObjectRequest{PolicyTypeId: "type-1"})
+	require.NoError(t, err)
+	objects := make(map[string]string)
+	for {
+		resp, err := policies.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		objects[resp.PolicyObjectId] = resp.PolicyObject
+	}
+	assert.Equal(t, map[string]string{"broken": "", "ok": `{"priority": 1}`}, objects)
+	entryErrors = policies.Trailer().Get(EntryErrorTrailer)
+	if assert.Len(t, entryErrors, 1) {
+		assert.Contains(t, entryErrors[0], "type-1/broken: ")
+		assert.Contains(t, entryErrors[0], "xapp-2")
+	}
+}
@@ -0,0 +1,21422 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: onos/a1t/admin/admin_ext.proto
+
+// Package onos.a1t.admin defines the admin RPCs of A1T that are not (yet) part of onos-api.
+
+package cli
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	golang_proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetPolicyHistoryRequest struct {
+	PolicyTypeId   string    `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string    `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	Since          time.Time `protobuf:"bytes,3,opt,name=since,proto3,stdtime" json:"since"`
+	Until          time.Time `protobuf:"bytes,4,opt,name=until,proto3,stdtime" json:"until"`
+}
+
+func (m *GetPolicyHistoryRequest) Reset()         { *m = GetPolicyHistoryRequest{} }
+func (m *GetPolicyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPolicyHistoryRequest) ProtoMessage()    {}
+func (*GetPolicyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{0}
+}
+func (m *GetPolicyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPolicyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPolicyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPolicyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPolicyHistoryRequest.Merge(m, src)
+}
+func (m *GetPolicyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPolicyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPolicyHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPolicyHistoryRequest proto.InternalMessageInfo
+
+func (m *GetPolicyHistoryRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *GetPolicyHistoryRequest) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *GetPolicyHistoryRequest) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
+func (m *GetPolicyHistoryRequest) GetUntil() time.Time {
+	if m != nil {
+		return m.Until
+	}
+	return time.Time{}
+}
+
+type XAppOutcome struct {
+	XappId  string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *XAppOutcome) Reset()         { *m = XAppOutcome{} }
+func (m *XAppOutcome) String() string { return proto.CompactTextString(m) }
+func (*XAppOutcome) ProtoMessage()    {}
+func (*XAppOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{1}
+}
+func (m *XAppOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XAppOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XAppOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *XAppOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XAppOutcome.Merge(m, src)
+}
+func (m *XAppOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *XAppOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_XAppOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XAppOutcome proto.InternalMessageInfo
+
+func (m *XAppOutcome) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *XAppOutcome) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *XAppOutcome) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type GetPolicyHistoryResponse struct {
+	Sequence        uint64         `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp       time.Time      `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Operation       string         `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	PolicyTypeId    string         `protobuf:"bytes,4,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId  string         `protobuf:"bytes,5,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	Caller          string         `protobuf:"bytes,6,opt,name=caller,proto3" json:"caller,omitempty"`
+	RequestId       string         `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Revision        uint64         `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`
+	OldPolicyObject string         `protobuf:"bytes,9,opt,name=old_policy_object,json=oldPolicyObject,proto3" json:"old_policy_object,omitempty"`
+	NewPolicyObject string         `protobuf:"bytes,10,opt,name=new_policy_object,json=newPolicyObject,proto3" json:"new_policy_object,omitempty"`
+	TargetXappIds   []string       `protobuf:"bytes,11,rep,name=target_xapp_ids,json=targetXappIds,proto3" json:"target_xapp_ids,omitempty"`
+	XappOutcomes    []*XAppOutcome `protobuf:"bytes,12,rep,name=xapp_outcomes,json=xappOutcomes,proto3" json:"xapp_outcomes,omitempty"`
+}
+
+func (m *GetPolicyHistoryResponse) Reset()         { *m = GetPolicyHistoryResponse{} }
+func (m *GetPolicyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPolicyHistoryResponse) ProtoMessage()    {}
+func (*GetPolicyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{2}
+}
+func (m *GetPolicyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPolicyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPolicyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPolicyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPolicyHistoryResponse.Merge(m, src)
+}
+func (m *GetPolicyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPolicyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPolicyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPolicyHistoryResponse proto.InternalMessageInfo
+
+func (m *GetPolicyHistoryResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *GetPolicyHistoryResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *GetPolicyHistoryResponse) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *GetPolicyHistoryResponse) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *GetPolicyHistoryResponse) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *GetPolicyHistoryResponse) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *GetPolicyHistoryResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *GetPolicyHistoryResponse) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *GetPolicyHistoryResponse) GetOldPolicyObject() string {
+	if m != nil {
+		return m.OldPolicyObject
+	}
+	return ""
+}
+
+func (m *GetPolicyHistoryResponse) GetNewPolicyObject() string {
+	if m != nil {
+		return m.NewPolicyObject
+	}
+	return ""
+}
+
+func (m *GetPolicyHistoryResponse) GetTargetXappIds() []string {
+	if m != nil {
+		return m.TargetXappIds
+	}
+	return nil
+}
+
+func (m *GetPolicyHistoryResponse) GetXappOutcomes() []*XAppOutcome {
+	if m != nil {
+		return m.XappOutcomes
+	}
+	return nil
+}
+
+type RollbackPolicyRequest struct {
+	PolicyTypeId   string    `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string    `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	Revision       uint64    `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Timestamp      time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	DryRun         bool      `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *RollbackPolicyRequest) Reset()         { *m = RollbackPolicyRequest{} }
+func (m *RollbackPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackPolicyRequest) ProtoMessage()    {}
+func (*RollbackPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{3}
+}
+func (m *RollbackPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackPolicyRequest.Merge(m, src)
+}
+func (m *RollbackPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackPolicyRequest proto.InternalMessageInfo
+
+func (m *RollbackPolicyRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *RollbackPolicyRequest) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *RollbackPolicyRequest) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *RollbackPolicyRequest) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *RollbackPolicyRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type PolicyRollback struct {
+	PolicyTypeId        string   `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId      string   `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	Action              string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	FromRevision        uint64   `protobuf:"varint,4,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision          uint64   `protobuf:"varint,5,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	CurrentPolicyObject string   `protobuf:"bytes,6,opt,name=current_policy_object,json=currentPolicyObject,proto3" json:"current_policy_object,omitempty"`
+	TargetPolicyObject  string   `protobuf:"bytes,7,opt,name=target_policy_object,json=targetPolicyObject,proto3" json:"target_policy_object,omitempty"`
+	ChangedFields       []string `protobuf:"bytes,8,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Success             bool     `protobuf:"varint,9,opt,name=success,proto3" json:"success,omitempty"`
+	Reason              string   `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PolicyRollback) Reset()         { *m = PolicyRollback{} }
+func (m *PolicyRollback) String() string { return proto.CompactTextString(m) }
+func (*PolicyRollback) ProtoMessage()    {}
+func (*PolicyRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{4}
+}
+func (m *PolicyRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyRollback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicyRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyRollback.Merge(m, src)
+}
+func (m *PolicyRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyRollback proto.InternalMessageInfo
+
+func (m *PolicyRollback) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicyRollback) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PolicyRollback) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PolicyRollback) GetFromRevision() uint64 {
+	if m != nil {
+		return m.FromRevision
+	}
+	return 0
+}
+
+func (m *PolicyRollback) GetToRevision() uint64 {
+	if m != nil {
+		return m.ToRevision
+	}
+	return 0
+}
+
+func (m *PolicyRollback) GetCurrentPolicyObject() string {
+	if m != nil {
+		return m.CurrentPolicyObject
+	}
+	return ""
+}
+
+func (m *PolicyRollback) GetTargetPolicyObject() string {
+	if m != nil {
+		return m.TargetPolicyObject
+	}
+	return ""
+}
+
+func (m *PolicyRollback) GetChangedFields() []string {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+func (m *PolicyRollback) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *PolicyRollback) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type RollbackPolicyResponse struct {
+	Rollbacks []*PolicyRollback `protobuf:"bytes,1,rep,name=rollbacks,proto3" json:"rollbacks,omitempty"`
+}
+
+func (m *RollbackPolicyResponse) Reset()         { *m = RollbackPolicyResponse{} }
+func (m *RollbackPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackPolicyResponse) ProtoMessage()    {}
+func (*RollbackPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{5}
+}
+func (m *RollbackPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackPolicyResponse.Merge(m, src)
+}
+func (m *RollbackPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackPolicyResponse proto.InternalMessageInfo
+
+func (m *RollbackPolicyResponse) GetRollbacks() []*PolicyRollback {
+	if m != nil {
+		return m.Rollbacks
+	}
+	return nil
+}
+
+type ListXAppSessionsRequest struct {
+	XappId string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+}
+
+func (m *ListXAppSessionsRequest) Reset()         { *m = ListXAppSessionsRequest{} }
+func (m *ListXAppSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListXAppSessionsRequest) ProtoMessage()    {}
+func (*ListXAppSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{6}
+}
+func (m *ListXAppSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListXAppSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListXAppSessionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListXAppSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListXAppSessionsRequest.Merge(m, src)
+}
+func (m *ListXAppSessionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListXAppSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListXAppSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListXAppSessionsRequest proto.InternalMessageInfo
+
+func (m *ListXAppSessionsRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+type XAppSession struct {
+	XappId    string    `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	A1Service string    `protobuf:"bytes,2,opt,name=a1_service,json=a1Service,proto3" json:"a1_service,omitempty"`
+	Endpoint  string    `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	State     string    `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Attempts  int32     `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string    `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Since     time.Time `protobuf:"bytes,7,opt,name=since,proto3,stdtime" json:"since"`
+}
+
+func (m *XAppSession) Reset()         { *m = XAppSession{} }
+func (m *XAppSession) String() string { return proto.CompactTextString(m) }
+func (*XAppSession) ProtoMessage()    {}
+func (*XAppSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{7}
+}
+func (m *XAppSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XAppSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XAppSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *XAppSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XAppSession.Merge(m, src)
+}
+func (m *XAppSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *XAppSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_XAppSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XAppSession proto.InternalMessageInfo
+
+func (m *XAppSession) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *XAppSession) GetA1Service() string {
+	if m != nil {
+		return m.A1Service
+	}
+	return ""
+}
+
+func (m *XAppSession) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *XAppSession) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *XAppSession) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *XAppSession) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *XAppSession) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
+type ListXAppSessionsResponse struct {
+	Sessions []*XAppSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (m *ListXAppSessionsResponse) Reset()         { *m = ListXAppSessionsResponse{} }
+func (m *ListXAppSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListXAppSessionsResponse) ProtoMessage()    {}
+func (*ListXAppSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{8}
+}
+func (m *ListXAppSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListXAppSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListXAppSessionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListXAppSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListXAppSessionsResponse.Merge(m, src)
+}
+func (m *ListXAppSessionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListXAppSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListXAppSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListXAppSessionsResponse proto.InternalMessageInfo
+
+func (m *ListXAppSessionsResponse) GetSessions() []*XAppSession {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type ListCircuitBreakersRequest struct {
+	XappId string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+}
+
+func (m *ListCircuitBreakersRequest) Reset()         { *m = ListCircuitBreakersRequest{} }
+func (m *ListCircuitBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCircuitBreakersRequest) ProtoMessage()    {}
+func (*ListCircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{9}
+}
+func (m *ListCircuitBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCircuitBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCircuitBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCircuitBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCircuitBreakersRequest.Merge(m, src)
+}
+func (m *ListCircuitBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCircuitBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCircuitBreakersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCircuitBreakersRequest proto.InternalMessageInfo
+
+func (m *ListCircuitBreakersRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+type CircuitBreaker struct {
+	XappId              string    `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	State               string    `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ConsecutiveFailures int32     `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastError           string    `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Since               time.Time `protobuf:"bytes,5,opt,name=since,proto3,stdtime" json:"since"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{10}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetConsecutiveFailures() int32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
+type ListCircuitBreakersResponse struct {
+	CircuitBreakers []*CircuitBreaker `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
+}
+
+func (m *ListCircuitBreakersResponse) Reset()         { *m = ListCircuitBreakersResponse{} }
+func (m *ListCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCircuitBreakersResponse) ProtoMessage()    {}
+func (*ListCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{11}
+}
+func (m *ListCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCircuitBreakersResponse.Merge(m, src)
+}
+func (m *ListCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCircuitBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCircuitBreakersResponse proto.InternalMessageInfo
+
+func (m *ListCircuitBreakersResponse) GetCircuitBreakers() []*CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+type WatchCircuitBreakersRequest struct {
+	XappId string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+}
+
+func (m *WatchCircuitBreakersRequest) Reset()         { *m = WatchCircuitBreakersRequest{} }
+func (m *WatchCircuitBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchCircuitBreakersRequest) ProtoMessage()    {}
+func (*WatchCircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{12}
+}
+func (m *WatchCircuitBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchCircuitBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchCircuitBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchCircuitBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchCircuitBreakersRequest.Merge(m, src)
+}
+func (m *WatchCircuitBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchCircuitBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchCircuitBreakersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchCircuitBreakersRequest proto.InternalMessageInfo
+
+func (m *WatchCircuitBreakersRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+type WatchCircuitBreakersResponse struct {
+	XappId    string    `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	FromState string    `protobuf:"bytes,2,opt,name=from_state,json=fromState,proto3" json:"from_state,omitempty"`
+	ToState   string    `protobuf:"bytes,3,opt,name=to_state,json=toState,proto3" json:"to_state,omitempty"`
+	Reason    string    `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp time.Time `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *WatchCircuitBreakersResponse) Reset()         { *m = WatchCircuitBreakersResponse{} }
+func (m *WatchCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchCircuitBreakersResponse) ProtoMessage()    {}
+func (*WatchCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{13}
+}
+func (m *WatchCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchCircuitBreakersResponse.Merge(m, src)
+}
+func (m *WatchCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchCircuitBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchCircuitBreakersResponse proto.InternalMessageInfo
+
+func (m *WatchCircuitBreakersResponse) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *WatchCircuitBreakersResponse) GetFromState() string {
+	if m != nil {
+		return m.FromState
+	}
+	return ""
+}
+
+func (m *WatchCircuitBreakersResponse) GetToState() string {
+	if m != nil {
+		return m.ToState
+	}
+	return ""
+}
+
+func (m *WatchCircuitBreakersResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WatchCircuitBreakersResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+type WatchPolicyObjectStatusRequest struct {
+	PolicyTypeId   string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	XappId         string `protobuf:"bytes,3,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	// snapshot sends the last status reported by each xApp before the new ones
+	Snapshot bool `protobuf:"varint,4,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (m *WatchPolicyObjectStatusRequest) Reset()         { *m = WatchPolicyObjectStatusRequest{} }
+func (m *WatchPolicyObjectStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchPolicyObjectStatusRequest) ProtoMessage()    {}
+func (*WatchPolicyObjectStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{14}
+}
+func (m *WatchPolicyObjectStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchPolicyObjectStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchPolicyObjectStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchPolicyObjectStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchPolicyObjectStatusRequest.Merge(m, src)
+}
+func (m *WatchPolicyObjectStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchPolicyObjectStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchPolicyObjectStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchPolicyObjectStatusRequest proto.InternalMessageInfo
+
+func (m *WatchPolicyObjectStatusRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *WatchPolicyObjectStatusRequest) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *WatchPolicyObjectStatusRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *WatchPolicyObjectStatusRequest) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
+	}
+	return false
+}
+
+type WatchPolicyObjectStatusResponse struct {
+	PolicyTypeId       string    `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId     string    `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	XappId             string    `protobuf:"bytes,3,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	PolicyObjectStatus string    `protobuf:"bytes,4,opt,name=policy_object_status,json=policyObjectStatus,proto3" json:"policy_object_status,omitempty"`
+	Timestamp          time.Time `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *WatchPolicyObjectStatusResponse) Reset()         { *m = WatchPolicyObjectStatusResponse{} }
+func (m *WatchPolicyObjectStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchPolicyObjectStatusResponse) ProtoMessage()    {}
+func (*WatchPolicyObjectStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{15}
+}
+func (m *WatchPolicyObjectStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchPolicyObjectStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchPolicyObjectStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchPolicyObjectStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchPolicyObjectStatusResponse.Merge(m, src)
+}
+func (m *WatchPolicyObjectStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchPolicyObjectStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchPolicyObjectStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchPolicyObjectStatusResponse proto.InternalMessageInfo
+
+func (m *WatchPolicyObjectStatusResponse) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *WatchPolicyObjectStatusResponse) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *WatchPolicyObjectStatusResponse) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *WatchPolicyObjectStatusResponse) GetPolicyObjectStatus() string {
+	if m != nil {
+		return m.PolicyObjectStatus
+	}
+	return ""
+}
+
+func (m *WatchPolicyObjectStatusResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+type BulkPolicyOperation struct {
+	Action                  string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	PolicyTypeId            string `protobuf:"bytes,2,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId          string `protobuf:"bytes,3,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	NotificationDestination string `protobuf:"bytes,4,opt,name=notification_destination,json=notificationDestination,proto3" json:"notification_destination,omitempty"`
+	PolicyObject            string `protobuf:"bytes,5,opt,name=policy_object,json=policyObject,proto3" json:"policy_object,omitempty"`
+}
+
+func (m *BulkPolicyOperation) Reset()         { *m = BulkPolicyOperation{} }
+func (m *BulkPolicyOperation) String() string { return proto.CompactTextString(m) }
+func (*BulkPolicyOperation) ProtoMessage()    {}
+func (*BulkPolicyOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{16}
+}
+func (m *BulkPolicyOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkPolicyOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkPolicyOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkPolicyOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkPolicyOperation.Merge(m, src)
+}
+func (m *BulkPolicyOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkPolicyOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkPolicyOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkPolicyOperation proto.InternalMessageInfo
+
+func (m *BulkPolicyOperation) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *BulkPolicyOperation) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *BulkPolicyOperation) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *BulkPolicyOperation) GetNotificationDestination() string {
+	if m != nil {
+		return m.NotificationDestination
+	}
+	return ""
+}
+
+func (m *BulkPolicyOperation) GetPolicyObject() string {
+	if m != nil {
+		return m.PolicyObject
+	}
+	return ""
+}
+
+type BulkPolicyOperationsRequest struct {
+	Operations []*BulkPolicyOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	Atomic     bool                   `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+}
+
+func (m *BulkPolicyOperationsRequest) Reset()         { *m = BulkPolicyOperationsRequest{} }
+func (m *BulkPolicyOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkPolicyOperationsRequest) ProtoMessage()    {}
+func (*BulkPolicyOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{17}
+}
+func (m *BulkPolicyOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkPolicyOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkPolicyOperationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkPolicyOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkPolicyOperationsRequest.Merge(m, src)
+}
+func (m *BulkPolicyOperationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkPolicyOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkPolicyOperationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkPolicyOperationsRequest proto.InternalMessageInfo
+
+func (m *BulkPolicyOperationsRequest) GetOperations() []*BulkPolicyOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *BulkPolicyOperationsRequest) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+type BulkPolicyResult struct {
+	Index          int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PolicyTypeId   string `protobuf:"bytes,2,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,3,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	Action         string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Applied        bool   `protobuf:"varint,5,opt,name=applied,proto3" json:"applied,omitempty"`
+	Compensated    bool   `protobuf:"varint,6,opt,name=compensated,proto3" json:"compensated,omitempty"`
+	Reason         string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *BulkPolicyResult) Reset()         { *m = BulkPolicyResult{} }
+func (m *BulkPolicyResult) String() string { return proto.CompactTextString(m) }
+func (*BulkPolicyResult) ProtoMessage()    {}
+func (*BulkPolicyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{18}
+}
+func (m *BulkPolicyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkPolicyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkPolicyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkPolicyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkPolicyResult.Merge(m, src)
+}
+func (m *BulkPolicyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkPolicyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkPolicyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkPolicyResult proto.InternalMessageInfo
+
+func (m *BulkPolicyResult) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BulkPolicyResult) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *BulkPolicyResult) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *BulkPolicyResult) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *BulkPolicyResult) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+func (m *BulkPolicyResult) GetCompensated() bool {
+	if m != nil {
+		return m.Compensated
+	}
+	return false
+}
+
+func (m *BulkPolicyResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type BulkPolicyOperationsResponse struct {
+	Success bool                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Reason  string              `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Results []*BulkPolicyResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *BulkPolicyOperationsResponse) Reset()         { *m = BulkPolicyOperationsResponse{} }
+func (m *BulkPolicyOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkPolicyOperationsResponse) ProtoMessage()    {}
+func (*BulkPolicyOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{19}
+}
+func (m *BulkPolicyOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkPolicyOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkPolicyOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkPolicyOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkPolicyOperationsResponse.Merge(m, src)
+}
+func (m *BulkPolicyOperationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkPolicyOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkPolicyOperationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkPolicyOperationsResponse proto.InternalMessageInfo
+
+func (m *BulkPolicyOperationsResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *BulkPolicyOperationsResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BulkPolicyOperationsResponse) GetResults() []*BulkPolicyResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type GetPolicySourceStatusRequest struct {
+}
+
+func (m *GetPolicySourceStatusRequest) Reset()         { *m = GetPolicySourceStatusRequest{} }
+func (m *GetPolicySourceStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPolicySourceStatusRequest) ProtoMessage()    {}
+func (*GetPolicySourceStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{20}
+}
+func (m *GetPolicySourceStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPolicySourceStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPolicySourceStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPolicySourceStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPolicySourceStatusRequest.Merge(m, src)
+}
+func (m *GetPolicySourceStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPolicySourceStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPolicySourceStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPolicySourceStatusRequest proto.InternalMessageInfo
+
+type SyncPolicySourceRequest struct {
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *SyncPolicySourceRequest) Reset()         { *m = SyncPolicySourceRequest{} }
+func (m *SyncPolicySourceRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncPolicySourceRequest) ProtoMessage()    {}
+func (*SyncPolicySourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{21}
+}
+func (m *SyncPolicySourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPolicySourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncPolicySourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncPolicySourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPolicySourceRequest.Merge(m, src)
+}
+func (m *SyncPolicySourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPolicySourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPolicySourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPolicySourceRequest proto.InternalMessageInfo
+
+func (m *SyncPolicySourceRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type PolicySourceChange struct {
+	Action         string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	PolicyTypeId   string `protobuf:"bytes,2,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,3,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	File           string `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	Applied        bool   `protobuf:"varint,5,opt,name=applied,proto3" json:"applied,omitempty"`
+	Reason         string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PolicySourceChange) Reset()         { *m = PolicySourceChange{} }
+func (m *PolicySourceChange) String() string { return proto.CompactTextString(m) }
+func (*PolicySourceChange) ProtoMessage()    {}
+func (*PolicySourceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{22}
+}
+func (m *PolicySourceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicySourceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicySourceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicySourceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicySourceChange.Merge(m, src)
+}
+func (m *PolicySourceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicySourceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicySourceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicySourceChange proto.InternalMessageInfo
+
+func (m *PolicySourceChange) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PolicySourceChange) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicySourceChange) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PolicySourceChange) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *PolicySourceChange) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+func (m *PolicySourceChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type PolicySourceConflict struct {
+	PolicyTypeId   string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	File           string `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+	Owner          string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PolicySourceConflict) Reset()         { *m = PolicySourceConflict{} }
+func (m *PolicySourceConflict) String() string { return proto.CompactTextString(m) }
+func (*PolicySourceConflict) ProtoMessage()    {}
+func (*PolicySourceConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{23}
+}
+func (m *PolicySourceConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicySourceConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicySourceConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicySourceConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicySourceConflict.Merge(m, src)
+}
+func (m *PolicySourceConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicySourceConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicySourceConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicySourceConflict proto.InternalMessageInfo
+
+func (m *PolicySourceConflict) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicySourceConflict) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PolicySourceConflict) GetFile() string {
+	if m != nil {
+		return m.File
+	}
+	return ""
+}
+
+func (m *PolicySourceConflict) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PolicySourceConflict) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type PolicySourceDrift struct {
+	PolicyTypeId   string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	Preexisting    bool   `protobuf:"varint,3,opt,name=preexisting,proto3" json:"preexisting,omitempty"`
+	Corrected      bool   `protobuf:"varint,4,opt,name=corrected,proto3" json:"corrected,omitempty"`
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PolicySourceDrift) Reset()         { *m = PolicySourceDrift{} }
+func (m *PolicySourceDrift) String() string { return proto.CompactTextString(m) }
+func (*PolicySourceDrift) ProtoMessage()    {}
+func (*PolicySourceDrift) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{24}
+}
+func (m *PolicySourceDrift) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicySourceDrift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicySourceDrift.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicySourceDrift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicySourceDrift.Merge(m, src)
+}
+func (m *PolicySourceDrift) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicySourceDrift) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicySourceDrift.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicySourceDrift proto.InternalMessageInfo
+
+func (m *PolicySourceDrift) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicySourceDrift) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PolicySourceDrift) GetPreexisting() bool {
+	if m != nil {
+		return m.Preexisting
+	}
+	return false
+}
+
+func (m *PolicySourceDrift) GetCorrected() bool {
+	if m != nil {
+		return m.Corrected
+	}
+	return false
+}
+
+func (m *PolicySourceDrift) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type PolicySourceStatus struct {
+	Timestamp time.Time               `protobuf:"bytes,1,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Digest    string                  `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	DryRun    bool                    `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Manifests int32                   `protobuf:"varint,4,opt,name=manifests,proto3" json:"manifests,omitempty"`
+	Changes   []*PolicySourceChange   `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	Conflicts []*PolicySourceConflict `protobuf:"bytes,6,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Drift     []*PolicySourceDrift    `protobuf:"bytes,7,rep,name=drift,proto3" json:"drift,omitempty"`
+	Errors    []string                `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *PolicySourceStatus) Reset()         { *m = PolicySourceStatus{} }
+func (m *PolicySourceStatus) String() string { return proto.CompactTextString(m) }
+func (*PolicySourceStatus) ProtoMessage()    {}
+func (*PolicySourceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{25}
+}
+func (m *PolicySourceStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicySourceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicySourceStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicySourceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicySourceStatus.Merge(m, src)
+}
+func (m *PolicySourceStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicySourceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicySourceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicySourceStatus proto.InternalMessageInfo
+
+func (m *PolicySourceStatus) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *PolicySourceStatus) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *PolicySourceStatus) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PolicySourceStatus) GetManifests() int32 {
+	if m != nil {
+		return m.Manifests
+	}
+	return 0
+}
+
+func (m *PolicySourceStatus) GetChanges() []*PolicySourceChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *PolicySourceStatus) GetConflicts() []*PolicySourceConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+func (m *PolicySourceStatus) GetDrift() []*PolicySourceDrift {
+	if m != nil {
+		return m.Drift
+	}
+	return nil
+}
+
+func (m *PolicySourceStatus) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type ListPolicyOwnersRequest struct {
+	PolicyTypeId string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	Owner        string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *ListPolicyOwnersRequest) Reset()         { *m = ListPolicyOwnersRequest{} }
+func (m *ListPolicyOwnersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyOwnersRequest) ProtoMessage()    {}
+func (*ListPolicyOwnersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{26}
+}
+func (m *ListPolicyOwnersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPolicyOwnersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPolicyOwnersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPolicyOwnersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyOwnersRequest.Merge(m, src)
+}
+func (m *ListPolicyOwnersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPolicyOwnersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyOwnersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyOwnersRequest proto.InternalMessageInfo
+
+func (m *ListPolicyOwnersRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *ListPolicyOwnersRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type PolicyOwner struct {
+	PolicyTypeId   string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	Owner          string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Revision       uint64 `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *PolicyOwner) Reset()         { *m = PolicyOwner{} }
+func (m *PolicyOwner) String() string { return proto.CompactTextString(m) }
+func (*PolicyOwner) ProtoMessage()    {}
+func (*PolicyOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{27}
+}
+func (m *PolicyOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicyOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyOwner.Merge(m, src)
+}
+func (m *PolicyOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyOwner proto.InternalMessageInfo
+
+func (m *PolicyOwner) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicyOwner) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PolicyOwner) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PolicyOwner) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type ListPolicyOwnersResponse struct {
+	Policies []*PolicyOwner `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (m *ListPolicyOwnersResponse) Reset()         { *m = ListPolicyOwnersResponse{} }
+func (m *ListPolicyOwnersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyOwnersResponse) ProtoMessage()    {}
+func (*ListPolicyOwnersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{28}
+}
+func (m *ListPolicyOwnersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPolicyOwnersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPolicyOwnersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPolicyOwnersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyOwnersResponse.Merge(m, src)
+}
+func (m *ListPolicyOwnersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPolicyOwnersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyOwnersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyOwnersResponse proto.InternalMessageInfo
+
+func (m *ListPolicyOwnersResponse) GetPolicies() []*PolicyOwner {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type TransferPolicyOwnershipRequest struct {
+	PolicyTypeId   string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	// owner is the new owner; empty makes the policy unowned
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *TransferPolicyOwnershipRequest) Reset()         { *m = TransferPolicyOwnershipRequest{} }
+func (m *TransferPolicyOwnershipRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferPolicyOwnershipRequest) ProtoMessage()    {}
+func (*TransferPolicyOwnershipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{29}
+}
+func (m *TransferPolicyOwnershipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferPolicyOwnershipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferPolicyOwnershipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferPolicyOwnershipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPolicyOwnershipRequest.Merge(m, src)
+}
+func (m *TransferPolicyOwnershipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferPolicyOwnershipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPolicyOwnershipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPolicyOwnershipRequest proto.InternalMessageInfo
+
+func (m *TransferPolicyOwnershipRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *TransferPolicyOwnershipRequest) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *TransferPolicyOwnershipRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type TransferPolicyOwnershipResponse struct {
+	Policy *PolicyOwner `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (m *TransferPolicyOwnershipResponse) Reset()         { *m = TransferPolicyOwnershipResponse{} }
+func (m *TransferPolicyOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferPolicyOwnershipResponse) ProtoMessage()    {}
+func (*TransferPolicyOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{30}
+}
+func (m *TransferPolicyOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferPolicyOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferPolicyOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferPolicyOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPolicyOwnershipResponse.Merge(m, src)
+}
+func (m *TransferPolicyOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferPolicyOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPolicyOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPolicyOwnershipResponse proto.InternalMessageInfo
+
+func (m *TransferPolicyOwnershipResponse) GetPolicy() *PolicyOwner {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type ListPolicySchedulesRequest struct {
+	PolicyTypeId string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+}
+
+func (m *ListPolicySchedulesRequest) Reset()         { *m = ListPolicySchedulesRequest{} }
+func (m *ListPolicySchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPolicySchedulesRequest) ProtoMessage()    {}
+func (*ListPolicySchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{31}
+}
+func (m *ListPolicySchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPolicySchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPolicySchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPolicySchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicySchedulesRequest.Merge(m, src)
+}
+func (m *ListPolicySchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPolicySchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicySchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicySchedulesRequest proto.InternalMessageInfo
+
+func (m *ListPolicySchedulesRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+type PolicySchedule struct {
+	PolicyTypeId    string    `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId  string    `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	Owner           string    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	ValidFrom       time.Time `protobuf:"bytes,4,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from"`
+	ValidUntil      time.Time `protobuf:"bytes,5,opt,name=valid_until,json=validUntil,proto3,stdtime" json:"valid_until"`
+	Recurrence      string    `protobuf:"bytes,6,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	RecurrenceUntil time.Time `protobuf:"bytes,7,opt,name=recurrence_until,json=recurrenceUntil,proto3,stdtime" json:"recurrence_until"`
+	// deployed is whether the xApps currently hold the policy
+	Deployed       bool      `protobuf:"varint,8,opt,name=deployed,proto3" json:"deployed,omitempty"`
+	NextTransition time.Time `protobuf:"bytes,9,opt,name=next_transition,json=nextTransition,proto3,stdtime" json:"next_transition"`
+}
+
+func (m *PolicySchedule) Reset()         { *m = PolicySchedule{} }
+func (m *PolicySchedule) String() string { return proto.CompactTextString(m) }
+func (*PolicySchedule) ProtoMessage()    {}
+func (*PolicySchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{32}
+}
+func (m *PolicySchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicySchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicySchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicySchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicySchedule.Merge(m, src)
+}
+func (m *PolicySchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicySchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicySchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicySchedule proto.InternalMessageInfo
+
+func (m *PolicySchedule) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicySchedule) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PolicySchedule) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PolicySchedule) GetValidFrom() time.Time {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return time.Time{}
+}
+
+func (m *PolicySchedule) GetValidUntil() time.Time {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return time.Time{}
+}
+
+func (m *PolicySchedule) GetRecurrence() string {
+	if m != nil {
+		return m.Recurrence
+	}
+	return ""
+}
+
+func (m *PolicySchedule) GetRecurrenceUntil() time.Time {
+	if m != nil {
+		return m.RecurrenceUntil
+	}
+	return time.Time{}
+}
+
+func (m *PolicySchedule) GetDeployed() bool {
+	if m != nil {
+		return m.Deployed
+	}
+	return false
+}
+
+func (m *PolicySchedule) GetNextTransition() time.Time {
+	if m != nil {
+		return m.NextTransition
+	}
+	return time.Time{}
+}
+
+type ListPolicySchedulesResponse struct {
+	Policies []*PolicySchedule `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (m *ListPolicySchedulesResponse) Reset()         { *m = ListPolicySchedulesResponse{} }
+func (m *ListPolicySchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPolicySchedulesResponse) ProtoMessage()    {}
+func (*ListPolicySchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{33}
+}
+func (m *ListPolicySchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPolicySchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPolicySchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPolicySchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicySchedulesResponse.Merge(m, src)
+}
+func (m *ListPolicySchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPolicySchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicySchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicySchedulesResponse proto.InternalMessageInfo
+
+func (m *ListPolicySchedulesResponse) GetPolicies() []*PolicySchedule {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type ListEITypesRequest struct {
+}
+
+func (m *ListEITypesRequest) Reset()         { *m = ListEITypesRequest{} }
+func (m *ListEITypesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEITypesRequest) ProtoMessage()    {}
+func (*ListEITypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{34}
+}
+func (m *ListEITypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListEITypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListEITypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListEITypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEITypesRequest.Merge(m, src)
+}
+func (m *ListEITypesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListEITypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEITypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEITypesRequest proto.InternalMessageInfo
+
+type EIType struct {
+	EiTypeId string `protobuf:"bytes,1,opt,name=ei_type_id,json=eiTypeId,proto3" json:"ei_type_id,omitempty"`
+	// xapp_ids are the xApps which declared they consume the EI type
+	XappIds []string `protobuf:"bytes,2,rep,name=xapp_ids,json=xappIds,proto3" json:"xapp_ids,omitempty"`
+	Jobs    int32    `protobuf:"varint,3,opt,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (m *EIType) Reset()         { *m = EIType{} }
+func (m *EIType) String() string { return proto.CompactTextString(m) }
+func (*EIType) ProtoMessage()    {}
+func (*EIType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{35}
+}
+func (m *EIType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EIType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EIType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EIType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EIType.Merge(m, src)
+}
+func (m *EIType) XXX_Size() int {
+	return m.Size()
+}
+func (m *EIType) XXX_DiscardUnknown() {
+	xxx_messageInfo_EIType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EIType proto.InternalMessageInfo
+
+func (m *EIType) GetEiTypeId() string {
+	if m != nil {
+		return m.EiTypeId
+	}
+	return ""
+}
+
+func (m *EIType) GetXappIds() []string {
+	if m != nil {
+		return m.XappIds
+	}
+	return nil
+}
+
+func (m *EIType) GetJobs() int32 {
+	if m != nil {
+		return m.Jobs
+	}
+	return 0
+}
+
+type ListEITypesResponse struct {
+	EiTypes []*EIType `protobuf:"bytes,1,rep,name=ei_types,json=eiTypes,proto3" json:"ei_types,omitempty"`
+}
+
+func (m *ListEITypesResponse) Reset()         { *m = ListEITypesResponse{} }
+func (m *ListEITypesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEITypesResponse) ProtoMessage()    {}
+func (*ListEITypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{36}
+}
+func (m *ListEITypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListEITypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListEITypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListEITypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEITypesResponse.Merge(m, src)
+}
+func (m *ListEITypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListEITypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEITypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEITypesResponse proto.InternalMessageInfo
+
+func (m *ListEITypesResponse) GetEiTypes() []*EIType {
+	if m != nil {
+		return m.EiTypes
+	}
+	return nil
+}
+
+type ListEIJobsRequest struct {
+	EiTypeId string `protobuf:"bytes,1,opt,name=ei_type_id,json=eiTypeId,proto3" json:"ei_type_id,omitempty"`
+	// xapp_id matches the jobs owned or consumed by the xApp
+	XappId string `protobuf:"bytes,2,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+}
+
+func (m *ListEIJobsRequest) Reset()         { *m = ListEIJobsRequest{} }
+func (m *ListEIJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEIJobsRequest) ProtoMessage()    {}
+func (*ListEIJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{37}
+}
+func (m *ListEIJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListEIJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListEIJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListEIJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEIJobsRequest.Merge(m, src)
+}
+func (m *ListEIJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListEIJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEIJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEIJobsRequest proto.InternalMessageInfo
+
+func (m *ListEIJobsRequest) GetEiTypeId() string {
+	if m != nil {
+		return m.EiTypeId
+	}
+	return ""
+}
+
+func (m *ListEIJobsRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+type EIJob struct {
+	EiJobId             string    `protobuf:"bytes,1,opt,name=ei_job_id,json=eiJobId,proto3" json:"ei_job_id,omitempty"`
+	EiTypeId            string    `protobuf:"bytes,2,opt,name=ei_type_id,json=eiTypeId,proto3" json:"ei_type_id,omitempty"`
+	Owner               string    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConsumerXappIds     []string  `protobuf:"bytes,4,rep,name=consumer_xapp_ids,json=consumerXappIds,proto3" json:"consumer_xapp_ids,omitempty"`
+	JobDefinition       string    `protobuf:"bytes,5,opt,name=job_definition,json=jobDefinition,proto3" json:"job_definition,omitempty"`
+	JobResultUri        string    `protobuf:"bytes,6,opt,name=job_result_uri,json=jobResultUri,proto3" json:"job_result_uri,omitempty"`
+	Status              string    `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Orphaned            bool      `protobuf:"varint,8,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	Timestamp           time.Time `protobuf:"bytes,9,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	LastResult          string    `protobuf:"bytes,10,opt,name=last_result,json=lastResult,proto3" json:"last_result,omitempty"`
+	LastResultTimestamp time.Time `protobuf:"bytes,11,opt,name=last_result_timestamp,json=lastResultTimestamp,proto3,stdtime" json:"last_result_timestamp"`
+	LastDeliveryError   string    `protobuf:"bytes,12,opt,name=last_delivery_error,json=lastDeliveryError,proto3" json:"last_delivery_error,omitempty"`
+}
+
+func (m *EIJob) Reset()         { *m = EIJob{} }
+func (m *EIJob) String() string { return proto.CompactTextString(m) }
+func (*EIJob) ProtoMessage()    {}
+func (*EIJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{38}
+}
+func (m *EIJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EIJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EIJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EIJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EIJob.Merge(m, src)
+}
+func (m *EIJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *EIJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_EIJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EIJob proto.InternalMessageInfo
+
+func (m *EIJob) GetEiJobId() string {
+	if m != nil {
+		return m.EiJobId
+	}
+	return ""
+}
+
+func (m *EIJob) GetEiTypeId() string {
+	if m != nil {
+		return m.EiTypeId
+	}
+	return ""
+}
+
+func (m *EIJob) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EIJob) GetConsumerXappIds() []string {
+	if m != nil {
+		return m.ConsumerXappIds
+	}
+	return nil
+}
+
+func (m *EIJob) GetJobDefinition() string {
+	if m != nil {
+		return m.JobDefinition
+	}
+	return ""
+}
+
+func (m *EIJob) GetJobResultUri() string {
+	if m != nil {
+		return m.JobResultUri
+	}
+	return ""
+}
+
+func (m *EIJob) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *EIJob) GetOrphaned() bool {
+	if m != nil {
+		return m.Orphaned
+	}
+	return false
+}
+
+func (m *EIJob) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *EIJob) GetLastResult() string {
+	if m != nil {
+		return m.LastResult
+	}
+	return ""
+}
+
+func (m *EIJob) GetLastResultTimestamp() time.Time {
+	if m != nil {
+		return m.LastResultTimestamp
+	}
+	return time.Time{}
+}
+
+func (m *EIJob) GetLastDeliveryError() string {
+	if m != nil {
+		return m.LastDeliveryError
+	}
+	return ""
+}
+
+type ListEIJobsResponse struct {
+	Jobs []*EIJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (m *ListEIJobsResponse) Reset()         { *m = ListEIJobsResponse{} }
+func (m *ListEIJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEIJobsResponse) ProtoMessage()    {}
+func (*ListEIJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{39}
+}
+func (m *ListEIJobsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListEIJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListEIJobsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListEIJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEIJobsResponse.Merge(m, src)
+}
+func (m *ListEIJobsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListEIJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEIJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEIJobsResponse proto.InternalMessageInfo
+
+func (m *ListEIJobsResponse) GetJobs() []*EIJob {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+type GetEIJobRequest struct {
+	EiJobId string `protobuf:"bytes,1,opt,name=ei_job_id,json=eiJobId,proto3" json:"ei_job_id,omitempty"`
+}
+
+func (m *GetEIJobRequest) Reset()         { *m = GetEIJobRequest{} }
+func (m *GetEIJobRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEIJobRequest) ProtoMessage()    {}
+func (*GetEIJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{40}
+}
+func (m *GetEIJobRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEIJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEIJobRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEIJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEIJobRequest.Merge(m, src)
+}
+func (m *GetEIJobRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEIJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEIJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEIJobRequest proto.InternalMessageInfo
+
+func (m *GetEIJobRequest) GetEiJobId() string {
+	if m != nil {
+		return m.EiJobId
+	}
+	return ""
+}
+
+type GetEIJobResponse struct {
+	Job *EIJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (m *GetEIJobResponse) Reset()         { *m = GetEIJobResponse{} }
+func (m *GetEIJobResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEIJobResponse) ProtoMessage()    {}
+func (*GetEIJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{41}
+}
+func (m *GetEIJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEIJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEIJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEIJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEIJobResponse.Merge(m, src)
+}
+func (m *GetEIJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEIJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEIJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEIJobResponse proto.InternalMessageInfo
+
+func (m *GetEIJobResponse) GetJob() *EIJob {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type ForceDeleteEIJobRequest struct {
+	EiJobId string `protobuf:"bytes,1,opt,name=ei_job_id,json=eiJobId,proto3" json:"ei_job_id,omitempty"`
+}
+
+func (m *ForceDeleteEIJobRequest) Reset()         { *m = ForceDeleteEIJobRequest{} }
+func (m *ForceDeleteEIJobRequest) String() string { return proto.CompactTextString(m) }
+func (*ForceDeleteEIJobRequest) ProtoMessage()    {}
+func (*ForceDeleteEIJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{42}
+}
+func (m *ForceDeleteEIJobRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForceDeleteEIJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForceDeleteEIJobRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForceDeleteEIJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceDeleteEIJobRequest.Merge(m, src)
+}
+func (m *ForceDeleteEIJobRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForceDeleteEIJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceDeleteEIJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceDeleteEIJobRequest proto.InternalMessageInfo
+
+func (m *ForceDeleteEIJobRequest) GetEiJobId() string {
+	if m != nil {
+		return m.EiJobId
+	}
+	return ""
+}
+
+type ForceDeleteEIJobResponse struct {
+	EiJobId string `protobuf:"bytes,1,opt,name=ei_job_id,json=eiJobId,proto3" json:"ei_job_id,omitempty"`
+	// remote_deleted is whether the Non-RT RIC deleted the job as well
+	RemoteDeleted bool `protobuf:"varint,2,opt,name=remote_deleted,json=remoteDeleted,proto3" json:"remote_deleted,omitempty"`
+}
+
+func (m *ForceDeleteEIJobResponse) Reset()         { *m = ForceDeleteEIJobResponse{} }
+func (m *ForceDeleteEIJobResponse) String() string { return proto.CompactTextString(m) }
+func (*ForceDeleteEIJobResponse) ProtoMessage()    {}
+func (*ForceDeleteEIJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{43}
+}
+func (m *ForceDeleteEIJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForceDeleteEIJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForceDeleteEIJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForceDeleteEIJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceDeleteEIJobResponse.Merge(m, src)
+}
+func (m *ForceDeleteEIJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForceDeleteEIJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceDeleteEIJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceDeleteEIJobResponse proto.InternalMessageInfo
+
+func (m *ForceDeleteEIJobResponse) GetEiJobId() string {
+	if m != nil {
+		return m.EiJobId
+	}
+	return ""
+}
+
+func (m *ForceDeleteEIJobResponse) GetRemoteDeleted() bool {
+	if m != nil {
+		return m.RemoteDeleted
+	}
+	return false
+}
+
+type PutPolicyObjectRequest struct {
+	PolicyTypeId            string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId          string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	PolicyObject            string `protobuf:"bytes,3,opt,name=policy_object,json=policyObject,proto3" json:"policy_object,omitempty"`
+	NotificationDestination string `protobuf:"bytes,4,opt,name=notification_destination,json=notificationDestination,proto3" json:"notification_destination,omitempty"`
+	// if_match and if_none_match are entity tags, as in the If-Match and If-None-Match headers of the REST API
+	IfMatch     []string `protobuf:"bytes,5,rep,name=if_match,json=ifMatch,proto3" json:"if_match,omitempty"`
+	IfNoneMatch []string `protobuf:"bytes,6,rep,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
+}
+
+func (m *PutPolicyObjectRequest) Reset()         { *m = PutPolicyObjectRequest{} }
+func (m *PutPolicyObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*PutPolicyObjectRequest) ProtoMessage()    {}
+func (*PutPolicyObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{44}
+}
+func (m *PutPolicyObjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PutPolicyObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutPolicyObjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PutPolicyObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutPolicyObjectRequest.Merge(m, src)
+}
+func (m *PutPolicyObjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PutPolicyObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutPolicyObjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutPolicyObjectRequest proto.InternalMessageInfo
+
+func (m *PutPolicyObjectRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PutPolicyObjectRequest) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PutPolicyObjectRequest) GetPolicyObject() string {
+	if m != nil {
+		return m.PolicyObject
+	}
+	return ""
+}
+
+func (m *PutPolicyObjectRequest) GetNotificationDestination() string {
+	if m != nil {
+		return m.NotificationDestination
+	}
+	return ""
+}
+
+func (m *PutPolicyObjectRequest) GetIfMatch() []string {
+	if m != nil {
+		return m.IfMatch
+	}
+	return nil
+}
+
+func (m *PutPolicyObjectRequest) GetIfNoneMatch() []string {
+	if m != nil {
+		return m.IfNoneMatch
+	}
+	return nil
+}
+
+type PutPolicyObjectResponse struct {
+	Created bool   `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Etag    string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (m *PutPolicyObjectResponse) Reset()         { *m = PutPolicyObjectResponse{} }
+func (m *PutPolicyObjectResponse) String() string { return proto.CompactTextString(m) }
+func (*PutPolicyObjectResponse) ProtoMessage()    {}
+func (*PutPolicyObjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{45}
+}
+func (m *PutPolicyObjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PutPolicyObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutPolicyObjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PutPolicyObjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutPolicyObjectResponse.Merge(m, src)
+}
+func (m *PutPolicyObjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PutPolicyObjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutPolicyObjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutPolicyObjectResponse proto.InternalMessageInfo
+
+func (m *PutPolicyObjectResponse) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *PutPolicyObjectResponse) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+type DeletePolicyObjectRequest struct {
+	PolicyTypeId   string   `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string   `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	IfMatch        []string `protobuf:"bytes,3,rep,name=if_match,json=ifMatch,proto3" json:"if_match,omitempty"`
+}
+
+func (m *DeletePolicyObjectRequest) Reset()         { *m = DeletePolicyObjectRequest{} }
+func (m *DeletePolicyObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePolicyObjectRequest) ProtoMessage()    {}
+func (*DeletePolicyObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{46}
+}
+func (m *DeletePolicyObjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePolicyObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePolicyObjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletePolicyObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePolicyObjectRequest.Merge(m, src)
+}
+func (m *DeletePolicyObjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePolicyObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePolicyObjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePolicyObjectRequest proto.InternalMessageInfo
+
+func (m *DeletePolicyObjectRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *DeletePolicyObjectRequest) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *DeletePolicyObjectRequest) GetIfMatch() []string {
+	if m != nil {
+		return m.IfMatch
+	}
+	return nil
+}
+
+type DeletePolicyObjectResponse struct {
+}
+
+func (m *DeletePolicyObjectResponse) Reset()         { *m = DeletePolicyObjectResponse{} }
+func (m *DeletePolicyObjectResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePolicyObjectResponse) ProtoMessage()    {}
+func (*DeletePolicyObjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{47}
+}
+func (m *DeletePolicyObjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePolicyObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePolicyObjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletePolicyObjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePolicyObjectResponse.Merge(m, src)
+}
+func (m *DeletePolicyObjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePolicyObjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePolicyObjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePolicyObjectResponse proto.InternalMessageInfo
+
+type ReconcileXAppRequest struct {
+	XappId string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+}
+
+func (m *ReconcileXAppRequest) Reset()         { *m = ReconcileXAppRequest{} }
+func (m *ReconcileXAppRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileXAppRequest) ProtoMessage()    {}
+func (*ReconcileXAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{48}
+}
+func (m *ReconcileXAppRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileXAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileXAppRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconcileXAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileXAppRequest.Merge(m, src)
+}
+func (m *ReconcileXAppRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileXAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileXAppRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileXAppRequest proto.InternalMessageInfo
+
+func (m *ReconcileXAppRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+type PolicyReconcile struct {
+	PolicyTypeId   string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	// action is PolicySetup or PolicyUpdate
+	Action  string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Success bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Reason  string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PolicyReconcile) Reset()         { *m = PolicyReconcile{} }
+func (m *PolicyReconcile) String() string { return proto.CompactTextString(m) }
+func (*PolicyReconcile) ProtoMessage()    {}
+func (*PolicyReconcile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{49}
+}
+func (m *PolicyReconcile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyReconcile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyReconcile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicyReconcile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyReconcile.Merge(m, src)
+}
+func (m *PolicyReconcile) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyReconcile) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyReconcile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyReconcile proto.InternalMessageInfo
+
+func (m *PolicyReconcile) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicyReconcile) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PolicyReconcile) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PolicyReconcile) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *PolicyReconcile) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ReconcileXAppResponse struct {
+	Policies []*PolicyReconcile `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (m *ReconcileXAppResponse) Reset()         { *m = ReconcileXAppResponse{} }
+func (m *ReconcileXAppResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconcileXAppResponse) ProtoMessage()    {}
+func (*ReconcileXAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{50}
+}
+func (m *ReconcileXAppResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileXAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileXAppResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconcileXAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileXAppResponse.Merge(m, src)
+}
+func (m *ReconcileXAppResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileXAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileXAppResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileXAppResponse proto.InternalMessageInfo
+
+func (m *ReconcileXAppResponse) GetPolicies() []*PolicyReconcile {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type DisconnectXAppRequest struct {
+	XappId string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+}
+
+func (m *DisconnectXAppRequest) Reset()         { *m = DisconnectXAppRequest{} }
+func (m *DisconnectXAppRequest) String() string { return proto.CompactTextString(m) }
+func (*DisconnectXAppRequest) ProtoMessage()    {}
+func (*DisconnectXAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{51}
+}
+func (m *DisconnectXAppRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisconnectXAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisconnectXAppRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisconnectXAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectXAppRequest.Merge(m, src)
+}
+func (m *DisconnectXAppRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisconnectXAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectXAppRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisconnectXAppRequest proto.InternalMessageInfo
+
+func (m *DisconnectXAppRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+type DisconnectXAppResponse struct {
+}
+
+func (m *DisconnectXAppResponse) Reset()         { *m = DisconnectXAppResponse{} }
+func (m *DisconnectXAppResponse) String() string { return proto.CompactTextString(m) }
+func (*DisconnectXAppResponse) ProtoMessage()    {}
+func (*DisconnectXAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{52}
+}
+func (m *DisconnectXAppResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisconnectXAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisconnectXAppResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisconnectXAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectXAppResponse.Merge(m, src)
+}
+func (m *DisconnectXAppResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisconnectXAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectXAppResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisconnectXAppResponse proto.InternalMessageInfo
+
+type ReconnectXAppRequest struct {
+	XappId string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+}
+
+func (m *ReconnectXAppRequest) Reset()         { *m = ReconnectXAppRequest{} }
+func (m *ReconnectXAppRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconnectXAppRequest) ProtoMessage()    {}
+func (*ReconnectXAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{53}
+}
+func (m *ReconnectXAppRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconnectXAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconnectXAppRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconnectXAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconnectXAppRequest.Merge(m, src)
+}
+func (m *ReconnectXAppRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconnectXAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconnectXAppRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconnectXAppRequest proto.InternalMessageInfo
+
+func (m *ReconnectXAppRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+type ReconnectXAppResponse struct {
+}
+
+func (m *ReconnectXAppResponse) Reset()         { *m = ReconnectXAppResponse{} }
+func (m *ReconnectXAppResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconnectXAppResponse) ProtoMessage()    {}
+func (*ReconnectXAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{54}
+}
+func (m *ReconnectXAppResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconnectXAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconnectXAppResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconnectXAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconnectXAppResponse.Merge(m, src)
+}
+func (m *ReconnectXAppResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconnectXAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconnectXAppResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconnectXAppResponse proto.InternalMessageInfo
+
+type DrainXAppRequest struct {
+	XappId string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	// drained false resumes routing new policies to the xApp
+	Drained bool `protobuf:"varint,2,opt,name=drained,proto3" json:"drained,omitempty"`
+}
+
+func (m *DrainXAppRequest) Reset()         { *m = DrainXAppRequest{} }
+func (m *DrainXAppRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainXAppRequest) ProtoMessage()    {}
+func (*DrainXAppRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{55}
+}
+func (m *DrainXAppRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainXAppRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainXAppRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainXAppRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainXAppRequest.Merge(m, src)
+}
+func (m *DrainXAppRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainXAppRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainXAppRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainXAppRequest proto.InternalMessageInfo
+
+func (m *DrainXAppRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *DrainXAppRequest) GetDrained() bool {
+	if m != nil {
+		return m.Drained
+	}
+	return false
+}
+
+type DrainXAppResponse struct {
+	DrainedXappIds []string `protobuf:"bytes,1,rep,name=drained_xapp_ids,json=drainedXappIds,proto3" json:"drained_xapp_ids,omitempty"`
+}
+
+func (m *DrainXAppResponse) Reset()         { *m = DrainXAppResponse{} }
+func (m *DrainXAppResponse) String() string { return proto.CompactTextString(m) }
+func (*DrainXAppResponse) ProtoMessage()    {}
+func (*DrainXAppResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{56}
+}
+func (m *DrainXAppResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainXAppResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainXAppResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainXAppResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainXAppResponse.Merge(m, src)
+}
+func (m *DrainXAppResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainXAppResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainXAppResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainXAppResponse proto.InternalMessageInfo
+
+func (m *DrainXAppResponse) GetDrainedXappIds() []string {
+	if m != nil {
+		return m.DrainedXappIds
+	}
+	return nil
+}
+
+// XAppQueryResult is the answer of one xApp to a policy query
+type XAppQueryResult struct {
+	XappId  string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Success bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Reason  string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// cached is set if the payload is the last status the xApp pushed rather than the answer to a query
+	Cached bool `protobuf:"varint,5,opt,name=cached,proto3" json:"cached,omitempty"`
+}
+
+func (m *XAppQueryResult) Reset()         { *m = XAppQueryResult{} }
+func (m *XAppQueryResult) String() string { return proto.CompactTextString(m) }
+func (*XAppQueryResult) ProtoMessage()    {}
+func (*XAppQueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{57}
+}
+func (m *XAppQueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XAppQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XAppQueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *XAppQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XAppQueryResult.Merge(m, src)
+}
+func (m *XAppQueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *XAppQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_XAppQueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XAppQueryResult proto.InternalMessageInfo
+
+func (m *XAppQueryResult) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *XAppQueryResult) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *XAppQueryResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *XAppQueryResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *XAppQueryResult) GetCached() bool {
+	if m != nil {
+		return m.Cached
+	}
+	return false
+}
+
+type ListPolicyTypeObjectsRequest struct {
+	PolicyTypeId string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	// page_size is the maximum number of entries returned; 0 means the default page size
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (m *ListPolicyTypeObjectsRequest) Reset()         { *m = ListPolicyTypeObjectsRequest{} }
+func (m *ListPolicyTypeObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyTypeObjectsRequest) ProtoMessage()    {}
+func (*ListPolicyTypeObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{58}
+}
+func (m *ListPolicyTypeObjectsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPolicyTypeObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPolicyTypeObjectsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPolicyTypeObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyTypeObjectsRequest.Merge(m, src)
+}
+func (m *ListPolicyTypeObjectsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPolicyTypeObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyTypeObjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyTypeObjectsRequest proto.InternalMessageInfo
+
+func (m *ListPolicyTypeObjectsRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *ListPolicyTypeObjectsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListPolicyTypeObjectsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type PolicyTypeObject struct {
+	PolicyTypeId     string   `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyIds        []string `protobuf:"bytes,2,rep,name=policy_ids,json=policyIds,proto3" json:"policy_ids,omitempty"`
+	PolicyTypeObject string   `protobuf:"bytes,3,opt,name=policy_type_object,json=policyTypeObject,proto3" json:"policy_type_object,omitempty"`
+	// xapp_results are the policy IDs each xApp holds
+	XappResults []*XAppQueryResult `protobuf:"bytes,4,rep,name=xapp_results,json=xappResults,proto3" json:"xapp_results,omitempty"`
+	// error is set if the entry is incomplete
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PolicyTypeObject) Reset()         { *m = PolicyTypeObject{} }
+func (m *PolicyTypeObject) String() string { return proto.CompactTextString(m) }
+func (*PolicyTypeObject) ProtoMessage()    {}
+func (*PolicyTypeObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{59}
+}
+func (m *PolicyTypeObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyTypeObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyTypeObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicyTypeObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyTypeObject.Merge(m, src)
+}
+func (m *PolicyTypeObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyTypeObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyTypeObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyTypeObject proto.InternalMessageInfo
+
+func (m *PolicyTypeObject) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicyTypeObject) GetPolicyIds() []string {
+	if m != nil {
+		return m.PolicyIds
+	}
+	return nil
+}
+
+func (m *PolicyTypeObject) GetPolicyTypeObject() string {
+	if m != nil {
+		return m.PolicyTypeObject
+	}
+	return ""
+}
+
+func (m *PolicyTypeObject) GetXappResults() []*XAppQueryResult {
+	if m != nil {
+		return m.XappResults
+	}
+	return nil
+}
+
+func (m *PolicyTypeObject) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListPolicyTypeObjectsResponse struct {
+	PolicyTypes   []*PolicyTypeObject `protobuf:"bytes,1,rep,name=policy_types,json=policyTypes,proto3" json:"policy_types,omitempty"`
+	NextPageToken string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListPolicyTypeObjectsResponse) Reset()         { *m = ListPolicyTypeObjectsResponse{} }
+func (m *ListPolicyTypeObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyTypeObjectsResponse) ProtoMessage()    {}
+func (*ListPolicyTypeObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{60}
+}
+func (m *ListPolicyTypeObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPolicyTypeObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPolicyTypeObjectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPolicyTypeObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyTypeObjectsResponse.Merge(m, src)
+}
+func (m *ListPolicyTypeObjectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPolicyTypeObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyTypeObjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyTypeObjectsResponse proto.InternalMessageInfo
+
+func (m *ListPolicyTypeObjectsResponse) GetPolicyTypes() []*PolicyTypeObject {
+	if m != nil {
+		return m.PolicyTypes
+	}
+	return nil
+}
+
+func (m *ListPolicyTypeObjectsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ListPolicyObjectsRequest struct {
+	PolicyTypeId   string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	PageSize       int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (m *ListPolicyObjectsRequest) Reset()         { *m = ListPolicyObjectsRequest{} }
+func (m *ListPolicyObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyObjectsRequest) ProtoMessage()    {}
+func (*ListPolicyObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{61}
+}
+func (m *ListPolicyObjectsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPolicyObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPolicyObjectsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPolicyObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyObjectsRequest.Merge(m, src)
+}
+func (m *ListPolicyObjectsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPolicyObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyObjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyObjectsRequest proto.InternalMessageInfo
+
+func (m *ListPolicyObjectsRequest) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *ListPolicyObjectsRequest) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *ListPolicyObjectsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListPolicyObjectsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type PolicyObject struct {
+	PolicyTypeId   string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	// policy_object is the policy object the xApps agree on, or the policy status aggregated across the xApps
+	PolicyObject string             `protobuf:"bytes,3,opt,name=policy_object,json=policyObject,proto3" json:"policy_object,omitempty"`
+	XappResults  []*XAppQueryResult `protobuf:"bytes,4,rep,name=xapp_results,json=xappResults,proto3" json:"xapp_results,omitempty"`
+	Error        string             `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PolicyObject) Reset()         { *m = PolicyObject{} }
+func (m *PolicyObject) String() string { return proto.CompactTextString(m) }
+func (*PolicyObject) ProtoMessage()    {}
+func (*PolicyObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{62}
+}
+func (m *PolicyObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicyObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyObject.Merge(m, src)
+}
+func (m *PolicyObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyObject proto.InternalMessageInfo
+
+func (m *PolicyObject) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicyObject) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PolicyObject) GetPolicyObject() string {
+	if m != nil {
+		return m.PolicyObject
+	}
+	return ""
+}
+
+func (m *PolicyObject) GetXappResults() []*XAppQueryResult {
+	if m != nil {
+		return m.XappResults
+	}
+	return nil
+}
+
+func (m *PolicyObject) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListPolicyObjectsResponse struct {
+	Policies      []*PolicyObject `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListPolicyObjectsResponse) Reset()         { *m = ListPolicyObjectsResponse{} }
+func (m *ListPolicyObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyObjectsResponse) ProtoMessage()    {}
+func (*ListPolicyObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{63}
+}
+func (m *ListPolicyObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPolicyObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPolicyObjectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPolicyObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyObjectsResponse.Merge(m, src)
+}
+func (m *ListPolicyObjectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPolicyObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyObjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyObjectsResponse proto.InternalMessageInfo
+
+func (m *ListPolicyObjectsResponse) GetPolicies() []*PolicyObject {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *ListPolicyObjectsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type GetPolicyInventoryRequest struct {
+	XappId string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	// rescan scans the xApps before answering instead of returning the last scan
+	Rescan bool `protobuf:"varint,2,opt,name=rescan,proto3" json:"rescan,omitempty"`
+}
+
+func (m *GetPolicyInventoryRequest) Reset()         { *m = GetPolicyInventoryRequest{} }
+func (m *GetPolicyInventoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPolicyInventoryRequest) ProtoMessage()    {}
+func (*GetPolicyInventoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{64}
+}
+func (m *GetPolicyInventoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPolicyInventoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPolicyInventoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPolicyInventoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPolicyInventoryRequest.Merge(m, src)
+}
+func (m *GetPolicyInventoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPolicyInventoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPolicyInventoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPolicyInventoryRequest proto.InternalMessageInfo
+
+func (m *GetPolicyInventoryRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *GetPolicyInventoryRequest) GetRescan() bool {
+	if m != nil {
+		return m.Rescan
+	}
+	return false
+}
+
+type XAppPolicy struct {
+	PolicyTypeId   string `protobuf:"bytes,1,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,2,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	PolicyObject   string `protobuf:"bytes,3,opt,name=policy_object,json=policyObject,proto3" json:"policy_object,omitempty"`
+}
+
+func (m *XAppPolicy) Reset()         { *m = XAppPolicy{} }
+func (m *XAppPolicy) String() string { return proto.CompactTextString(m) }
+func (*XAppPolicy) ProtoMessage()    {}
+func (*XAppPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{65}
+}
+func (m *XAppPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XAppPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XAppPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *XAppPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XAppPolicy.Merge(m, src)
+}
+func (m *XAppPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *XAppPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_XAppPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XAppPolicy proto.InternalMessageInfo
+
+func (m *XAppPolicy) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *XAppPolicy) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *XAppPolicy) GetPolicyObject() string {
+	if m != nil {
+		return m.PolicyObject
+	}
+	return ""
+}
+
+type XAppPolicyInventory struct {
+	XappId    string        `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	Timestamp time.Time     `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Policies  []*XAppPolicy `protobuf:"bytes,3,rep,name=policies,proto3" json:"policies,omitempty"`
+	// errors are the reasons the policies of some types could not be read, by policy type
+	Errors map[string]string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *XAppPolicyInventory) Reset()         { *m = XAppPolicyInventory{} }
+func (m *XAppPolicyInventory) String() string { return proto.CompactTextString(m) }
+func (*XAppPolicyInventory) ProtoMessage()    {}
+func (*XAppPolicyInventory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{66}
+}
+func (m *XAppPolicyInventory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XAppPolicyInventory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XAppPolicyInventory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *XAppPolicyInventory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XAppPolicyInventory.Merge(m, src)
+}
+func (m *XAppPolicyInventory) XXX_Size() int {
+	return m.Size()
+}
+func (m *XAppPolicyInventory) XXX_DiscardUnknown() {
+	xxx_messageInfo_XAppPolicyInventory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XAppPolicyInventory proto.InternalMessageInfo
+
+func (m *XAppPolicyInventory) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *XAppPolicyInventory) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *XAppPolicyInventory) GetPolicies() []*XAppPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *XAppPolicyInventory) GetErrors() map[string]string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type GetPolicyInventoryResponse struct {
+	Timestamp time.Time              `protobuf:"bytes,1,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Xapps     []*XAppPolicyInventory `protobuf:"bytes,2,rep,name=xapps,proto3" json:"xapps,omitempty"`
+}
+
+func (m *GetPolicyInventoryResponse) Reset()         { *m = GetPolicyInventoryResponse{} }
+func (m *GetPolicyInventoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPolicyInventoryResponse) ProtoMessage()    {}
+func (*GetPolicyInventoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{67}
+}
+func (m *GetPolicyInventoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPolicyInventoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPolicyInventoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPolicyInventoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPolicyInventoryResponse.Merge(m, src)
+}
+func (m *GetPolicyInventoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPolicyInventoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPolicyInventoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPolicyInventoryResponse proto.InternalMessageInfo
+
+func (m *GetPolicyInventoryResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *GetPolicyInventoryResponse) GetXapps() []*XAppPolicyInventory {
+	if m != nil {
+		return m.Xapps
+	}
+	return nil
+}
+
+type GetDivergenceReportRequest struct {
+	XappId string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	// kind is Missing, Stale, Unknown or Unreachable; empty matches every kind
+	Kind   string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Rescan bool   `protobuf:"varint,3,opt,name=rescan,proto3" json:"rescan,omitempty"`
+}
+
+func (m *GetDivergenceReportRequest) Reset()         { *m = GetDivergenceReportRequest{} }
+func (m *GetDivergenceReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDivergenceReportRequest) ProtoMessage()    {}
+func (*GetDivergenceReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{68}
+}
+func (m *GetDivergenceReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDivergenceReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDivergenceReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetDivergenceReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDivergenceReportRequest.Merge(m, src)
+}
+func (m *GetDivergenceReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDivergenceReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDivergenceReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDivergenceReportRequest proto.InternalMessageInfo
+
+func (m *GetDivergenceReportRequest) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *GetDivergenceReportRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *GetDivergenceReportRequest) GetRescan() bool {
+	if m != nil {
+		return m.Rescan
+	}
+	return false
+}
+
+type PolicyDivergence struct {
+	XappId         string `protobuf:"bytes,1,opt,name=xapp_id,json=xappId,proto3" json:"xapp_id,omitempty"`
+	PolicyTypeId   string `protobuf:"bytes,2,opt,name=policy_type_id,json=policyTypeId,proto3" json:"policy_type_id,omitempty"`
+	PolicyObjectId string `protobuf:"bytes,3,opt,name=policy_object_id,json=policyObjectId,proto3" json:"policy_object_id,omitempty"`
+	Kind           string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Repaired       bool   `protobuf:"varint,6,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (m *PolicyDivergence) Reset()         { *m = PolicyDivergence{} }
+func (m *PolicyDivergence) String() string { return proto.CompactTextString(m) }
+func (*PolicyDivergence) ProtoMessage()    {}
+func (*PolicyDivergence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{69}
+}
+func (m *PolicyDivergence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyDivergence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyDivergence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicyDivergence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyDivergence.Merge(m, src)
+}
+func (m *PolicyDivergence) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyDivergence) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyDivergence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyDivergence proto.InternalMessageInfo
+
+func (m *PolicyDivergence) GetXappId() string {
+	if m != nil {
+		return m.XappId
+	}
+	return ""
+}
+
+func (m *PolicyDivergence) GetPolicyTypeId() string {
+	if m != nil {
+		return m.PolicyTypeId
+	}
+	return ""
+}
+
+func (m *PolicyDivergence) GetPolicyObjectId() string {
+	if m != nil {
+		return m.PolicyObjectId
+	}
+	return ""
+}
+
+func (m *PolicyDivergence) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *PolicyDivergence) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PolicyDivergence) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
+	}
+	return false
+}
+
+type GetDivergenceReportResponse struct {
+	Timestamp   time.Time           `protobuf:"bytes,1,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Divergences []*PolicyDivergence `protobuf:"bytes,2,rep,name=divergences,proto3" json:"divergences,omitempty"`
+}
+
+func (m *GetDivergenceReportResponse) Reset()         { *m = GetDivergenceReportResponse{} }
+func (m *GetDivergenceReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDivergenceReportResponse) ProtoMessage()    {}
+func (*GetDivergenceReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec8c8decc5d01b0, []int{70}
+}
+func (m *GetDivergenceReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDivergenceReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDivergenceReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetDivergenceReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDivergenceReportResponse.Merge(m, src)
+}
+func (m *GetDivergenceReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDivergenceReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDivergenceReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDivergenceReportResponse proto.InternalMessageInfo
+
+func (m *GetDivergenceReportResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *GetDivergenceReportResponse) GetDivergences() []*PolicyDivergence {
+	if m != nil {
+		return m.Divergences
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetPolicyHistoryRequest)(nil), "onos.a1t.admin.GetPolicyHistoryRequest")
+	golang_proto.RegisterType((*GetPolicyHistoryRequest)(nil), "onos.a1t.admin.GetPolicyHistoryRequest")
+	proto.RegisterType((*XAppOutcome)(nil), "onos.a1t.admin.XAppOutcome")
+	golang_proto.RegisterType((*XAppOutcome)(nil), "onos.a1t.admin.XAppOutcome")
+	proto.RegisterType((*GetPolicyHistoryResponse)(nil), "onos.a1t.admin.GetPolicyHistoryResponse")
+	golang_proto.RegisterType((*GetPolicyHistoryResponse)(nil), "onos.a1t.admin.GetPolicyHistoryResponse")
+	proto.RegisterType((*RollbackPolicyRequest)(nil), "onos.a1t.admin.RollbackPolicyRequest")
+	golang_proto.RegisterType((*RollbackPolicyRequest)(nil), "onos.a1t.admin.RollbackPolicyRequest")
+	proto.RegisterType((*PolicyRollback)(nil), "onos.a1t.admin.PolicyRollback")
+	golang_proto.RegisterType((*PolicyRollback)(nil), "onos.a1t.admin.PolicyRollback")
+	proto.RegisterType((*RollbackPolicyResponse)(nil), "onos.a1t.admin.RollbackPolicyResponse")
+	golang_proto.RegisterType((*RollbackPolicyResponse)(nil), "onos.a1t.admin.RollbackPolicyResponse")
+	proto.RegisterType((*ListXAppSessionsRequest)(nil), "onos.a1t.admin.ListXAppSessionsRequest")
+	golang_proto.RegisterType((*ListXAppSessionsRequest)(nil), "onos.a1t.admin.ListXAppSessionsRequest")
+	proto.RegisterType((*XAppSession)(nil), "onos.a1t.admin.XAppSession")
+	golang_proto.RegisterType((*XAppSession)(nil), "onos.a1t.admin.XAppSession")
+	proto.RegisterType((*ListXAppSessionsResponse)(nil), "onos.a1t.admin.ListXAppSessionsResponse")
+	golang_proto.RegisterType((*ListXAppSessionsResponse)(nil), "onos.a1t.admin.ListXAppSessionsResponse")
+	proto.RegisterType((*ListCircuitBreakersRequest)(nil), "onos.a1t.admin.ListCircuitBreakersRequest")
+	golang_proto.RegisterType((*ListCircuitBreakersRequest)(nil), "onos.a1t.admin.ListCircuitBreakersRequest")
+	proto.RegisterType((*CircuitBreaker)(nil), "onos.a1t.admin.CircuitBreaker")
+	golang_proto.RegisterType((*CircuitBreaker)(nil), "onos.a1t.admin.CircuitBreaker")
+	proto.RegisterType((*ListCircuitBreakersResponse)(nil), "onos.a1t.admin.ListCircuitBreakersResponse")
+	golang_proto.RegisterType((*ListCircuitBreakersResponse)(nil), "onos.a1t.admin.ListCircuitBreakersResponse")
+	proto.RegisterType((*WatchCircuitBreakersRequest)(nil), "onos.a1t.admin.WatchCircuitBreakersRequest")
+	golang_proto.RegisterType((*WatchCircuitBreakersRequest)(nil), "onos.a1t.admin.WatchCircuitBreakersRequest")
+	proto.RegisterType((*WatchCircuitBreakersResponse)(nil), "onos.a1t.admin.WatchCircuitBreakersResponse")
+	golang_proto.RegisterType((*WatchCircuitBreakersResponse)(nil), "onos.a1t.admin.WatchCircuitBreakersResponse")
+	proto.RegisterType((*WatchPolicyObjectStatusRequest)(nil), "onos.a1t.admin.WatchPolicyObjectStatusRequest")
+	golang_proto.RegisterType((*WatchPolicyObjectStatusRequest)(nil), "onos.a1t.admin.WatchPolicyObjectStatusRequest")
+	proto.RegisterType((*WatchPolicyObjectStatusResponse)(nil), "onos.a1t.admin.WatchPolicyObjectStatusResponse")
+	golang_proto.RegisterType((*WatchPolicyObjectStatusResponse)(nil), "onos.a1t.admin.WatchPolicyObjectStatusResponse")
+	proto.RegisterType((*BulkPolicyOperation)(nil), "onos.a1t.admin.BulkPolicyOperation")
+	golang_proto.RegisterType((*BulkPolicyOperation)(nil), "onos.a1t.admin.BulkPolicyOperation")
+	proto.RegisterType((*BulkPolicyOperationsRequest)(nil), "onos.a1t.admin.BulkPolicyOperationsRequest")
+	golang_proto.RegisterType((*BulkPolicyOperationsRequest)(nil), "onos.a1t.admin.BulkPolicyOperationsRequest")
+	proto.RegisterType((*BulkPolicyResult)(nil), "onos.a1t.admin.BulkPolicyResult")
+	golang_proto.RegisterType((*BulkPolicyResult)(nil), "onos.a1t.admin.BulkPolicyResult")
+	proto.RegisterType((*BulkPolicyOperationsResponse)(nil), "onos.a1t.admin.BulkPolicyOperationsResponse")
+	golang_proto.RegisterType((*BulkPolicyOperationsResponse)(nil), "onos.a1t.admin.BulkPolicyOperationsResponse")
+	proto.RegisterType((*GetPolicySourceStatusRequest)(nil), "onos.a1t.admin.GetPolicySourceStatusRequest")
+	golang_proto.RegisterType((*GetPolicySourceStatusRequest)(nil), "onos.a1t.admin.GetPolicySourceStatusRequest")
+	proto.RegisterType((*SyncPolicySourceRequest)(nil), "onos.a1t.admin.SyncPolicySourceRequest")
+	golang_proto.RegisterType((*SyncPolicySourceRequest)(nil), "onos.a1t.admin.SyncPolicySourceRequest")
+	proto.RegisterType((*PolicySourceChange)(nil), "onos.a1t.admin.PolicySourceChange")
+	golang_proto.RegisterType((*PolicySourceChange)(nil), "onos.a1t.admin.PolicySourceChange")
+	proto.RegisterType((*PolicySourceConflict)(nil), "onos.a1t.admin.PolicySourceConflict")
+	golang_proto.RegisterType((*PolicySourceConflict)(nil), "onos.a1t.admin.PolicySourceConflict")
+	proto.RegisterType((*PolicySourceDrift)(nil), "onos.a1t.admin.PolicySourceDrift")
+	golang_proto.RegisterType((*PolicySourceDrift)(nil), "onos.a1t.admin.PolicySourceDrift")
+	proto.RegisterType((*PolicySourceStatus)(nil), "onos.a1t.admin.PolicySourceStatus")
+	golang_proto.RegisterType((*PolicySourceStatus)(nil), "onos.a1t.admin.PolicySourceStatus")
+	proto.RegisterType((*ListPolicyOwnersRequest)(nil), "onos.a1t.admin.ListPolicyOwnersRequest")
+	golang_proto.RegisterType((*ListPolicyOwnersRequest)(nil), "onos.a1t.admin.ListPolicyOwnersRequest")
+	proto.RegisterType((*PolicyOwner)(nil), "onos.a1t.admin.PolicyOwner")
+	golang_proto.RegisterType((*PolicyOwner)(nil), "onos.a1t.admin.PolicyOwner")
+	proto.RegisterType((*ListPolicyOwnersResponse)(nil), "onos.a1t.admin.ListPolicyOwnersResponse")
+	golang_proto.RegisterType((*ListPolicyOwnersResponse)(nil), "onos.a1t.admin.ListPolicyOwnersResponse")
+	proto.RegisterType((*TransferPolicyOwnershipRequest)(nil), "onos.a1t.admin.TransferPolicyOwnershipRequest")
+	golang_proto.RegisterType((*TransferPolicyOwnershipRequest)(nil), "onos.a1t.admin.TransferPolicyOwnershipRequest")
+	proto.RegisterType((*TransferPolicyOwnershipResponse)(nil), "onos.a1t.admin.TransferPolicyOwnershipResponse")
+	golang_proto.RegisterType((*TransferPolicyOwnershipResponse)(nil), "onos.a1t.admin.TransferPolicyOwnershipResponse")
+	proto.RegisterType((*ListPolicySchedulesRequest)(nil), "onos.a1t.admin.ListPolicySchedulesRequest")
+	golang_proto.RegisterType((*ListPolicySchedulesRequest)(nil), "onos.a1t.admin.ListPolicySchedulesRequest")
+	proto.RegisterType((*PolicySchedule)(nil), "onos.a1t.admin.PolicySchedule")
+	golang_proto.RegisterType((*PolicySchedule)(nil), "onos.a1t.admin.PolicySchedule")
+	proto.RegisterType((*ListPolicySchedulesResponse)(nil), "onos.a1t.admin.ListPolicySchedulesResponse")
+	golang_proto.RegisterType((*ListPolicySchedulesResponse)(nil), "onos.a1t.admin.ListPolicySchedulesResponse")
+	proto.RegisterType((*ListEITypesRequest)(nil), "onos.a1t.admin.ListEITypesRequest")
+	golang_proto.RegisterType((*ListEITypesRequest)(nil), "onos.a1t.admin.ListEITypesRequest")
+	proto.RegisterType((*EIType)(nil), "onos.a1t.admin.EIType")
+	golang_proto.RegisterType((*EIType)(nil), "onos.a1t.admin.EIType")
+	proto.RegisterType((*ListEITypesResponse)(nil), "onos.a1t.admin.ListEITypesResponse")
+	golang_proto.RegisterType((*ListEITypesResponse)(nil), "onos.a1t.admin.ListEITypesResponse")
+	proto.RegisterType((*ListEIJobsRequest)(nil), "onos.a1t.admin.ListEIJobsRequest")
+	golang_proto.RegisterType((*ListEIJobsRequest)(nil), "onos.a1t.admin.ListEIJobsRequest")
+	proto.RegisterType((*EIJob)(nil), "onos.a1t.admin.EIJob")
+	golang_proto.RegisterType((*EIJob)(nil), "onos.a1t.admin.EIJob")
+	proto.RegisterType((*ListEIJobsResponse)(nil), "onos.a1t.admin.ListEIJobsResponse")
+	golang_proto.RegisterType((*ListEIJobsResponse)(nil), "onos.a1t.admin.ListEIJobsResponse")
+	proto.RegisterType((*GetEIJobRequest)(nil), "onos.a1t.admin.GetEIJobRequest")
+	golang_proto.RegisterType((*GetEIJobRequest)(nil), "onos.a1t.admin.GetEIJobRequest")
+	proto.RegisterType((*GetEIJobResponse)(nil), "onos.a1t.admin.GetEIJobResponse")
+	golang_proto.RegisterType((*GetEIJobResponse)(nil), "onos.a1t.admin.GetEIJobResponse")
+	proto.RegisterType((*ForceDeleteEIJobRequest)(nil), "onos.a1t.admin.ForceDeleteEIJobRequest")
+	golang_proto.RegisterType((*ForceDeleteEIJobRequest)(nil), "onos.a1t.admin.ForceDeleteEIJobRequest")
+	proto.RegisterType((*ForceDeleteEIJobResponse)(nil), "onos.a1t.admin.ForceDeleteEIJobResponse")
+	golang_proto.RegisterType((*ForceDeleteEIJobResponse)(nil), "onos.a1t.admin.ForceDeleteEIJobResponse")
+	proto.RegisterType((*PutPolicyObjectRequest)(nil), "onos.a1t.admin.PutPolicyObjectRequest")
+	golang_proto.RegisterType((*PutPolicyObjectRequest)(nil), "onos.a1t.admin.PutPolicyObjectRequest")
+	proto.RegisterType((*PutPolicyObjectResponse)(nil), "onos.a1t.admin.PutPolicyObjectResponse")
+	golang_proto.RegisterType((*PutPolicyObjectResponse)(nil), "onos.a1t.admin.PutPolicyObjectResponse")
+	proto.RegisterType((*DeletePolicyObjectRequest)(nil), "onos.a1t.admin.DeletePolicyObjectRequest")
+	golang_proto.RegisterType((*DeletePolicyObjectRequest)(nil), "onos.a1t.admin.DeletePolicyObjectRequest")
+	proto.RegisterType((*DeletePolicyObjectResponse)(nil), "onos.a1t.admin.DeletePolicyObjectResponse")
+	golang_proto.RegisterType((*DeletePolicyObjectResponse)(nil), "onos.a1t.admin.DeletePolicyObjectResponse")
+	proto.RegisterType((*ReconcileXAppRequest)(nil), "onos.a1t.admin.ReconcileXAppRequest")
+	golang_proto.RegisterType((*ReconcileXAppRequest)(nil), "onos.a1t.admin.ReconcileXAppRequest")
+	proto.RegisterType((*PolicyReconcile)(nil), "onos.a1t.admin.PolicyReconcile")
+	golang_proto.RegisterType((*PolicyReconcile)(nil), "onos.a1t.admin.PolicyReconcile")
+	proto.RegisterType((*ReconcileXAppResponse)(nil), "onos.a1t.admin.ReconcileXAppResponse")
+	golang_proto.RegisterType((*ReconcileXAppResponse)(nil), "onos.a1t.admin.ReconcileXAppResponse")
+	proto.RegisterType((*DisconnectXAppRequest)(nil), "onos.a1t.admin.DisconnectXAppRequest")
+	golang_proto.RegisterType((*DisconnectXAppRequest)(nil), "onos.a1t.admin.DisconnectXAppRequest")
+	proto.RegisterType((*DisconnectXAppResponse)(nil), "onos.a1t.admin.DisconnectXAppResponse")
+	golang_proto.RegisterType((*DisconnectXAppResponse)(nil), "onos.a1t.admin.DisconnectXAppResponse")
+	proto.RegisterType((*ReconnectXAppRequest)(nil), "onos.a1t.admin.ReconnectXAppRequest")
+	golang_proto.RegisterType((*ReconnectXAppRequest)(nil), "onos.a1t.admin.ReconnectXAppRequest")
+	proto.RegisterType((*ReconnectXAppResponse)(nil), "onos.a1t.admin.ReconnectXAppResponse")
+	golang_proto.RegisterType((*ReconnectXAppResponse)(nil), "onos.a1t.admin.ReconnectXAppResponse")
+	proto.RegisterType((*DrainXAppRequest)(nil), "onos.a1t.admin.DrainXAppRequest")
+	golang_proto.RegisterType((*DrainXAppRequest)(nil), "onos.a1t.admin.DrainXAppRequest")
+	proto.RegisterType((*DrainXAppResponse)(nil), "onos.a1t.admin.DrainXAppResponse")
+	golang_proto.RegisterType((*DrainXAppResponse)(nil), "onos.a1t.admin.DrainXAppResponse")
+	proto.RegisterType((*XAppQueryResult)(nil), "onos.a1t.admin.XAppQueryResult")
+	golang_proto.RegisterType((*XAppQueryResult)(nil), "onos.a1t.admin.XAppQueryResult")
+	proto.RegisterType((*ListPolicyTypeObjectsRequest)(nil), "onos.a1t.admin.ListPolicyTypeObjectsRequest")
+	golang_proto.RegisterType((*ListPolicyTypeObjectsRequest)(nil), "onos.a1t.admin.ListPolicyTypeObjectsRequest")
+	proto.RegisterType((*PolicyTypeObject)(nil), "onos.a1t.admin.PolicyTypeObject")
+	golang_proto.RegisterType((*PolicyTypeObject)(nil), "onos.a1t.admin.PolicyTypeObject")
+	proto.RegisterType((*ListPolicyTypeObjectsResponse)(nil), "onos.a1t.admin.ListPolicyTypeObjectsResponse")
+	golang_proto.RegisterType((*ListPolicyTypeObjectsResponse)(nil), "onos.a1t.admin.ListPolicyTypeObjectsResponse")
+	proto.RegisterType((*ListPolicyObjectsRequest)(nil), "onos.a1t.admin.ListPolicyObjectsRequest")
+	golang_proto.RegisterType((*ListPolicyObjectsRequest)(nil), "onos.a1t.admin.ListPolicyObjectsRequest")
+	proto.RegisterType((*PolicyObject)(nil), "onos.a1t.admin.PolicyObject")
+	golang_proto.RegisterType((*PolicyObject)(nil), "onos.a1t.admin.PolicyObject")
+	proto.RegisterType((*ListPolicyObjectsResponse)(nil), "onos.a1t.admin.ListPolicyObjectsResponse")
+	golang_proto.RegisterType((*ListPolicyObjectsResponse)(nil), "onos.a1t.admin.ListPolicyObjectsResponse")
+	proto.RegisterType((*GetPolicyInventoryRequest)(nil), "onos.a1t.admin.GetPolicyInventoryRequest")
+	golang_proto.RegisterType((*GetPolicyInventoryRequest)(nil), "onos.a1t.admin.GetPolicyInventoryRequest")
+	proto.RegisterType((*XAppPolicy)(nil), "onos.a1t.admin.XAppPolicy")
+	golang_proto.RegisterType((*XAppPolicy)(nil), "onos.a1t.admin.XAppPolicy")
+	proto.RegisterType((*XAppPolicyInventory)(nil), "onos.a1t.admin.XAppPolicyInventory")
+	golang_proto.RegisterType((*XAppPolicyInventory)(nil), "onos.a1t.admin.XAppPolicyInventory")
+	proto.RegisterMapType((map[string]string)(nil), "onos.a1t.admin.XAppPolicyInventory.ErrorsEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "onos.a1t.admin.XAppPolicyInventory.ErrorsEntry")
+	proto.RegisterType((*GetPolicyInventoryResponse)(nil), "onos.a1t.admin.GetPolicyInventoryResponse")
+	golang_proto.RegisterType((*GetPolicyInventoryResponse)(nil), "onos.a1t.admin.GetPolicyInventoryResponse")
+	proto.RegisterType((*GetDivergenceReportRequest)(nil), "onos.a1t.admin.GetDivergenceReportRequest")
+	golang_proto.RegisterType((*GetDivergenceReportRequest)(nil), "onos.a1t.admin.GetDivergenceReportRequest")
+	proto.RegisterType((*PolicyDivergence)(nil), "onos.a1t.admin.PolicyDivergence")
+	golang_proto.RegisterType((*PolicyDivergence)(nil), "onos.a1t.admin.PolicyDivergence")
+	proto.RegisterType((*GetDivergenceReportResponse)(nil), "onos.a1t.admin.GetDivergenceReportResponse")
+	golang_proto.RegisterType((*GetDivergenceReportResponse)(nil), "onos.a1t.admin.GetDivergenceReportResponse")
+}
+
+func init() { proto.RegisterFile("onos/a1t/admin/admin_ext.proto", fileDescriptor_5ec8c8decc5d01b0) }
+func init() {
+	golang_proto.RegisterFile("onos/a1t/admin/admin_ext.proto", fileDescriptor_5ec8c8decc5d01b0)
+}
+
+var fileDescriptor_5ec8c8decc5d01b0 = []byte{
+	// 3294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0xc9, 0x6f, 0x1c, 0xc7,
+	0xd5, 0x57, 0xcf, 0xc2, 0x99, 0x79, 0xc3, 0x4d, 0x2d, 0x2e, 0xa3, 0x16, 0x4d, 0xd2, 0x6d, 0xcb,
+	0xa6, 0x25, 0x99, 0x94, 0x64, 0x78, 0x93, 0xfd, 0xe1, 0xfb, 0x4c, 0x6d, 0xa6, 0x60, 0x7f, 0x52,
+	0x9a, 0x94, 0xa3, 0x04, 0x09, 0x06, 0x3d, 0xdd, 0x35, 0xc3, 0x12, 0x67, 0xba, 0xda, 0xdd, 0x3d,
+	0x32, 0x29, 0x24, 0x80, 0x73, 0x49, 0x0e, 0x09, 0x12, 0xdf, 0x73, 0x4e, 0x10, 0x20, 0x40, 0xfe,
+	0x81, 0x5c, 0x82, 0xe4, 0xe2, 0x4b, 0x00, 0x1f, 0x73, 0xf1, 0x12, 0x2b, 0xe7, 0x5c, 0x72, 0x0b,
+	0x60, 0x20, 0xa8, 0xa5, 0xbb, 0xab, 0xb7, 0xe1, 0x50, 0xa1, 0xe4, 0x0b, 0x31, 0x55, 0xf5, 0xb6,
+	0x7a, 0xf5, 0xab, 0x57, 0xaf, 0x5e, 0x35, 0x61, 0x99, 0x38, 0xc4, 0xdf, 0x30, 0x2f, 0x05, 0x1b,
+	0xa6, 0x3d, 0xc0, 0x0e, 0xff, 0xdb, 0x46, 0xfb, 0xc1, 0xba, 0xeb, 0x91, 0x80, 0xa8, 0xd3, 0x74,
+	0x7c, 0xdd, 0xbc, 0x14, 0xac, 0xb3, 0x11, 0x6d, 0xa5, 0x47, 0x48, 0xaf, 0x8f, 0x36, 0xd8, 0x68,
+	0x67, 0xd8, 0xdd, 0x08, 0xf0, 0x00, 0xf9, 0x81, 0x39, 0x70, 0x39, 0x83, 0x36, 0xd7, 0x23, 0x3d,
+	0xc2, 0x7e, 0x6e, 0xd0, 0x5f, 0xbc, 0x57, 0xff, 0xbb, 0x02, 0x8b, 0x37, 0x51, 0x70, 0x87, 0xf4,
+	0xb1, 0x75, 0xf0, 0x2e, 0xf6, 0x03, 0xe2, 0x1d, 0x18, 0xe8, 0xc3, 0x21, 0xf2, 0x03, 0xf5, 0x79,
+	0x98, 0x76, 0x59, 0x7f, 0x3b, 0x38, 0x70, 0x51, 0x1b, 0xdb, 0x2d, 0x65, 0x55, 0x59, 0x6b, 0x18,
+	0x93, 0xbc, 0x77, 0xe7, 0xc0, 0x45, 0x5b, 0xb6, 0xba, 0x06, 0xb3, 0x82, 0x8a, 0x74, 0xee, 0x23,
+	0x2b, 0xa0, 0x74, 0x25, 0x46, 0x27, 0xb8, 0x6f, 0xb3, 0xee, 0x2d, 0x5b, 0xbd, 0x02, 0x55, 0x1f,
+	0x3b, 0x16, 0x6a, 0x95, 0x57, 0x95, 0xb5, 0xe6, 0x65, 0x6d, 0x9d, 0x9b, 0xbc, 0x1e, 0x9a, 0xbc,
+	0xbe, 0x13, 0x9a, 0xbc, 0x59, 0xff, 0xf4, 0x8b, 0x95, 0x13, 0x9f, 0x7c, 0xb9, 0xa2, 0x18, 0x9c,
+	0x85, 0xf2, 0x0e, 0x9d, 0x00, 0xf7, 0x5b, 0x95, 0xa3, 0xf0, 0x32, 0x16, 0xfd, 0x1e, 0x34, 0xef,
+	0xbd, 0xe3, 0xba, 0xb7, 0x87, 0x81, 0x45, 0x06, 0x48, 0x5d, 0x84, 0xda, 0xbe, 0xe9, 0xba, 0xf1,
+	0x7c, 0x26, 0x68, 0x73, 0xcb, 0x56, 0x5b, 0x50, 0xf3, 0x87, 0x96, 0x85, 0x7c, 0x9f, 0x4d, 0xa0,
+	0x6e, 0x84, 0x4d, 0x75, 0x01, 0x26, 0x3c, 0x64, 0xfa, 0xc4, 0x61, 0xa6, 0x37, 0x0c, 0xd1, 0xd2,
+	0xbf, 0x29, 0x43, 0x2b, 0xeb, 0x3d, 0xdf, 0x25, 0x8e, 0x8f, 0x54, 0x0d, 0xea, 0x3e, 0xf5, 0x24,
+	0x9d, 0x31, 0x55, 0x54, 0x31, 0xa2, 0xb6, 0xba, 0x09, 0x8d, 0x68, 0x7d, 0x5a, 0xa5, 0x23, 0x4c,
+	0x29, 0x66, 0x53, 0x97, 0xa0, 0x41, 0x5c, 0xe4, 0x99, 0x01, 0x8e, 0xec, 0x8a, 0x3b, 0x72, 0x16,
+	0xaf, 0x32, 0xe6, 0xe2, 0x55, 0x73, 0x17, 0x6f, 0x01, 0x26, 0x2c, 0xb3, 0xdf, 0x47, 0x5e, 0x6b,
+	0x82, 0xbb, 0x80, 0xb7, 0xd4, 0x67, 0x00, 0x3c, 0x8e, 0x17, 0xca, 0x5b, 0xe3, 0x66, 0x88, 0x9e,
+	0x2d, 0x9b, 0x3a, 0xc1, 0x43, 0x0f, 0xb0, 0x4f, 0x6d, 0xac, 0x73, 0x27, 0x84, 0x6d, 0xf5, 0x1c,
+	0x9c, 0x24, 0x7d, 0xbb, 0x9d, 0x30, 0xa0, 0xd5, 0x60, 0x12, 0x66, 0x48, 0xdf, 0xbe, 0x23, 0x19,
+	0x40, 0x69, 0x1d, 0xf4, 0x51, 0x8a, 0x16, 0x38, 0xad, 0x83, 0x3e, 0x4a, 0xd0, 0xbe, 0x00, 0x33,
+	0x81, 0xe9, 0xf5, 0x50, 0xd0, 0x16, 0xeb, 0xec, 0xb7, 0x9a, 0xab, 0xe5, 0xb5, 0x86, 0x31, 0xc5,
+	0xbb, 0xef, 0xb1, 0xe5, 0xf6, 0xd5, 0xff, 0x83, 0x29, 0x46, 0x40, 0x38, 0x30, 0xfc, 0xd6, 0xe4,
+	0x6a, 0x79, 0xad, 0x79, 0xf9, 0xcc, 0x7a, 0x72, 0x6b, 0xad, 0x4b, 0xe0, 0x31, 0x26, 0xf7, 0xcd,
+	0xa8, 0xe1, 0xeb, 0x5f, 0x29, 0x30, 0x6f, 0x90, 0x7e, 0xbf, 0x63, 0x5a, 0x7b, 0xdc, 0x84, 0x27,
+	0xb5, 0x77, 0x64, 0x3f, 0x96, 0x53, 0x7e, 0x4c, 0x80, 0xa9, 0xf2, 0x78, 0x60, 0x5a, 0x84, 0x9a,
+	0xed, 0x1d, 0xb4, 0xbd, 0xa1, 0xc3, 0xd6, 0xbf, 0x6e, 0x4c, 0xd8, 0xde, 0x81, 0x31, 0x74, 0xf4,
+	0x6f, 0x4a, 0x30, 0x2d, 0xa6, 0x26, 0x26, 0x7a, 0xec, 0x73, 0x5b, 0x80, 0x09, 0xd3, 0x92, 0x50,
+	0x2c, 0x5a, 0xea, 0x73, 0x30, 0xd5, 0xf5, 0xc8, 0xa0, 0x1d, 0x4d, 0xbc, 0xc2, 0x26, 0x3e, 0x49,
+	0x3b, 0x8d, 0x70, 0xf2, 0x2b, 0xd0, 0x0c, 0x48, 0x4c, 0x52, 0x65, 0x24, 0x10, 0x90, 0x88, 0xe0,
+	0x32, 0xcc, 0x5b, 0x43, 0xcf, 0x43, 0x4e, 0x90, 0x42, 0x0f, 0xc7, 0xf1, 0x29, 0x31, 0x98, 0x40,
+	0xd0, 0x45, 0x98, 0x13, 0x08, 0x4a, 0xb2, 0x70, 0x78, 0xab, 0x7c, 0x2c, 0xc1, 0x71, 0x16, 0xa6,
+	0xad, 0x5d, 0xd3, 0xe9, 0x21, 0xbb, 0xdd, 0xc5, 0xa8, 0x6f, 0xfb, 0xad, 0x3a, 0x87, 0x9c, 0xe8,
+	0xbd, 0xc1, 0x3a, 0xe5, 0x10, 0xd3, 0x28, 0x0a, 0x31, 0x90, 0x08, 0x31, 0x1f, 0xc0, 0x42, 0x1a,
+	0x61, 0x22, 0xbe, 0xbc, 0x0d, 0x0d, 0x4f, 0x8c, 0xf8, 0x2d, 0x85, 0x41, 0x77, 0x39, 0x0d, 0xdd,
+	0xe4, 0xca, 0x19, 0x31, 0x83, 0x7e, 0x19, 0x16, 0xdf, 0xc3, 0x7e, 0x40, 0xb1, 0xbd, 0x8d, 0x7c,
+	0xea, 0x29, 0x3f, 0xc4, 0x6e, 0x51, 0x80, 0xd4, 0xff, 0xa9, 0x40, 0x53, 0x62, 0x28, 0x8e, 0xa4,
+	0xcf, 0x00, 0x98, 0x97, 0xda, 0x3e, 0xf2, 0x1e, 0x60, 0x0b, 0x89, 0x55, 0x6f, 0x98, 0x97, 0xb6,
+	0x79, 0x07, 0x05, 0x33, 0x72, 0x6c, 0x97, 0x60, 0x27, 0x10, 0x4b, 0x1e, 0xb5, 0xd5, 0x39, 0xa8,
+	0xfa, 0x81, 0x19, 0x20, 0x11, 0xae, 0x78, 0x83, 0x72, 0x98, 0x41, 0x80, 0x06, 0x6e, 0xe0, 0xb3,
+	0x25, 0xae, 0x1a, 0x51, 0x9b, 0x2a, 0xeb, 0x9b, 0x7e, 0xd0, 0x46, 0x9e, 0x47, 0xc2, 0xe8, 0xd4,
+	0xa0, 0x3d, 0xd7, 0x69, 0x47, 0x7c, 0xea, 0xd4, 0x8e, 0x7c, 0xea, 0xe8, 0xdb, 0xd0, 0xca, 0x3a,
+	0x49, 0xb8, 0xff, 0x75, 0x1a, 0xde, 0x79, 0x5f, 0x4b, 0x29, 0x0e, 0x1c, 0x82, 0xcf, 0x88, 0x88,
+	0xf5, 0x57, 0x41, 0xa3, 0x42, 0xaf, 0x62, 0xcf, 0x1a, 0xe2, 0x60, 0xd3, 0x43, 0xe6, 0x1e, 0xf2,
+	0x0e, 0x77, 0xfe, 0x5f, 0x15, 0x98, 0x4e, 0xf2, 0x14, 0xfb, 0x3f, 0x72, 0x62, 0x49, 0x76, 0xe2,
+	0x25, 0x98, 0xb3, 0xa8, 0xe9, 0xd6, 0x30, 0xc0, 0x0f, 0x50, 0xbb, 0x6b, 0xe2, 0xfe, 0xd0, 0x43,
+	0x3e, 0x5b, 0x82, 0xaa, 0x71, 0x4a, 0x1a, 0xbb, 0x21, 0x86, 0x52, 0xbe, 0xad, 0x14, 0xfa, 0xb6,
+	0x7a, 0x74, 0xdf, 0xee, 0xc2, 0x99, 0x5c, 0x37, 0x08, 0xf7, 0x6e, 0xc1, 0xac, 0xc5, 0x87, 0xda,
+	0x1d, 0x31, 0x56, 0x04, 0xf2, 0xa4, 0x08, 0x63, 0xc6, 0x4a, 0x8a, 0xd4, 0x5f, 0x83, 0x33, 0xdf,
+	0x35, 0x03, 0x6b, 0xf7, 0x31, 0x3c, 0xbe, 0x94, 0xcf, 0x28, 0x6c, 0x1c, 0x85, 0x7f, 0x16, 0xb9,
+	0xe4, 0x45, 0x68, 0xd0, 0x9e, 0x6d, 0xb6, 0x10, 0xa7, 0xa1, 0x1e, 0x10, 0x31, 0xc8, 0xf1, 0x5f,
+	0x0b, 0x08, 0x1f, 0x8a, 0xc3, 0x40, 0x45, 0x0e, 0x03, 0xc9, 0x18, 0x5f, 0x7d, 0xac, 0x18, 0xaf,
+	0xff, 0x46, 0x81, 0x65, 0x36, 0x1f, 0x39, 0x72, 0x51, 0xb5, 0x43, 0xff, 0x49, 0x1d, 0x5b, 0x92,
+	0x87, 0xca, 0x09, 0x0f, 0xd1, 0xe4, 0xc8, 0x31, 0x5d, 0x7f, 0x97, 0x04, 0x6c, 0xa6, 0x75, 0x23,
+	0x6a, 0xeb, 0x1f, 0x97, 0x60, 0xa5, 0xd0, 0x4e, 0xe1, 0xfa, 0xa7, 0x66, 0xe8, 0x45, 0x98, 0x4b,
+	0x8a, 0xf0, 0x99, 0x21, 0x62, 0x79, 0x54, 0x37, 0x63, 0xe2, 0xb1, 0x2c, 0xd5, 0xe7, 0x0a, 0x9c,
+	0xda, 0x1c, 0xf6, 0x45, 0xc8, 0xbf, 0x1d, 0x65, 0x75, 0xf1, 0x51, 0xa9, 0x24, 0x8e, 0xca, 0xac,
+	0x3b, 0x4a, 0x63, 0xba, 0xa3, 0x9c, 0xeb, 0x8e, 0x37, 0xa1, 0xe5, 0x90, 0x00, 0x77, 0xb1, 0xc5,
+	0xf4, 0xb6, 0x6d, 0xe4, 0x07, 0xd8, 0x61, 0xbf, 0xc5, 0xcc, 0x17, 0xe5, 0xf1, 0x6b, 0xf1, 0x30,
+	0x3d, 0xb5, 0x93, 0x87, 0x66, 0x55, 0xb6, 0x84, 0x6b, 0xd0, 0x1f, 0xc2, 0x99, 0x9c, 0xe9, 0x45,
+	0x30, 0xbc, 0x0a, 0x10, 0x65, 0xb2, 0xe1, 0xb6, 0x7f, 0x2e, 0xbd, 0xed, 0x73, 0x04, 0x18, 0x12,
+	0x1b, 0xf3, 0x55, 0x40, 0x06, 0xd8, 0x12, 0xd9, 0xbc, 0x68, 0xe9, 0xff, 0x50, 0x60, 0x36, 0xe6,
+	0x35, 0x90, 0x3f, 0xec, 0xb3, 0x63, 0x07, 0x3b, 0x36, 0xda, 0x67, 0x7e, 0xad, 0x1a, 0xbc, 0x71,
+	0xec, 0x6e, 0x8d, 0x97, 0xaf, 0x92, 0x58, 0xbe, 0x16, 0xd4, 0x4c, 0xd7, 0xed, 0x63, 0x64, 0x8b,
+	0xec, 0x2b, 0x6c, 0xaa, 0xab, 0xd0, 0xb4, 0xc8, 0xc0, 0x45, 0x8e, 0x6f, 0x06, 0xc8, 0x66, 0xa7,
+	0x5b, 0xdd, 0x90, 0xbb, 0xa4, 0x88, 0x51, 0x4b, 0x24, 0x0e, 0xbf, 0x50, 0x60, 0x29, 0xdf, 0xc7,
+	0x62, 0x0b, 0x49, 0xb9, 0x88, 0x52, 0x94, 0x8b, 0x94, 0x12, 0x41, 0xe8, 0x0a, 0xd4, 0x3c, 0xe6,
+	0x2e, 0x7a, 0x66, 0xd0, 0x35, 0x59, 0x2d, 0x5e, 0x13, 0xee, 0x57, 0x23, 0x64, 0xd0, 0x97, 0x61,
+	0x29, 0xba, 0x29, 0x6d, 0x93, 0xa1, 0x67, 0xa1, 0x44, 0xe4, 0xa1, 0xf9, 0xc8, 0xf6, 0x81, 0x63,
+	0xc9, 0x04, 0x52, 0x80, 0x0e, 0x73, 0x53, 0x25, 0x91, 0x9b, 0xfe, 0x59, 0x01, 0x55, 0x66, 0xb8,
+	0xca, 0x72, 0xad, 0xa7, 0xb6, 0x49, 0x54, 0xa8, 0x74, 0x71, 0x3f, 0xcc, 0x54, 0xd8, 0xef, 0x11,
+	0x2b, 0x19, 0x3b, 0x75, 0x22, 0xb1, 0x4e, 0xbf, 0x53, 0x60, 0x2e, 0x31, 0x09, 0xe2, 0x74, 0xfb,
+	0xd8, 0x3a, 0xfe, 0x58, 0x1c, 0x9a, 0x5b, 0x96, 0xcc, 0x9d, 0x83, 0x2a, 0xf9, 0xc8, 0x41, 0xe1,
+	0xd1, 0xce, 0x1b, 0x92, 0xa9, 0xd5, 0x84, 0xa9, 0x7f, 0x54, 0xe0, 0xa4, 0x6c, 0xea, 0x35, 0x0f,
+	0x77, 0x8f, 0xdf, 0xce, 0x55, 0x68, 0xba, 0x1e, 0x42, 0xfb, 0x98, 0xc6, 0x94, 0x1e, 0x33, 0xb7,
+	0x6e, 0xc8, 0x5d, 0xf4, 0xe6, 0x6b, 0x11, 0xcf, 0x43, 0x16, 0xdd, 0x12, 0xfc, 0xf4, 0x88, 0x3b,
+	0x0a, 0xad, 0xff, 0x77, 0x09, 0xd4, 0x2c, 0xfe, 0x92, 0xe1, 0x5a, 0x79, 0xbc, 0xdb, 0xd3, 0x02,
+	0x4c, 0xd8, 0xb8, 0x87, 0xfc, 0x20, 0xdc, 0x30, 0xbc, 0x25, 0x23, 0xb7, 0x2c, 0x23, 0x97, 0xce,
+	0x60, 0x60, 0x3a, 0xb8, 0x8b, 0xfc, 0x80, 0x1f, 0x25, 0x55, 0x23, 0xee, 0x50, 0xdf, 0x86, 0x1a,
+	0xbf, 0x36, 0xd0, 0x64, 0x97, 0xee, 0x33, 0x3d, 0x3f, 0xaf, 0x97, 0x51, 0x6f, 0x84, 0x2c, 0x74,
+	0x42, 0x96, 0xc0, 0x90, 0xdf, 0x9a, 0x60, 0xfc, 0xcf, 0x8f, 0xe4, 0x17, 0xc4, 0x46, 0xcc, 0xa6,
+	0xbe, 0x0e, 0x55, 0x9b, 0x2e, 0x6e, 0xab, 0xc6, 0xf8, 0x9f, 0x1d, 0xc5, 0xcf, 0x50, 0x60, 0x70,
+	0x7a, 0xea, 0x09, 0x96, 0x2b, 0x86, 0xf7, 0x1f, 0xd1, 0xd2, 0xef, 0xf2, 0xeb, 0x86, 0x08, 0x46,
+	0x14, 0x65, 0x47, 0xcc, 0x39, 0x22, 0xa4, 0x96, 0x24, 0xa4, 0xea, 0xbf, 0x52, 0xa0, 0x29, 0xc9,
+	0x3c, 0x76, 0x2c, 0x46, 0x5a, 0xcb, 0xf2, 0xfe, 0x90, 0x2f, 0xe3, 0x95, 0xe4, 0x65, 0x3c, 0xbc,
+	0x32, 0x24, 0x27, 0x1a, 0x5f, 0x19, 0x98, 0x7c, 0x8c, 0x0a, 0xaf, 0x0c, 0x12, 0x9f, 0x11, 0x11,
+	0xeb, 0x3f, 0x55, 0x60, 0x79, 0xc7, 0x33, 0x1d, 0xbf, 0x8b, 0x3c, 0x59, 0xf2, 0x2e, 0x76, 0x9f,
+	0x54, 0xe6, 0x96, 0x3b, 0x73, 0xfd, 0x03, 0x58, 0x29, 0xb4, 0x43, 0x4c, 0xf2, 0x15, 0x98, 0xe0,
+	0xa2, 0xc4, 0x66, 0x1a, 0x39, 0x45, 0x41, 0xaa, 0x6f, 0xf2, 0x3b, 0x91, 0x80, 0x95, 0xb5, 0x8b,
+	0xec, 0x61, 0x1f, 0x1d, 0x0d, 0x21, 0xfa, 0x17, 0x65, 0x98, 0x4e, 0x0a, 0x78, 0x4a, 0x70, 0xb8,
+	0x0a, 0xf0, 0xc0, 0xec, 0x63, 0xbb, 0x4d, 0x33, 0xfc, 0xa3, 0x15, 0x60, 0x18, 0xdf, 0x0d, 0x8f,
+	0x0c, 0xd4, 0xeb, 0xd0, 0xe4, 0x42, 0x78, 0x99, 0xf3, 0x28, 0x79, 0x23, 0xd7, 0x7e, 0x97, 0xf2,
+	0xa9, 0xcb, 0xb4, 0x1c, 0xc7, 0x4b, 0x1a, 0x16, 0x12, 0x27, 0x8d, 0xd4, 0xa3, 0xde, 0x86, 0xd9,
+	0xb8, 0x25, 0x74, 0x1d, 0xe5, 0x62, 0x3c, 0x13, 0x73, 0x73, 0x85, 0x1a, 0xd4, 0x6d, 0xe4, 0xf6,
+	0xc9, 0x01, 0xb2, 0x59, 0x81, 0xaf, 0x6e, 0x44, 0x6d, 0xf5, 0x7d, 0x98, 0x71, 0xd0, 0x7e, 0xd0,
+	0x0e, 0x28, 0x64, 0x30, 0x3b, 0x91, 0x1b, 0x47, 0xd0, 0x35, 0x4d, 0x99, 0x77, 0x22, 0x5e, 0xfd,
+	0x7b, 0xfc, 0xc6, 0x98, 0x01, 0x89, 0x00, 0xde, 0x95, 0xcc, 0xee, 0x2a, 0x28, 0x87, 0x84, 0xac,
+	0xd2, 0x06, 0x9b, 0x03, 0x95, 0x8a, 0xbe, 0xbe, 0x45, 0x21, 0x11, 0xe5, 0x24, 0x77, 0x61, 0x82,
+	0xf7, 0xa8, 0x4b, 0x00, 0x08, 0xa7, 0x40, 0x54, 0x47, 0x58, 0x00, 0xe8, 0x34, 0xd4, 0xa3, 0x4a,
+	0x63, 0x89, 0x85, 0xbd, 0xda, 0xbe, 0xa8, 0x31, 0xaa, 0x50, 0xb9, 0x4f, 0x3a, 0xe1, 0x1d, 0x9b,
+	0xfd, 0xd6, 0xdf, 0x85, 0x53, 0x09, 0x65, 0xc2, 0xfe, 0x4b, 0x50, 0x17, 0x3a, 0x42, 0xfb, 0x17,
+	0xd2, 0xf6, 0x73, 0x16, 0xa3, 0xc6, 0x35, 0xfb, 0xfa, 0x2d, 0x38, 0xc9, 0x25, 0xdd, 0x22, 0x9d,
+	0x68, 0xb7, 0x8c, 0xb6, 0x55, 0xba, 0xe8, 0x94, 0x12, 0xb7, 0xdd, 0x7f, 0x95, 0xa1, 0xca, 0x04,
+	0xa9, 0x1a, 0x34, 0x10, 0x6e, 0xdf, 0x27, 0x9d, 0x98, 0xbf, 0x86, 0xf0, 0x2d, 0xd2, 0xd9, 0xb2,
+	0x53, 0xc2, 0x4b, 0x29, 0xe1, 0xf9, 0xfb, 0xe3, 0x1c, 0x9c, 0xb4, 0x88, 0xe3, 0x0f, 0x07, 0xc8,
+	0x8b, 0x2b, 0xb2, 0x15, 0xe6, 0xa7, 0x99, 0x70, 0x20, 0xac, 0xc9, 0x9e, 0x85, 0x69, 0xaa, 0xd8,
+	0x46, 0x5d, 0xec, 0x70, 0xc4, 0xf0, 0x43, 0x7c, 0xea, 0x3e, 0xe9, 0x5c, 0x8b, 0x3a, 0xd5, 0xe7,
+	0x39, 0x19, 0x4f, 0x2e, 0xdb, 0x43, 0x0f, 0x0b, 0xa8, 0x4f, 0xde, 0x27, 0x1d, 0x9e, 0x79, 0xde,
+	0xf5, 0x30, 0x3d, 0x8c, 0xc4, 0x6d, 0x4d, 0xa4, 0xc6, 0xbc, 0x45, 0x31, 0x4b, 0x3c, 0x77, 0xd7,
+	0x74, 0x62, 0xcc, 0x86, 0xed, 0x64, 0x3a, 0xd0, 0x78, 0xbc, 0x74, 0x60, 0x05, 0x9a, 0xac, 0x6a,
+	0xc2, 0xcd, 0x13, 0x05, 0x3d, 0x56, 0x48, 0x11, 0xb7, 0x8d, 0x7b, 0x30, 0x2f, 0x11, 0xb4, 0x63,
+	0x85, 0xcd, 0x23, 0x28, 0x3c, 0x15, 0x0b, 0x8c, 0x86, 0xd5, 0x75, 0x60, 0xdd, 0x6d, 0x1b, 0xf5,
+	0xf1, 0x03, 0xe4, 0x1d, 0x88, 0xca, 0xcd, 0x24, 0x33, 0xe1, 0x24, 0x1d, 0xba, 0x26, 0x46, 0x58,
+	0x05, 0x47, 0xff, 0x5f, 0x50, 0x65, 0x04, 0x09, 0x28, 0xbe, 0x24, 0x50, 0xcb, 0x61, 0x38, 0x9f,
+	0x85, 0xe1, 0x2d, 0xd2, 0x11, 0x60, 0x7e, 0x19, 0x66, 0x6e, 0x22, 0xce, 0x1f, 0x02, 0x70, 0x04,
+	0x7e, 0xf4, 0xb7, 0x60, 0x36, 0x26, 0x17, 0xda, 0x5e, 0x84, 0xf2, 0x7d, 0xd2, 0x11, 0xc7, 0x45,
+	0x81, 0x32, 0x4a, 0xa1, 0xbf, 0x0a, 0x8b, 0x37, 0x08, 0xcd, 0x38, 0x50, 0x1f, 0x05, 0x68, 0x6c,
+	0x9d, 0x3f, 0x84, 0x56, 0x96, 0x2d, 0x7a, 0xa4, 0x29, 0xc6, 0xfa, 0x59, 0x98, 0xf6, 0xd0, 0x80,
+	0x04, 0x88, 0x7a, 0x13, 0x05, 0x88, 0xe3, 0xbd, 0x6e, 0x4c, 0xf1, 0x5e, 0x2e, 0xce, 0xd6, 0x7f,
+	0x56, 0x82, 0x85, 0x3b, 0xc3, 0x44, 0x39, 0xf8, 0x49, 0x1d, 0xca, 0x99, 0xbb, 0x75, 0x39, 0x7b,
+	0xb7, 0xfe, 0x6f, 0xee, 0xee, 0xa7, 0xa1, 0x8e, 0xbb, 0xed, 0x01, 0xad, 0xbd, 0xb0, 0xcc, 0xb3,
+	0x61, 0xd4, 0x70, 0xf7, 0x7d, 0xda, 0x54, 0x75, 0x98, 0xc2, 0xdd, 0xb6, 0x43, 0x1c, 0x24, 0xc6,
+	0x27, 0xd8, 0x78, 0x13, 0x77, 0xff, 0x9f, 0x38, 0x88, 0xd1, 0xe8, 0x37, 0x61, 0x31, 0xe3, 0x88,
+	0xf8, 0xb2, 0x69, 0x79, 0x88, 0xdd, 0x61, 0xc5, 0x65, 0x53, 0x34, 0x69, 0x84, 0x44, 0x81, 0xd9,
+	0x13, 0x33, 0x66, 0xbf, 0x69, 0xbe, 0x73, 0x9a, 0xbb, 0xf7, 0x69, 0x78, 0x55, 0x9e, 0x75, 0x39,
+	0x31, 0x6b, 0x7d, 0x09, 0xb4, 0x3c, 0x3b, 0xf8, 0xa4, 0xf4, 0x0d, 0x98, 0x33, 0x90, 0x45, 0x1c,
+	0x0b, 0xf7, 0x11, 0xad, 0xf5, 0x1e, 0x5a, 0x51, 0xfc, 0xbd, 0x02, 0x33, 0xe1, 0xf5, 0x58, 0xf0,
+	0x3d, 0xb5, 0xd7, 0x14, 0xe9, 0xba, 0x5f, 0x29, 0xba, 0xee, 0x27, 0x2f, 0x4c, 0x3b, 0x30, 0x9f,
+	0x9a, 0x9e, 0x58, 0xcc, 0xb7, 0x32, 0x27, 0xed, 0x4a, 0xc1, 0xc3, 0x43, 0xc8, 0x2e, 0x1d, 0xb5,
+	0x17, 0x61, 0xfe, 0x1a, 0xf6, 0x2d, 0xe2, 0x38, 0xc8, 0x0a, 0xc6, 0xf2, 0x5a, 0x0b, 0x16, 0xd2,
+	0x1c, 0xa9, 0x05, 0x18, 0x5b, 0xd4, 0x22, 0xcc, 0xa7, 0x18, 0x84, 0xa4, 0xeb, 0x30, 0x7b, 0xcd,
+	0x33, 0xb1, 0x33, 0x8e, 0x14, 0xea, 0x4a, 0x9b, 0x12, 0x47, 0x11, 0x21, 0x6c, 0xea, 0xff, 0x03,
+	0x27, 0x25, 0x31, 0xc2, 0x5d, 0x6b, 0x30, 0x2b, 0xc6, 0xe3, 0xe3, 0x4f, 0x61, 0x38, 0x9b, 0x16,
+	0xfd, 0xe2, 0xf4, 0xd3, 0x7f, 0xa9, 0xc0, 0x0c, 0x65, 0xfd, 0xce, 0x10, 0x79, 0x61, 0x65, 0x6a,
+	0x94, 0x15, 0xae, 0x79, 0xd0, 0x27, 0x66, 0x88, 0x84, 0xb0, 0x29, 0x2f, 0x75, 0xb9, 0x68, 0xa9,
+	0x93, 0xe5, 0x65, 0xf6, 0xba, 0x4b, 0xd3, 0xa2, 0xf0, 0xf5, 0x8f, 0xb7, 0xf4, 0x8f, 0x15, 0x58,
+	0x8a, 0x73, 0x2e, 0x8a, 0x45, 0x8e, 0xb3, 0x23, 0x5e, 0xde, 0xce, 0x40, 0xc3, 0x35, 0x7b, 0xa8,
+	0xed, 0xe3, 0x87, 0xbc, 0x1c, 0x5e, 0x35, 0xea, 0xb4, 0x63, 0x1b, 0x3f, 0x44, 0xb4, 0x58, 0xce,
+	0x06, 0x03, 0xb2, 0x87, 0xa2, 0x87, 0x6c, 0xda, 0xb3, 0x43, 0x3b, 0xf4, 0x2f, 0x15, 0x98, 0x4d,
+	0xab, 0x1f, 0x53, 0x2d, 0x95, 0xcc, 0xa9, 0xe2, 0xcc, 0xac, 0xc1, 0x7b, 0x68, 0xae, 0x71, 0x01,
+	0x54, 0x59, 0x48, 0x22, 0xa4, 0xce, 0xba, 0x69, 0x95, 0x9b, 0xc0, 0xde, 0x7e, 0xdb, 0x61, 0x05,
+	0xac, 0x92, 0x0f, 0xfc, 0xd4, 0xf2, 0x19, 0x4d, 0xca, 0xc4, 0x7f, 0xfb, 0x34, 0x3f, 0xe2, 0xe7,
+	0x31, 0xdf, 0x68, 0xbc, 0x41, 0x2b, 0x75, 0xcf, 0x14, 0x38, 0x59, 0x20, 0xe8, 0x2a, 0x4c, 0x4a,
+	0x96, 0x86, 0x9b, 0x6e, 0x35, 0x7f, 0xd3, 0xc5, 0x02, 0x8c, 0x66, 0x3c, 0x0b, 0x9f, 0x3e, 0x8b,
+	0xb3, 0x6c, 0x5c, 0x72, 0x36, 0xc7, 0xcd, 0x14, 0xed, 0xbe, 0x13, 0x39, 0xfc, 0xb7, 0x4a, 0xe2,
+	0x0a, 0xfb, 0x38, 0xeb, 0x3d, 0x7e, 0xb4, 0x4a, 0x20, 0xa3, 0x3c, 0x12, 0x19, 0x95, 0x34, 0x32,
+	0x3e, 0x57, 0x60, 0x32, 0xf1, 0x08, 0xfb, 0xad, 0x1c, 0xb7, 0x4f, 0x0e, 0x17, 0x3f, 0x86, 0xd3,
+	0x39, 0xeb, 0x20, 0x20, 0xf1, 0x46, 0x26, 0x06, 0x2f, 0x15, 0x5c, 0xb4, 0x39, 0x14, 0x22, 0xea,
+	0xb1, 0x71, 0xf0, 0x1e, 0x9c, 0x8e, 0x2a, 0xb6, 0x5b, 0xce, 0x03, 0xe4, 0xc8, 0xdf, 0x06, 0x15,
+	0x46, 0x25, 0x16, 0x61, 0x7c, 0xcb, 0x74, 0xc2, 0xaa, 0x3b, 0x6f, 0xe9, 0x3f, 0x51, 0x00, 0xa8,
+	0x0f, 0xb8, 0xbc, 0x6f, 0x65, 0xa9, 0xf4, 0x3f, 0x94, 0xe0, 0x54, 0x6c, 0x43, 0x34, 0xa7, 0xe2,
+	0xc9, 0x1c, 0xc7, 0x67, 0x3a, 0xaf, 0x49, 0x0b, 0xc5, 0xab, 0xe6, 0x5a, 0x1e, 0x36, 0xc4, 0x81,
+	0x19, 0x2f, 0xd3, 0xcd, 0xa8, 0x92, 0xc6, 0x11, 0xb5, 0x51, 0xcc, 0x15, 0xcd, 0x64, 0x9d, 0x25,
+	0xf5, 0xfe, 0x75, 0x27, 0xf0, 0x0e, 0xc2, 0xd2, 0x9b, 0xf6, 0x26, 0x34, 0xa5, 0x6e, 0x75, 0x16,
+	0xca, 0x7b, 0xe8, 0x40, 0x4c, 0x94, 0xfe, 0xa4, 0xe8, 0x7b, 0x60, 0xf6, 0x87, 0xd1, 0x6b, 0x31,
+	0x6b, 0x5c, 0x29, 0xbd, 0xa1, 0xe8, 0xbf, 0x56, 0x40, 0xcb, 0xc3, 0x80, 0xc0, 0xe0, 0x71, 0x94,
+	0x4e, 0xdf, 0x84, 0x2a, 0x75, 0x36, 0x0f, 0xcf, 0x39, 0xaf, 0x3c, 0x39, 0xb3, 0x34, 0x38, 0x87,
+	0x6e, 0x32, 0xe3, 0xae, 0xd1, 0xdb, 0x4c, 0x0f, 0x39, 0x16, 0x32, 0x90, 0x4b, 0xbc, 0xe0, 0x50,
+	0x84, 0xaa, 0x50, 0xd9, 0xc3, 0x4e, 0x08, 0x24, 0xf6, 0x5b, 0x42, 0x6d, 0x39, 0x81, 0xda, 0xbf,
+	0x44, 0x87, 0x4f, 0xac, 0xa6, 0x58, 0xf2, 0x13, 0x78, 0x60, 0x60, 0x96, 0x56, 0xd2, 0x96, 0x66,
+	0x93, 0x35, 0x5e, 0x93, 0x74, 0x4d, 0xec, 0x45, 0xaf, 0x44, 0x51, 0x9b, 0x3e, 0xfc, 0x9e, 0xc9,
+	0xf5, 0xd4, 0x31, 0xae, 0xe3, 0x26, 0x34, 0xed, 0x48, 0x7e, 0xb8, 0x9a, 0x05, 0x27, 0x94, 0x64,
+	0x88, 0xcc, 0x74, 0xf9, 0xe7, 0x0b, 0xa0, 0xbe, 0x73, 0x69, 0xe7, 0x1d, 0x4a, 0x7a, 0x7d, 0x3f,
+	0x08, 0x3f, 0x17, 0xe9, 0xb1, 0x3b, 0x63, 0xe2, 0x23, 0x3b, 0xf5, 0xc5, 0xb4, 0xe4, 0x82, 0x8f,
+	0x18, 0xb5, 0xb5, 0xc3, 0x09, 0xb9, 0x17, 0x2e, 0x2a, 0x6a, 0x1b, 0xa6, 0x93, 0xdf, 0xda, 0xa8,
+	0x67, 0xd3, 0xdc, 0xb9, 0x5f, 0x7b, 0x69, 0x2f, 0x1c, 0x46, 0x26, 0x1c, 0x8d, 0x60, 0x36, 0xfd,
+	0x3d, 0x49, 0x76, 0x26, 0x05, 0x9f, 0xe5, 0x68, 0x6b, 0x87, 0x13, 0x0a, 0x35, 0x0e, 0x2f, 0x30,
+	0xa5, 0x3e, 0x5b, 0x50, 0xcf, 0xe5, 0x09, 0xc8, 0xff, 0x28, 0x42, 0x3b, 0x3f, 0x16, 0xad, 0xd0,
+	0xf7, 0x21, 0xcc, 0xe5, 0xbd, 0x34, 0xaa, 0xe7, 0xc7, 0x78, 0xb2, 0x8d, 0x34, 0x5e, 0x18, 0x8f,
+	0x58, 0xa8, 0xc4, 0x30, 0x9f, 0xfb, 0x9c, 0xa8, 0x5e, 0x28, 0x5c, 0xef, 0x9c, 0x57, 0x47, 0x6d,
+	0xe4, 0xc3, 0x8a, 0x90, 0xd8, 0x86, 0xd9, 0xf4, 0xcb, 0x64, 0x76, 0xd1, 0x0a, 0xde, 0x2e, 0xc7,
+	0x52, 0x20, 0x50, 0x21, 0x17, 0xd4, 0xf3, 0x51, 0x91, 0xf3, 0x7a, 0xa2, 0xad, 0x1d, 0x4e, 0x28,
+	0x5c, 0xf6, 0x10, 0x16, 0x0b, 0x6a, 0xf7, 0xea, 0x7a, 0x5a, 0xc8, 0xe8, 0xc7, 0x06, 0x6d, 0x63,
+	0x6c, 0xfa, 0x24, 0x22, 0x53, 0xa5, 0xdb, 0x7c, 0x44, 0xe6, 0x3f, 0x02, 0x68, 0xe7, 0xc7, 0xa2,
+	0x15, 0xfa, 0x3e, 0x80, 0xa6, 0x54, 0x62, 0x55, 0xf5, 0x3c, 0xde, 0x64, 0xb1, 0x57, 0x7b, 0x6e,
+	0x24, 0x8d, 0x90, 0xbb, 0x0d, 0x10, 0x97, 0xcb, 0xd4, 0x67, 0xf3, 0x59, 0xa4, 0x62, 0xac, 0xa6,
+	0x8f, 0x22, 0x11, 0x42, 0xdf, 0x87, 0x7a, 0x58, 0x13, 0x53, 0x57, 0x72, 0xe0, 0x2b, 0x17, 0xba,
+	0xb4, 0xd5, 0x62, 0x82, 0x38, 0xc8, 0xa4, 0xcb, 0x5d, 0x59, 0x38, 0x15, 0xd4, 0xd1, 0xb4, 0xb5,
+	0xc3, 0x09, 0x85, 0x9a, 0x0e, 0xcc, 0xa4, 0x8a, 0x3d, 0x6a, 0x26, 0x0c, 0xe6, 0x97, 0xc5, 0xb4,
+	0x17, 0x0f, 0xa5, 0x13, 0x3a, 0xf6, 0x40, 0xcd, 0x96, 0x5f, 0xd4, 0x97, 0xd2, 0xec, 0x85, 0xa5,
+	0x22, 0xed, 0xdc, 0x38, 0xa4, 0x42, 0xd9, 0x0f, 0x60, 0x2a, 0x51, 0xee, 0x50, 0x33, 0xaf, 0xa6,
+	0x79, 0xc5, 0x1e, 0xed, 0xec, 0x21, 0x54, 0x42, 0x7a, 0x1b, 0xa6, 0x93, 0x45, 0x8c, 0xec, 0xd9,
+	0x92, 0x5b, 0x16, 0xd1, 0x5e, 0x38, 0x8c, 0x2c, 0x65, 0x7e, 0x24, 0x3f, 0xdf, 0xfc, 0xb4, 0xf8,
+	0xb3, 0x87, 0x50, 0x09, 0xe9, 0x77, 0xa0, 0x11, 0x15, 0x36, 0xd4, 0x0c, 0x06, 0xd3, 0xa5, 0x13,
+	0xed, 0xd9, 0x11, 0x14, 0x42, 0x62, 0x00, 0xf3, 0xb9, 0x97, 0xde, 0x6c, 0x04, 0x1f, 0x55, 0x80,
+	0xd0, 0x5e, 0x1e, 0x93, 0x5a, 0x68, 0xdd, 0x85, 0x93, 0x31, 0x41, 0xa8, 0x71, 0x54, 0x0c, 0x4d,
+	0x6a, 0x7b, 0x69, 0x0c, 0x4a, 0xa1, 0x89, 0x64, 0x6f, 0xd1, 0x3c, 0xde, 0xa3, 0x27, 0xa4, 0x70,
+	0x0f, 0xd4, 0x6c, 0xae, 0x9e, 0xdd, 0x2c, 0x85, 0x77, 0x3a, 0xed, 0xdc, 0x38, 0xa4, 0x71, 0x40,
+	0xcf, 0xc9, 0x28, 0xd5, 0x3c, 0x11, 0x05, 0x09, 0xba, 0x76, 0x7e, 0x2c, 0x5a, 0xa1, 0xcf, 0x87,
+	0xb9, 0xbc, 0x4f, 0x31, 0xb3, 0x29, 0xc6, 0x88, 0x2f, 0x3d, 0xb5, 0x0b, 0xe3, 0x11, 0x47, 0xf9,
+	0xe0, 0x8f, 0x60, 0xb1, 0xe0, 0x3b, 0xc4, 0xec, 0x89, 0x39, 0xfa, 0xc3, 0x4a, 0x6d, 0x63, 0x6c,
+	0xfa, 0x50, 0xfb, 0xe6, 0xce, 0xa7, 0x5f, 0x2f, 0x2b, 0x9f, 0x7d, 0xbd, 0xac, 0x7c, 0xf5, 0xf5,
+	0xb2, 0xf2, 0xc9, 0xa3, 0xe5, 0x13, 0x7f, 0x7a, 0xb4, 0xac, 0x7c, 0xf6, 0x68, 0xf9, 0xc4, 0xdf,
+	0x1e, 0x2d, 0x9f, 0xf8, 0xfe, 0x6b, 0x3d, 0x1c, 0xec, 0x0e, 0x3b, 0xeb, 0x16, 0x19, 0x6c, 0x50,
+	0xd1, 0xae, 0x47, 0xa8, 0x14, 0xf6, 0xfb, 0x65, 0xfa, 0x6f, 0x43, 0xee, 0x5e, 0x6f, 0xc3, 0x21,
+	0x5e, 0xb0, 0xdb, 0x21, 0x43, 0xc7, 0xde, 0xb0, 0xfa, 0xf8, 0x2d, 0xab, 0x8f, 0x3b, 0x13, 0x2c,
+	0xa1, 0x7f, 0xe5, 0x3f, 0x03, 0x00, 0x93, 0xaa, 0x4e, 0x28, 0x60, 0x34, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// A1TAdminExtServiceClient is the client API for A1TAdminExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type A1TAdminExtServiceClient interface {
+	GetPolicyHistory(ctx context.Context, in *GetPolicyHistoryRequest, opts ...grpc.CallOption) (A1TAdminExtService_GetPolicyHistoryClient, error)
+	RollbackPolicy(ctx context.Context, in *RollbackPolicyRequest, opts ...grpc.CallOption) (*RollbackPolicyResponse, error)
+	ListXAppSessions(ctx context.Context, in *ListXAppSessionsRequest, opts ...grpc.CallOption) (*ListXAppSessionsResponse, error)
+	ListCircuitBreakers(ctx context.Context, in *ListCircuitBreakersRequest, opts ...grpc.CallOption) (*ListCircuitBreakersResponse, error)
+	BulkPolicyOperations(ctx context.Context, in *BulkPolicyOperationsRequest, opts ...grpc.CallOption) (*BulkPolicyOperationsResponse, error)
+	GetPolicySourceStatus(ctx context.Context, in *GetPolicySourceStatusRequest, opts ...grpc.CallOption) (*PolicySourceStatus, error)
+	SyncPolicySource(ctx context.Context, in *SyncPolicySourceRequest, opts ...grpc.CallOption) (*PolicySourceStatus, error)
+	ListPolicyOwners(ctx context.Context, in *ListPolicyOwnersRequest, opts ...grpc.CallOption) (*ListPolicyOwnersResponse, error)
+	TransferPolicyOwnership(ctx context.Context, in *TransferPolicyOwnershipRequest, opts ...grpc.CallOption) (*TransferPolicyOwnershipResponse, error)
+	ListPolicySchedules(ctx context.Context, in *ListPolicySchedulesRequest, opts ...grpc.CallOption) (*ListPolicySchedulesResponse, error)
+	ListEITypes(ctx context.Context, in *ListEITypesRequest, opts ...grpc.CallOption) (*ListEITypesResponse, error)
+	ListEIJobs(ctx context.Context, in *ListEIJobsRequest, opts ...grpc.CallOption) (*ListEIJobsResponse, error)
+	GetEIJob(ctx context.Context, in *GetEIJobRequest, opts ...grpc.CallOption) (*GetEIJobResponse, error)
+	ForceDeleteEIJob(ctx context.Context, in *ForceDeleteEIJobRequest, opts ...grpc.CallOption) (*ForceDeleteEIJobResponse, error)
+	PutPolicyObject(ctx context.Context, in *PutPolicyObjectRequest, opts ...grpc.CallOption) (*PutPolicyObjectResponse, error)
+	DeletePolicyObject(ctx context.Context, in *DeletePolicyObjectRequest, opts ...grpc.CallOption) (*DeletePolicyObjectResponse, error)
+	ReconcileXApp(ctx context.Context, in *ReconcileXAppRequest, opts ...grpc.CallOption) (*ReconcileXAppResponse, error)
+	DisconnectXApp(ctx context.Context, in *DisconnectXAppRequest, opts ...grpc.CallOption) (*DisconnectXAppResponse, error)
+	ReconnectXApp(ctx context.Context, in *ReconnectXAppRequest, opts ...grpc.CallOption) (*ReconnectXAppResponse, error)
+	DrainXApp(ctx context.Context, in *DrainXAppRequest, opts ...grpc.CallOption) (*DrainXAppResponse, error)
+	ListPolicyTypeObjects(ctx context.Context, in *ListPolicyTypeObjectsRequest, opts ...grpc.CallOption) (*ListPolicyTypeObjectsResponse, error)
+	ListPolicyObjects(ctx context.Context, in *ListPolicyObjectsRequest, opts ...grpc.CallOption) (*ListPolicyObjectsResponse, error)
+	ListPolicyObjectStatuses(ctx context.Context, in *ListPolicyObjectsRequest, opts ...grpc.CallOption) (*ListPolicyObjectsResponse, error)
+	GetPolicyInventory(ctx context.Context, in *GetPolicyInventoryRequest, opts ...grpc.CallOption) (*GetPolicyInventoryResponse, error)
+	GetDivergenceReport(ctx context.Context, in *GetDivergenceReportRequest, opts ...grpc.CallOption) (*GetDivergenceReportResponse, error)
+	WatchCircuitBreakers(ctx context.Context, in *WatchCircuitBreakersRequest, opts ...grpc.CallOption) (A1TAdminExtService_WatchCircuitBreakersClient, error)
+	WatchPolicyObjectStatus(ctx context.Context, in *WatchPolicyObjectStatusRequest, opts ...grpc.CallOption) (A1TAdminExtService_WatchPolicyObjectStatusClient, error)
+}
+
+type a1TAdminExtServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewA1TAdminExtServiceClient(cc *grpc.ClientConn) A1TAdminExtServiceClient {
+	return &a1TAdminExtServiceClient{cc}
+}
+
+func (c *a1TAdminExtServiceClient) GetPolicyHistory(ctx context.Context, in *GetPolicyHistoryRequest, opts ...grpc.CallOption) (A1TAdminExtService_GetPolicyHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_A1TAdminExtService_serviceDesc.Streams[0], "/onos.a1t.admin.A1TAdminExtService/GetPolicyHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &a1TAdminExtServiceGetPolicyHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type A1TAdminExtService_GetPolicyHistoryClient interface {
+	Recv() (*GetPolicyHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type a1TAdminExtServiceGetPolicyHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *a1TAdminExtServiceGetPolicyHistoryClient) Recv() (*GetPolicyHistoryResponse, error) {
+	m := new(GetPolicyHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *a1TAdminExtServiceClient) RollbackPolicy(ctx context.Context, in *RollbackPolicyRequest, opts ...grpc.CallOption) (*RollbackPolicyResponse, error) {
+	out := new(RollbackPolicyResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/RollbackPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ListXAppSessions(ctx context.Context, in *ListXAppSessionsRequest, opts ...grpc.CallOption) (*ListXAppSessionsResponse, error) {
+	out := new(ListXAppSessionsResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ListXAppSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ListCircuitBreakers(ctx context.Context, in *ListCircuitBreakersRequest, opts ...grpc.CallOption) (*ListCircuitBreakersResponse, error) {
+	out := new(ListCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ListCircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) BulkPolicyOperations(ctx context.Context, in *BulkPolicyOperationsRequest, opts ...grpc.CallOption) (*BulkPolicyOperationsResponse, error) {
+	out := new(BulkPolicyOperationsResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/BulkPolicyOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) GetPolicySourceStatus(ctx context.Context, in *GetPolicySourceStatusRequest, opts ...grpc.CallOption) (*PolicySourceStatus, error) {
+	out := new(PolicySourceStatus)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/GetPolicySourceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) SyncPolicySource(ctx context.Context, in *SyncPolicySourceRequest, opts ...grpc.CallOption) (*PolicySourceStatus, error) {
+	out := new(PolicySourceStatus)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/SyncPolicySource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ListPolicyOwners(ctx context.Context, in *ListPolicyOwnersRequest, opts ...grpc.CallOption) (*ListPolicyOwnersResponse, error) {
+	out := new(ListPolicyOwnersResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ListPolicyOwners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) TransferPolicyOwnership(ctx context.Context, in *TransferPolicyOwnershipRequest, opts ...grpc.CallOption) (*TransferPolicyOwnershipResponse, error) {
+	out := new(TransferPolicyOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/TransferPolicyOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ListPolicySchedules(ctx context.Context, in *ListPolicySchedulesRequest, opts ...grpc.CallOption) (*ListPolicySchedulesResponse, error) {
+	out := new(ListPolicySchedulesResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ListPolicySchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ListEITypes(ctx context.Context, in *ListEITypesRequest, opts ...grpc.CallOption) (*ListEITypesResponse, error) {
+	out := new(ListEITypesResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ListEITypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ListEIJobs(ctx context.Context, in *ListEIJobsRequest, opts ...grpc.CallOption) (*ListEIJobsResponse, error) {
+	out := new(ListEIJobsResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ListEIJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) GetEIJob(ctx context.Context, in *GetEIJobRequest, opts ...grpc.CallOption) (*GetEIJobResponse, error) {
+	out := new(GetEIJobResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/GetEIJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ForceDeleteEIJob(ctx context.Context, in *ForceDeleteEIJobRequest, opts ...grpc.CallOption) (*ForceDeleteEIJobResponse, error) {
+	out := new(ForceDeleteEIJobResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ForceDeleteEIJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) PutPolicyObject(ctx context.Context, in *PutPolicyObjectRequest, opts ...grpc.CallOption) (*PutPolicyObjectResponse, error) {
+	out := new(PutPolicyObjectResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/PutPolicyObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) DeletePolicyObject(ctx context.Context, in *DeletePolicyObjectRequest, opts ...grpc.CallOption) (*DeletePolicyObjectResponse, error) {
+	out := new(DeletePolicyObjectResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/DeletePolicyObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ReconcileXApp(ctx context.Context, in *ReconcileXAppRequest, opts ...grpc.CallOption) (*ReconcileXAppResponse, error) {
+	out := new(ReconcileXAppResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ReconcileXApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) DisconnectXApp(ctx context.Context, in *DisconnectXAppRequest, opts ...grpc.CallOption) (*DisconnectXAppResponse, error) {
+	out := new(DisconnectXAppResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/DisconnectXApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ReconnectXApp(ctx context.Context, in *ReconnectXAppRequest, opts ...grpc.CallOption) (*ReconnectXAppResponse, error) {
+	out := new(ReconnectXAppResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ReconnectXApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) DrainXApp(ctx context.Context, in *DrainXAppRequest, opts ...grpc.CallOption) (*DrainXAppResponse, error) {
+	out := new(DrainXAppResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/DrainXApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ListPolicyTypeObjects(ctx context.Context, in *ListPolicyTypeObjectsRequest, opts ...grpc.CallOption) (*ListPolicyTypeObjectsResponse, error) {
+	out := new(ListPolicyTypeObjectsResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ListPolicyTypeObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ListPolicyObjects(ctx context.Context, in *ListPolicyObjectsRequest, opts ...grpc.CallOption) (*ListPolicyObjectsResponse, error) {
+	out := new(ListPolicyObjectsResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ListPolicyObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) ListPolicyObjectStatuses(ctx context.Context, in *ListPolicyObjectsRequest, opts ...grpc.CallOption) (*ListPolicyObjectsResponse, error) {
+	out := new(ListPolicyObjectsResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/ListPolicyObjectStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) GetPolicyInventory(ctx context.Context, in *GetPolicyInventoryRequest, opts ...grpc.CallOption) (*GetPolicyInventoryResponse, error) {
+	out := new(GetPolicyInventoryResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/GetPolicyInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) GetDivergenceReport(ctx context.Context, in *GetDivergenceReportRequest, opts ...grpc.CallOption) (*GetDivergenceReportResponse, error) {
+	out := new(GetDivergenceReportResponse)
+	err := c.cc.Invoke(ctx, "/onos.a1t.admin.A1TAdminExtService/GetDivergenceReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *a1TAdminExtServiceClient) WatchCircuitBreakers(ctx context.Context, in *WatchCircuitBreakersRequest, opts ...grpc.CallOption) (A1TAdminExtService_WatchCircuitBreakersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_A1TAdminExtService_serviceDesc.Streams[1], "/onos.a1t.admin.A1TAdminExtService/WatchCircuitBreakers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &a1TAdminExtServiceWatchCircuitBreakersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type A1TAdminExtService_WatchCircuitBreakersClient interface {
+	Recv() (*WatchCircuitBreakersResponse, error)
+	grpc.ClientStream
+}
+
+type a1TAdminExtServiceWatchCircuitBreakersClient struct {
+	grpc.ClientStream
+}
+
+func (x *a1TAdminExtServiceWatchCircuitBreakersClient) Recv() (*WatchCircuitBreakersResponse, error) {
+	m := new(WatchCircuitBreakersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *a1TAdminExtServiceClient) WatchPolicyObjectStatus(ctx context.Context, in *WatchPolicyObjectStatusRequest, opts ...grpc.CallOption) (A1TAdminExtService_WatchPolicyObjectStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_A1TAdminExtService_serviceDesc.Streams[2], "/onos.a1t.admin.A1TAdminExtService/WatchPolicyObjectStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &a1TAdminExtServiceWatchPolicyObjectStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type A1TAdminExtService_WatchPolicyObjectStatusClient interface {
+	Recv() (*WatchPolicyObjectStatusResponse, error)
+	grpc.ClientStream
+}
+
+type a1TAdminExtServiceWatchPolicyObjectStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *a1TAdminExtServiceWatchPolicyObjectStatusClient) Recv() (*WatchPolicyObjectStatusResponse, error) {
+	m := new(WatchPolicyObjectStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// A1TAdminExtServiceServer is the server API for A1TAdminExtService service.
+type A1TAdminExtServiceServer interface {
+	GetPolicyHistory(*GetPolicyHistoryRequest, A1TAdminExtService_GetPolicyHistoryServer) error
+	RollbackPolicy(context.Context, *RollbackPolicyRequest) (*RollbackPolicyResponse, error)
+	ListXAppSessions(context.Context, *ListXAppSessionsRequest) (*ListXAppSessionsResponse, error)
+	ListCircuitBreakers(context.Context, *ListCircuitBreakersRequest) (*ListCircuitBreakersResponse, error)
+	BulkPolicyOperations(context.Context, *BulkPolicyOperationsRequest) (*BulkPolicyOperationsResponse, error)
+	GetPolicySourceStatus(context.Context, *GetPolicySourceStatusRequest) (*PolicySourceStatus, error)
+	SyncPolicySource(context.Context, *SyncPolicySourceRequest) (*PolicySourceStatus, error)
+	ListPolicyOwners(context.Context, *ListPolicyOwnersRequest) (*ListPolicyOwnersResponse, error)
+	TransferPolicyOwnership(context.Context, *TransferPolicyOwnershipRequest) (*TransferPolicyOwnershipResponse, error)
+	ListPolicySchedules(context.Context, *ListPolicySchedulesRequest) (*ListPolicySchedulesResponse, error)
+	ListEITypes(context.Context, *ListEITypesRequest) (*ListEITypesResponse, error)
+	ListEIJobs(context.Context, *ListEIJobsRequest) (*ListEIJobsResponse, error)
+	GetEIJob(context.Context, *GetEIJobRequest) (*GetEIJobResponse, error)
+	ForceDeleteEIJob(context.Context, *ForceDeleteEIJobRequest) (*ForceDeleteEIJobResponse, error)
+	PutPolicyObject(context.Context, *PutPolicyObjectRequest) (*PutPolicyObjectResponse, error)
+	DeletePolicyObject(context.Context, *DeletePolicyObjectRequest) (*DeletePolicyObjectResponse, error)
+	ReconcileXApp(context.Context, *ReconcileXAppRequest) (*ReconcileXAppResponse, error)
+	DisconnectXApp(context.Context, *DisconnectXAppRequest) (*DisconnectXAppResponse, error)
+	ReconnectXApp(context.Context, *ReconnectXAppRequest) (*ReconnectXAppResponse, error)
+	DrainXApp(context.Context, *DrainXAppRequest) (*DrainXAppResponse, error)
+	ListPolicyTypeObjects(context.Context, *ListPolicyTypeObjectsRequest) (*ListPolicyTypeObjectsResponse, error)
+	ListPolicyObjects(context.Context, *ListPolicyObjectsRequest) (*ListPolicyObjectsResponse, error)
+	ListPolicyObjectStatuses(context.Context, *ListPolicyObjectsRequest) (*ListPolicyObjectsResponse, error)
+	GetPolicyInventory(context.Context, *GetPolicyInventoryRequest) (*GetPolicyInventoryResponse, error)
+	GetDivergenceReport(context.Context, *GetDivergenceReportRequest) (*GetDivergenceReportResponse, error)
+	WatchCircuitBreakers(*WatchCircuitBreakersRequest, A1TAdminExtService_WatchCircuitBreakersServer) error
+	WatchPolicyObjectStatus(*WatchPolicyObjectStatusRequest, A1TAdminExtService_WatchPolicyObjectStatusServer) error
+}
+
+// UnimplementedA1TAdminExtServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedA1TAdminExtServiceServer struct {
+}
+
+func (*UnimplementedA1TAdminExtServiceServer) GetPolicyHistory(req *GetPolicyHistoryRequest, srv A1TAdminExtService_GetPolicyHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPolicyHistory not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) RollbackPolicy(ctx context.Context, req *RollbackPolicyRequest) (*RollbackPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPolicy not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ListXAppSessions(ctx context.Context, req *ListXAppSessionsRequest) (*ListXAppSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListXAppSessions not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ListCircuitBreakers(ctx context.Context, req *ListCircuitBreakersRequest) (*ListCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCircuitBreakers not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) BulkPolicyOperations(ctx context.Context, req *BulkPolicyOperationsRequest) (*BulkPolicyOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkPolicyOperations not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) GetPolicySourceStatus(ctx context.Context, req *GetPolicySourceStatusRequest) (*PolicySourceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicySourceStatus not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) SyncPolicySource(ctx context.Context, req *SyncPolicySourceRequest) (*PolicySourceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncPolicySource not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ListPolicyOwners(ctx context.Context, req *ListPolicyOwnersRequest) (*ListPolicyOwnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyOwners not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) TransferPolicyOwnership(ctx context.Context, req *TransferPolicyOwnershipRequest) (*TransferPolicyOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPolicyOwnership not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ListPolicySchedules(ctx context.Context, req *ListPolicySchedulesRequest) (*ListPolicySchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicySchedules not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ListEITypes(ctx context.Context, req *ListEITypesRequest) (*ListEITypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEITypes not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ListEIJobs(ctx context.Context, req *ListEIJobsRequest) (*ListEIJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEIJobs not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) GetEIJob(ctx context.Context, req *GetEIJobRequest) (*GetEIJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEIJob not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ForceDeleteEIJob(ctx context.Context, req *ForceDeleteEIJobRequest) (*ForceDeleteEIJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceDeleteEIJob not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) PutPolicyObject(ctx context.Context, req *PutPolicyObjectRequest) (*PutPolicyObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutPolicyObject not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) DeletePolicyObject(ctx context.Context, req *DeletePolicyObjectRequest) (*DeletePolicyObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicyObject not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ReconcileXApp(ctx context.Context, req *ReconcileXAppRequest) (*ReconcileXAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileXApp not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) DisconnectXApp(ctx context.Context, req *DisconnectXAppRequest) (*DisconnectXAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectXApp not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ReconnectXApp(ctx context.Context, req *ReconnectXAppRequest) (*ReconnectXAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconnectXApp not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) DrainXApp(ctx context.Context, req *DrainXAppRequest) (*DrainXAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainXApp not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ListPolicyTypeObjects(ctx context.Context, req *ListPolicyTypeObjectsRequest) (*ListPolicyTypeObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyTypeObjects not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ListPolicyObjects(ctx context.Context, req *ListPolicyObjectsRequest) (*ListPolicyObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyObjects not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) ListPolicyObjectStatuses(ctx context.Context, req *ListPolicyObjectsRequest) (*ListPolicyObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyObjectStatuses not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) GetPolicyInventory(ctx context.Context, req *GetPolicyInventoryRequest) (*GetPolicyInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyInventory not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) GetDivergenceReport(ctx context.Context, req *GetDivergenceReportRequest) (*GetDivergenceReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDivergenceReport not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) WatchCircuitBreakers(req *WatchCircuitBreakersRequest, srv A1TAdminExtService_WatchCircuitBreakersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCircuitBreakers not implemented")
+}
+func (*UnimplementedA1TAdminExtServiceServer) WatchPolicyObjectStatus(req *WatchPolicyObjectStatusRequest, srv A1TAdminExtService_WatchPolicyObjectStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPolicyObjectStatus not implemented")
+}
+
+func RegisterA1TAdminExtServiceServer(s *grpc.Server, srv A1TAdminExtServiceServer) {
+	s.RegisterService(&_A1TAdminExtService_serviceDesc, srv)
+}
+
+func _A1TAdminExtService_GetPolicyHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPolicyHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(A1TAdminExtServiceServer).GetPolicyHistory(m, &a1TAdminExtServiceGetPolicyHistoryServer{stream})
+}
+
+type A1TAdminExtService_GetPolicyHistoryServer interface {
+	Send(*GetPolicyHistoryResponse) error
+	grpc.ServerStream
+}
+
+type a1TAdminExtServiceGetPolicyHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *a1TAdminExtServiceGetPolicyHistoryServer) Send(m *GetPolicyHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _A1TAdminExtService_RollbackPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).RollbackPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/RollbackPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).RollbackPolicy(ctx, req.(*RollbackPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ListXAppSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListXAppSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ListXAppSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ListXAppSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ListXAppSessions(ctx, req.(*ListXAppSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ListCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ListCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ListCircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ListCircuitBreakers(ctx, req.(*ListCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_BulkPolicyOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkPolicyOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).BulkPolicyOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/BulkPolicyOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).BulkPolicyOperations(ctx, req.(*BulkPolicyOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_GetPolicySourceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicySourceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).GetPolicySourceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/GetPolicySourceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).GetPolicySourceStatus(ctx, req.(*GetPolicySourceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_SyncPolicySource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncPolicySourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).SyncPolicySource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/SyncPolicySource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).SyncPolicySource(ctx, req.(*SyncPolicySourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ListPolicyOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyOwnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ListPolicyOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ListPolicyOwners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ListPolicyOwners(ctx, req.(*ListPolicyOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_TransferPolicyOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferPolicyOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).TransferPolicyOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/TransferPolicyOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).TransferPolicyOwnership(ctx, req.(*TransferPolicyOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ListPolicySchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicySchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ListPolicySchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ListPolicySchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ListPolicySchedules(ctx, req.(*ListPolicySchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ListEITypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEITypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ListEITypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ListEITypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ListEITypes(ctx, req.(*ListEITypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ListEIJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEIJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ListEIJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ListEIJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ListEIJobs(ctx, req.(*ListEIJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_GetEIJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEIJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).GetEIJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/GetEIJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).GetEIJob(ctx, req.(*GetEIJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ForceDeleteEIJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceDeleteEIJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ForceDeleteEIJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ForceDeleteEIJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ForceDeleteEIJob(ctx, req.(*ForceDeleteEIJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_PutPolicyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutPolicyObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).PutPolicyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/PutPolicyObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).PutPolicyObject(ctx, req.(*PutPolicyObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_DeletePolicyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).DeletePolicyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/DeletePolicyObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).DeletePolicyObject(ctx, req.(*DeletePolicyObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ReconcileXApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileXAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ReconcileXApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ReconcileXApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ReconcileXApp(ctx, req.(*ReconcileXAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_DisconnectXApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectXAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).DisconnectXApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/DisconnectXApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).DisconnectXApp(ctx, req.(*DisconnectXAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ReconnectXApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconnectXAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ReconnectXApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ReconnectXApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ReconnectXApp(ctx, req.(*ReconnectXAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_DrainXApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainXAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).DrainXApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/DrainXApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).DrainXApp(ctx, req.(*DrainXAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ListPolicyTypeObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyTypeObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ListPolicyTypeObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ListPolicyTypeObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ListPolicyTypeObjects(ctx, req.(*ListPolicyTypeObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ListPolicyObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ListPolicyObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ListPolicyObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ListPolicyObjects(ctx, req.(*ListPolicyObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_ListPolicyObjectStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).ListPolicyObjectStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/ListPolicyObjectStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).ListPolicyObjectStatuses(ctx, req.(*ListPolicyObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_GetPolicyInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).GetPolicyInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/GetPolicyInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).GetPolicyInventory(ctx, req.(*GetPolicyInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_GetDivergenceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDivergenceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(A1TAdminExtServiceServer).GetDivergenceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.a1t.admin.A1TAdminExtService/GetDivergenceReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(A1TAdminExtServiceServer).GetDivergenceReport(ctx, req.(*GetDivergenceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _A1TAdminExtService_WatchCircuitBreakers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCircuitBreakersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(A1TAdminExtServiceServer).WatchCircuitBreakers(m, &a1TAdminExtServiceWatchCircuitBreakersServer{stream})
+}
+
+type A1TAdminExtService_WatchCircuitBreakersServer interface {
+	Send(*WatchCircuitBreakersResponse) error
+	grpc.ServerStream
+}
+
+type a1TAdminExtServiceWatchCircuitBreakersServer struct {
+	grpc.ServerStream
+}
+
+func (x *a1TAdminExtServiceWatchCircuitBreakersServer) Send(m *WatchCircuitBreakersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _A1TAdminExtService_WatchPolicyObjectStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPolicyObjectStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(A1TAdminExtServiceServer).WatchPolicyObjectStatus(m, &a1TAdminExtServiceWatchPolicyObjectStatusServer{stream})
+}
+
+type A1TAdminExtService_WatchPolicyObjectStatusServer interface {
+	Send(*WatchPolicyObjectStatusResponse) error
+	grpc.ServerStream
+}
+
+type a1TAdminExtServiceWatchPolicyObjectStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *a1TAdminExtServiceWatchPolicyObjectStatusServer) Send(m *WatchPolicyObjectStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _A1TAdminExtService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "onos.a1t.admin.A1TAdminExtService",
+	HandlerType: (*A1TAdminExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RollbackPolicy",
+			Handler:    _A1TAdminExtService_RollbackPolicy_Handler,
+		},
+		{
+			MethodName: "ListXAppSessions",
+			Handler:    _A1TAdminExtService_ListXAppSessions_Handler,
+		},
+		{
+			MethodName: "ListCircuitBreakers",
+			Handler:    _A1TAdminExtService_ListCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "BulkPolicyOperations",
+			Handler:    _A1TAdminExtService_BulkPolicyOperations_Handler,
+		},
+		{
+			MethodName: "GetPolicySourceStatus",
+			Handler:    _A1TAdminExtService_GetPolicySourceStatus_Handler,
+		},
+		{
+			MethodName: "SyncPolicySource",
+			Handler:    _A1TAdminExtService_SyncPolicySource_Handler,
+		},
+		{
+			MethodName: "ListPolicyOwners",
+			Handler:    _A1TAdminExtService_ListPolicyOwners_Handler,
+		},
+		{
+			MethodName: "TransferPolicyOwnership",
+			Handler:    _A1TAdminExtService_TransferPolicyOwnership_Handler,
+		},
+		{
+			MethodName: "ListPolicySchedules",
+			Handler:    _A1TAdminExtService_ListPolicySchedules_Handler,
+		},
+		{
+			MethodName: "ListEITypes",
+			Handler:    _A1TAdminExtService_ListEITypes_Handler,
+		},
+		{
+			MethodName: "ListEIJobs",
+			Handler:    _A1TAdminExtService_ListEIJobs_Handler,
+		},
+		{
+			MethodName: "GetEIJob",
+			Handler:    _A1TAdminExtService_GetEIJob_Handler,
+		},
+		{
+			MethodName: "ForceDeleteEIJob",
+			Handler:    _A1TAdminExtService_ForceDeleteEIJob_Handler,
+		},
+		{
+			MethodName: "PutPolicyObject",
+			Handler:    _A1TAdminExtService_PutPolicyObject_Handler,
+		},
+		{
+			MethodName: "DeletePolicyObject",
+			Handler:    _A1TAdminExtService_DeletePolicyObject_Handler,
+		},
+		{
+			MethodName: "ReconcileXApp",
+			Handler:    _A1TAdminExtService_ReconcileXApp_Handler,
+		},
+		{
+			MethodName: "DisconnectXApp",
+			Handler:    _A1TAdminExtService_DisconnectXApp_Handler,
+		},
+		{
+			MethodName: "ReconnectXApp",
+			Handler:    _A1TAdminExtService_ReconnectXApp_Handler,
+		},
+		{
+			MethodName: "DrainXApp",
+			Handler:    _A1TAdminExtService_DrainXApp_Handler,
+		},
+		{
+			MethodName: "ListPolicyTypeObjects",
+			Handler:    _A1TAdminExtService_ListPolicyTypeObjects_Handler,
+		},
+		{
+			MethodName: "ListPolicyObjects",
+			Handler:    _A1TAdminExtService_ListPolicyObjects_Handler,
+		},
+		{
+			MethodName: "ListPolicyObjectStatuses",
+			Handler:    _A1TAdminExtService_ListPolicyObjectStatuses_Handler,
+		},
+		{
+			MethodName: "GetPolicyInventory",
+			Handler:    _A1TAdminExtService_GetPolicyInventory_Handler,
+		},
+		{
+			MethodName: "GetDivergenceReport",
+			Handler:    _A1TAdminExtService_GetDivergenceReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetPolicyHistory",
+			Handler:       _A1TAdminExtService_GetPolicyHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCircuitBreakers",
+			Handler:       _A1TAdminExtService_WatchCircuitBreakers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchPolicyObjectStatus",
+			Handler:       _A1TAdminExtService_WatchPolicyObjectStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "onos/a1t/admin/admin_ext.proto",
+}
+
+func (m *GetPolicyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPolicyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPolicyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Until, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Until):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAdminExt(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Since):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAdminExt(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *XAppOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XAppOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *XAppOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPolicyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPolicyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPolicyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.XappOutcomes) > 0 {
+		for iNdEx := len(m.XappOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.XappOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TargetXappIds) > 0 {
+		for iNdEx := len(m.TargetXappIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetXappIds[iNdEx])
+			copy(dAtA[i:], m.TargetXappIds[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.TargetXappIds[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.NewPolicyObject) > 0 {
+		i -= len(m.NewPolicyObject)
+		copy(dAtA[i:], m.NewPolicyObject)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.NewPolicyObject)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.OldPolicyObject) > 0 {
+		i -= len(m.OldPolicyObject)
+		copy(dAtA[i:], m.OldPolicyObject)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.OldPolicyObject)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAdminExt(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Sequence != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAdminExt(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.Revision != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ChangedFields) > 0 {
+		for iNdEx := len(m.ChangedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFields[iNdEx])
+			copy(dAtA[i:], m.ChangedFields[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.ChangedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TargetPolicyObject) > 0 {
+		i -= len(m.TargetPolicyObject)
+		copy(dAtA[i:], m.TargetPolicyObject)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.TargetPolicyObject)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CurrentPolicyObject) > 0 {
+		i -= len(m.CurrentPolicyObject)
+		copy(dAtA[i:], m.CurrentPolicyObject)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.CurrentPolicyObject)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ToRevision != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.ToRevision))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromRevision != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.FromRevision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rollbacks) > 0 {
+		for iNdEx := len(m.Rollbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rollbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListXAppSessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListXAppSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListXAppSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *XAppSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XAppSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *XAppSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Since):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAdminExt(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.A1Service) > 0 {
+		i -= len(m.A1Service)
+		copy(dAtA[i:], m.A1Service)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.A1Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListXAppSessionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListXAppSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListXAppSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCircuitBreakersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCircuitBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCircuitBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Since):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAdminExt(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchCircuitBreakersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchCircuitBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchCircuitBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintAdminExt(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ToState) > 0 {
+		i -= len(m.ToState)
+		copy(dAtA[i:], m.ToState)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.ToState)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromState) > 0 {
+		i -= len(m.FromState)
+		copy(dAtA[i:], m.FromState)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.FromState)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchPolicyObjectStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchPolicyObjectStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchPolicyObjectStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchPolicyObjectStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchPolicyObjectStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchPolicyObjectStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintAdminExt(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PolicyObjectStatus) > 0 {
+		i -= len(m.PolicyObjectStatus)
+		copy(dAtA[i:], m.PolicyObjectStatus)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectStatus)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkPolicyOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkPolicyOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkPolicyOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PolicyObject) > 0 {
+		i -= len(m.PolicyObject)
+		copy(dAtA[i:], m.PolicyObject)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObject)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NotificationDestination) > 0 {
+		i -= len(m.NotificationDestination)
+		copy(dAtA[i:], m.NotificationDestination)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.NotificationDestination)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkPolicyOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkPolicyOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkPolicyOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Atomic {
+		i--
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkPolicyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkPolicyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkPolicyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Compensated {
+		i--
+		if m.Compensated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkPolicyOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkPolicyOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkPolicyOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPolicySourceStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPolicySourceStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPolicySourceStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncPolicySourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicySourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPolicySourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicySourceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicySourceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicySourceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicySourceConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicySourceConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicySourceConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicySourceDrift) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicySourceDrift) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicySourceDrift) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Corrected {
+		i--
+		if m.Corrected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Preexisting {
+		i--
+		if m.Preexisting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicySourceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicySourceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicySourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Drift) > 0 {
+		for iNdEx := len(m.Drift) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Drift[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Manifests != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.Manifests))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintAdminExt(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPolicyOwnersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPolicyOwnersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPolicyOwnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPolicyOwnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPolicyOwnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPolicyOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferPolicyOwnershipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferPolicyOwnershipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferPolicyOwnershipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferPolicyOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferPolicyOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferPolicyOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdminExt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPolicySchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPolicySchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPolicySchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicySchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicySchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicySchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextTransition, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextTransition):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintAdminExt(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x4a
+	if m.Deployed {
+		i--
+		if m.Deployed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RecurrenceUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RecurrenceUntil):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintAdminExt(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Recurrence) > 0 {
+		i -= len(m.Recurrence)
+		copy(dAtA[i:], m.Recurrence)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Recurrence)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ValidUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ValidUntil):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintAdminExt(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ValidFrom):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintAdminExt(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPolicySchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPolicySchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPolicySchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListEITypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListEITypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListEITypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EIType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EIType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EIType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jobs != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.Jobs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.XappIds) > 0 {
+		for iNdEx := len(m.XappIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.XappIds[iNdEx])
+			copy(dAtA[i:], m.XappIds[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EiTypeId) > 0 {
+		i -= len(m.EiTypeId)
+		copy(dAtA[i:], m.EiTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.EiTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListEITypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListEITypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListEITypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EiTypes) > 0 {
+		for iNdEx := len(m.EiTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EiTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListEIJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListEIJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListEIJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EiTypeId) > 0 {
+		i -= len(m.EiTypeId)
+		copy(dAtA[i:], m.EiTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.EiTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EIJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EIJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EIJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastDeliveryError) > 0 {
+		i -= len(m.LastDeliveryError)
+		copy(dAtA[i:], m.LastDeliveryError)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.LastDeliveryError)))
+		i--
+		dAtA[i] = 0x62
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastResultTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastResultTimestamp):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintAdminExt(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.LastResult) > 0 {
+		i -= len(m.LastResult)
+		copy(dAtA[i:], m.LastResult)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.LastResult)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintAdminExt(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x4a
+	if m.Orphaned {
+		i--
+		if m.Orphaned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.JobResultUri) > 0 {
+		i -= len(m.JobResultUri)
+		copy(dAtA[i:], m.JobResultUri)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.JobResultUri)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.JobDefinition) > 0 {
+		i -= len(m.JobDefinition)
+		copy(dAtA[i:], m.JobDefinition)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.JobDefinition)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsumerXappIds) > 0 {
+		for iNdEx := len(m.ConsumerXappIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerXappIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerXappIds[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.ConsumerXappIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EiTypeId) > 0 {
+		i -= len(m.EiTypeId)
+		copy(dAtA[i:], m.EiTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.EiTypeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EiJobId) > 0 {
+		i -= len(m.EiJobId)
+		copy(dAtA[i:], m.EiJobId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.EiJobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListEIJobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListEIJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListEIJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEIJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEIJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEIJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EiJobId) > 0 {
+		i -= len(m.EiJobId)
+		copy(dAtA[i:], m.EiJobId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.EiJobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEIJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEIJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEIJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdminExt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceDeleteEIJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceDeleteEIJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceDeleteEIJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EiJobId) > 0 {
+		i -= len(m.EiJobId)
+		copy(dAtA[i:], m.EiJobId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.EiJobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceDeleteEIJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceDeleteEIJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceDeleteEIJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemoteDeleted {
+		i--
+		if m.RemoteDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EiJobId) > 0 {
+		i -= len(m.EiJobId)
+		copy(dAtA[i:], m.EiJobId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.EiJobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutPolicyObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutPolicyObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutPolicyObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IfNoneMatch) > 0 {
+		for iNdEx := len(m.IfNoneMatch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IfNoneMatch[iNdEx])
+			copy(dAtA[i:], m.IfNoneMatch[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.IfNoneMatch[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.IfMatch) > 0 {
+		for iNdEx := len(m.IfMatch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IfMatch[iNdEx])
+			copy(dAtA[i:], m.IfMatch[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.IfMatch[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.NotificationDestination) > 0 {
+		i -= len(m.NotificationDestination)
+		copy(dAtA[i:], m.NotificationDestination)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.NotificationDestination)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PolicyObject) > 0 {
+		i -= len(m.PolicyObject)
+		copy(dAtA[i:], m.PolicyObject)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutPolicyObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutPolicyObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutPolicyObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Etag) > 0 {
+		i -= len(m.Etag)
+		copy(dAtA[i:], m.Etag)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Etag)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Created {
+		i--
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePolicyObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePolicyObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePolicyObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IfMatch) > 0 {
+		for iNdEx := len(m.IfMatch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IfMatch[iNdEx])
+			copy(dAtA[i:], m.IfMatch[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.IfMatch[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePolicyObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePolicyObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePolicyObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileXAppRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileXAppRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileXAppRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyReconcile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyReconcile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyReconcile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileXAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileXAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileXAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisconnectXAppRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisconnectXAppRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisconnectXAppRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisconnectXAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisconnectXAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisconnectXAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconnectXAppRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconnectXAppRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconnectXAppRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconnectXAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconnectXAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconnectXAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainXAppRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainXAppRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainXAppRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Drained {
+		i--
+		if m.Drained {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainXAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainXAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainXAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DrainedXappIds) > 0 {
+		for iNdEx := len(m.DrainedXappIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DrainedXappIds[iNdEx])
+			copy(dAtA[i:], m.DrainedXappIds[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.DrainedXappIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *XAppQueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XAppQueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *XAppQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cached {
+		i--
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPolicyTypeObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPolicyTypeObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPolicyTypeObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyTypeObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyTypeObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyTypeObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.XappResults) > 0 {
+		for iNdEx := len(m.XappResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.XappResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PolicyTypeObject) > 0 {
+		i -= len(m.PolicyTypeObject)
+		copy(dAtA[i:], m.PolicyTypeObject)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeObject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyIds) > 0 {
+		for iNdEx := len(m.PolicyIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PolicyIds[iNdEx])
+			copy(dAtA[i:], m.PolicyIds[iNdEx])
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPolicyTypeObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPolicyTypeObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPolicyTypeObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypes) > 0 {
+		for iNdEx := len(m.PolicyTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PolicyTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPolicyObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPolicyObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPolicyObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintAdminExt(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.XappResults) > 0 {
+		for iNdEx := len(m.XappResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.XappResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PolicyObject) > 0 {
+		i -= len(m.PolicyObject)
+		copy(dAtA[i:], m.PolicyObject)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPolicyObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPolicyObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPolicyObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPolicyInventoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPolicyInventoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPolicyInventoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rescan {
+		i--
+		if m.Rescan {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *XAppPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XAppPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *XAppPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PolicyObject) > 0 {
+		i -= len(m.PolicyObject)
+		copy(dAtA[i:], m.PolicyObject)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *XAppPolicyInventory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XAppPolicyInventory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *XAppPolicyInventory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for k := range m.Errors {
+			v := m.Errors[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdminExt(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdminExt(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintAdminExt(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x12
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPolicyInventoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPolicyInventoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPolicyInventoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Xapps) > 0 {
+		for iNdEx := len(m.Xapps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Xapps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintAdminExt(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDivergenceReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDivergenceReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDivergenceReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rescan {
+		i--
+		if m.Rescan {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyDivergence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyDivergence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyDivergence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Repaired {
+		i--
+		if m.Repaired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PolicyObjectId) > 0 {
+		i -= len(m.PolicyObjectId)
+		copy(dAtA[i:], m.PolicyObjectId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyObjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyTypeId) > 0 {
+		i -= len(m.PolicyTypeId)
+		copy(dAtA[i:], m.PolicyTypeId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.PolicyTypeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.XappId) > 0 {
+		i -= len(m.XappId)
+		copy(dAtA[i:], m.XappId)
+		i = encodeVarintAdminExt(dAtA, i, uint64(len(m.XappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDivergenceReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDivergenceReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDivergenceReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Divergences) > 0 {
+		for iNdEx := len(m.Divergences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Divergences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdminExt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintAdminExt(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdminExt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdminExt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetPolicyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovAdminExt(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Until)
+	n += 1 + l + sovAdminExt(uint64(l))
+	return n
+}
+
+func (m *XAppOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *GetPolicyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovAdminExt(uint64(m.Sequence))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovAdminExt(uint64(m.Revision))
+	}
+	l = len(m.OldPolicyObject)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.NewPolicyObject)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.TargetXappIds) > 0 {
+		for _, s := range m.TargetXappIds {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	if len(m.XappOutcomes) > 0 {
+		for _, e := range m.XappOutcomes {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollbackPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovAdminExt(uint64(m.Revision))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *PolicyRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.FromRevision != 0 {
+		n += 1 + sovAdminExt(uint64(m.FromRevision))
+	}
+	if m.ToRevision != 0 {
+		n += 1 + sovAdminExt(uint64(m.ToRevision))
+	}
+	l = len(m.CurrentPolicyObject)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.TargetPolicyObject)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *RollbackPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rollbacks) > 0 {
+		for _, e := range m.Rollbacks {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListXAppSessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *XAppSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.A1Service)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovAdminExt(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovAdminExt(uint64(l))
+	return n
+}
+
+func (m *ListXAppSessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListCircuitBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovAdminExt(uint64(m.ConsecutiveFailures))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovAdminExt(uint64(l))
+	return n
+}
+
+func (m *ListCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WatchCircuitBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.FromState)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.ToState)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	return n
+}
+
+func (m *WatchPolicyObjectStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	return n
+}
+
+func (m *WatchPolicyObjectStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectStatus)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	return n
+}
+
+func (m *BulkPolicyOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.NotificationDestination)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObject)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *BulkPolicyOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	if m.Atomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *BulkPolicyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovAdminExt(uint64(m.Index))
+	}
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Applied {
+		n += 2
+	}
+	if m.Compensated {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *BulkPolicyOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPolicySourceStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SyncPolicySourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *PolicySourceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Applied {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicySourceConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicySourceDrift) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Preexisting {
+		n += 2
+	}
+	if m.Corrected {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicySourceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.Manifests != 0 {
+		n += 1 + sovAdminExt(uint64(m.Manifests))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	if len(m.Drift) > 0 {
+		for _, e := range m.Drift {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListPolicyOwnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicyOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovAdminExt(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *ListPolicyOwnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferPolicyOwnershipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferPolicyOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *ListPolicySchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicySchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ValidFrom)
+	n += 1 + l + sovAdminExt(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ValidUntil)
+	n += 1 + l + sovAdminExt(uint64(l))
+	l = len(m.Recurrence)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RecurrenceUntil)
+	n += 1 + l + sovAdminExt(uint64(l))
+	if m.Deployed {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextTransition)
+	n += 1 + l + sovAdminExt(uint64(l))
+	return n
+}
+
+func (m *ListPolicySchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListEITypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EIType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EiTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.XappIds) > 0 {
+		for _, s := range m.XappIds {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	if m.Jobs != 0 {
+		n += 1 + sovAdminExt(uint64(m.Jobs))
+	}
+	return n
+}
+
+func (m *ListEITypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EiTypes) > 0 {
+		for _, e := range m.EiTypes {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListEIJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EiTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *EIJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EiJobId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.EiTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.ConsumerXappIds) > 0 {
+		for _, s := range m.ConsumerXappIds {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	l = len(m.JobDefinition)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.JobResultUri)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Orphaned {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	l = len(m.LastResult)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastResultTimestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	l = len(m.LastDeliveryError)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *ListEIJobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetEIJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EiJobId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *GetEIJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *ForceDeleteEIJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EiJobId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *ForceDeleteEIJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EiJobId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.RemoteDeleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *PutPolicyObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObject)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.NotificationDestination)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.IfMatch) > 0 {
+		for _, s := range m.IfMatch {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	if len(m.IfNoneMatch) > 0 {
+		for _, s := range m.IfNoneMatch {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PutPolicyObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Created {
+		n += 2
+	}
+	l = len(m.Etag)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *DeletePolicyObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.IfMatch) > 0 {
+		for _, s := range m.IfMatch {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeletePolicyObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReconcileXAppRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicyReconcile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *ReconcileXAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DisconnectXAppRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *DisconnectXAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReconnectXAppRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *ReconnectXAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DrainXAppRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Drained {
+		n += 2
+	}
+	return n
+}
+
+func (m *DrainXAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DrainedXappIds) > 0 {
+		for _, s := range m.DrainedXappIds {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *XAppQueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListPolicyTypeObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovAdminExt(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicyTypeObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.PolicyIds) > 0 {
+		for _, s := range m.PolicyIds {
+			l = len(s)
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	l = len(m.PolicyTypeObject)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.XappResults) > 0 {
+		for _, e := range m.XappResults {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *ListPolicyTypeObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PolicyTypes) > 0 {
+		for _, e := range m.PolicyTypes {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *ListPolicyObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovAdminExt(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicyObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObject)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if len(m.XappResults) > 0 {
+		for _, e := range m.XappResults {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *ListPolicyObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *GetPolicyInventoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Rescan {
+		n += 2
+	}
+	return n
+}
+
+func (m *XAppPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObject)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	return n
+}
+
+func (m *XAppPolicyInventory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for k, v := range m.Errors {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdminExt(uint64(len(k))) + 1 + len(v) + sovAdminExt(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdminExt(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetPolicyInventoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	if len(m.Xapps) > 0 {
+		for _, e := range m.Xapps {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetDivergenceReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Rescan {
+		n += 2
+	}
+	return n
+}
+
+func (m *PolicyDivergence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XappId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyTypeId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.PolicyObjectId)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdminExt(uint64(l))
+	}
+	if m.Repaired {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetDivergenceReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovAdminExt(uint64(l))
+	if len(m.Divergences) > 0 {
+		for _, e := range m.Divergences {
+			l = e.Size()
+			n += 1 + l + sovAdminExt(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAdminExt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdminExt(x uint64) (n int) {
+	return sovAdminExt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetPolicyHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPolicyHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPolicyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Until, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XAppOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XAppOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XAppOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPolicyHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPolicyHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPolicyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPolicyObject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldPolicyObject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPolicyObject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPolicyObject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetXappIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetXappIds = append(m.TargetXappIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappOutcomes = append(m.XappOutcomes, &XAppOutcome{})
+			if err := m.XappOutcomes[len(m.XappOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRevision", wireType)
+			}
+			m.FromRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRevision", wireType)
+			}
+			m.ToRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPolicyObject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentPolicyObject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPolicyObject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPolicyObject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFields = append(m.ChangedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollbacks = append(m.Rollbacks, &PolicyRollback{})
+			if err := m.Rollbacks[len(m.Rollbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListXAppSessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListXAppSessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListXAppSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XAppSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XAppSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XAppSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A1Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.A1Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListXAppSessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListXAppSessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListXAppSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &XAppSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCircuitBreakersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCircuitBreakersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCircuitBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCircuitBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, &CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchCircuitBreakersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchCircuitBreakersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchCircuitBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchCircuitBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchPolicyObjectStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchPolicyObjectStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchPolicyObjectStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snapshot = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchPolicyObjectStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchPolicyObjectStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchPolicyObjectStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkPolicyOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPolicyOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPolicyOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotificationDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkPolicyOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPolicyOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPolicyOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &BulkPolicyOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkPolicyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPolicyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPolicyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compensated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compensated = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkPolicyOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPolicyOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPolicyOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &BulkPolicyResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPolicySourceStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPolicySourceStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPolicySourceStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicySourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicySourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicySourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicySourceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicySourceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicySourceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicySourceConflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicySourceConflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicySourceConflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicySourceDrift) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicySourceDrift: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicySourceDrift: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preexisting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Preexisting = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corrected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Corrected = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicySourceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicySourceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicySourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			m.Manifests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Manifests |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &PolicySourceChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, &PolicySourceConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drift", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Drift = append(m.Drift, &PolicySourceDrift{})
+			if err := m.Drift[len(m.Drift)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPolicyOwnersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPolicyOwnersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPolicyOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPolicyOwnersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPolicyOwnersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPolicyOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, &PolicyOwner{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferPolicyOwnershipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferPolicyOwnershipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferPolicyOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferPolicyOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferPolicyOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferPolicyOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &PolicyOwner{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPolicySchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPolicySchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPolicySchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicySchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicySchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicySchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ValidUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recurrence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurrenceUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RecurrenceUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deployed = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTransition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextTransition, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPolicySchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPolicySchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPolicySchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, &PolicySchedule{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListEITypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListEITypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListEITypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EIType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EIType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EIType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EiTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EiTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappIds = append(m.XappIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			m.Jobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Jobs |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListEITypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListEITypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListEITypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EiTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EiTypes = append(m.EiTypes, &EIType{})
+			if err := m.EiTypes[len(m.EiTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListEIJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListEIJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListEIJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EiTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EiTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EIJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EIJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EIJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EiJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EiJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EiTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EiTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerXappIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerXappIds = append(m.ConsumerXappIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobDefinition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobDefinition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobResultUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobResultUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orphaned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Orphaned = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResult", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResultTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastResultTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeliveryError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastDeliveryError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListEIJobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListEIJobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListEIJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &EIJob{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEIJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEIJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEIJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EiJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EiJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEIJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEIJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEIJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &EIJob{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForceDeleteEIJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForceDeleteEIJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForceDeleteEIJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EiJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EiJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForceDeleteEIJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForceDeleteEIJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForceDeleteEIJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EiJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EiJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoteDeleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutPolicyObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutPolicyObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutPolicyObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotificationDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IfMatch = append(m.IfMatch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNoneMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IfNoneMatch = append(m.IfNoneMatch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutPolicyObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutPolicyObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutPolicyObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Etag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Etag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePolicyObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePolicyObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePolicyObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IfMatch = append(m.IfMatch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePolicyObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePolicyObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePolicyObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconcileXAppRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconcileXAppRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconcileXAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyReconcile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyReconcile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyReconcile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconcileXAppResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconcileXAppResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconcileXAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, &PolicyReconcile{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisconnectXAppRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisconnectXAppRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisconnectXAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisconnectXAppResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisconnectXAppResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisconnectXAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconnectXAppRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconnectXAppRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconnectXAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReconnectXAppResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReconnectXAppResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReconnectXAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainXAppRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainXAppRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainXAppRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drained", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Drained = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainXAppResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainXAppResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainXAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainedXappIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrainedXappIds = append(m.DrainedXappIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XAppQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XAppQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XAppQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPolicyTypeObjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPolicyTypeObjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPolicyTypeObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyTypeObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyTypeObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyTypeObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyIds = append(m.PolicyIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeObject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeObject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappResults = append(m.XappResults, &XAppQueryResult{})
+			if err := m.XappResults[len(m.XappResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPolicyTypeObjectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPolicyTypeObjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPolicyTypeObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypes = append(m.PolicyTypes, &PolicyTypeObject{})
+			if err := m.PolicyTypes[len(m.PolicyTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPolicyObjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPolicyObjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPolicyObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappResults = append(m.XappResults, &XAppQueryResult{})
+			if err := m.XappResults[len(m.XappResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPolicyObjectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPolicyObjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPolicyObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, &PolicyObject{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPolicyInventoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPolicyInventoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPolicyInventoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rescan", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rescan = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XAppPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XAppPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XAppPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XAppPolicyInventory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XAppPolicyInventory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XAppPolicyInventory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, &XAppPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Errors == nil {
+				m.Errors = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdminExt
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminExt
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdminExt
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdminExt
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdminExt
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdminExt
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdminExt
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdminExt(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdminExt
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Errors[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPolicyInventoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPolicyInventoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPolicyInventoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xapps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Xapps = append(m.Xapps, &XAppPolicyInventory{})
+			if err := m.Xapps[len(m.Xapps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDivergenceReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDivergenceReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDivergenceReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rescan", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rescan = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyDivergence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyDivergence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyDivergence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyTypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repaired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDivergenceReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDivergenceReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDivergenceReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Divergences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Divergences = append(m.Divergences, &PolicyDivergence{})
+			if err := m.Divergences[len(m.Divergences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdminExt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdminExt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdminExt(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdminExt
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdminExt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAdminExt
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAdminExt
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAdminExt
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAdminExt        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdminExt          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAdminExt = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testAdminExtServer struct {
+	UnimplementedA1TAdminExtServiceServer
+	since time.Time
+}
+
+func (s *testAdminExtServer) GetPolicyInventory(ctx context.Context, request *GetPolicyInventoryRequest) (*GetPolicyInventoryResponse, error) {
+	return &GetPolicyInventoryResponse{
+		Timestamp: s.since,
+		Xapps: []*XAppPolicyInventory{{
+			XappId:    request.XappId,
+			Timestamp: s.since,
+			Errors:    map[string]string{"type-1": "unreachable"},
+		}},
+	}, nil
+}
+
+func (s *testAdminExtServer) GetPolicyHistory(request *GetPolicyHistoryRequest, server A1TAdminExtService_GetPolicyHistoryServer) error {
+	for i := uint64(1); i <= 2; i++ {
+		if err := server.Send(&GetPolicyHistoryResponse{Sequence: i, Timestamp: request.Since, PolicyObjectId: request.PolicyObjectId}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestAdminExtServiceRoundTrip(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	since := time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)
+	RegisterA1TAdminExtServiceServer(server, &testAdminExtServer{since: since})
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := NewA1TAdminExtServiceClient(conn)
+	ctx := context.Background()
+
+	inventory, err := client.GetPolicyInventory(ctx, &GetPolicyInventoryRequest{XappId: "xapp-1"})
+	require.NoError(t, err)
+	assert.True(t, since.Equal(inventory.Timestamp))
+	require.Len(t, inventory.Xapps, 1)
+	assert.Equal(t, "xapp-1", inventory.Xapps[0].XappId)
+	assert.Equal(t, "unreachable", inventory.Xapps[0].Errors["type-1"])
+
+	stream, err := client.GetPolicyHistory(ctx, &GetPolicyHistoryRequest{PolicyObjectId: "1", Since: since})
+	require.NoError(t, err)
+	sequences := make([]uint64, 0)
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		assert.True(t, since.Equal(resp.Timestamp))
+		assert.Equal(t, "1", resp.PolicyObjectId)
+		sequences = append(sequences, resp.Sequence)
+	}
+	assert.Equal(t, []uint64{1, 2}, sequences)
+
+	_, err = client.ListEITypes(ctx, &ListEITypesRequest{})
+	assert.Error(t, err)
+}
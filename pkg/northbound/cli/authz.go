@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// Permission is the right to call a group of admin RPCs which change the state of A1T or of the xApps
+type Permission string
+
+const (
+	// PermissionPolicyWrite allows to create, update and delete policy objects
+	PermissionPolicyWrite Permission = "policyWrite"
+	// PermissionXAppReconcile allows to push the policies to an xApp again
+	PermissionXAppReconcile Permission = "xAppReconcile"
+	// PermissionXAppSession allows to disconnect and reconnect the southbound sessions of an xApp
+	PermissionXAppSession Permission = "xAppSession"
+	// PermissionXAppDrain allows to stop and resume routing new policies to an xApp
+	PermissionXAppDrain Permission = "xAppDrain"
+	// PermissionEIJobWrite allows to force-delete EI jobs
+	PermissionEIJobWrite Permission = "eiJobWrite"
+)
+
+// AnyCaller grants a permission to every caller
+const AnyCaller = "*"
+
+var permissions = []Permission{PermissionPolicyWrite, PermissionXAppReconcile, PermissionXAppSession, PermissionXAppDrain, PermissionEIJobWrite}
+
+// Authorization lists the callers granted each permission; the callers are the client certificate subjects, or the
+// peer IP addresses without mTLS. A nil Authorization denies every permission.
+type Authorization struct {
+	Permissions map[Permission][]string `json:"permissions"`
+}
+
+// LoadAuthorization reads the authorization from a YAML or JSON file
+func LoadAuthorization(path string) (*Authorization, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, errors.NewInvalid("%v: %v", path, err)
+	}
+	authz := &Authorization{}
+	if err := json.Unmarshal(doc, authz); err != nil {
+		return nil, errors.NewInvalid("%v: %v", path, err)
+	}
+	for permission := range authz.Permissions {
+		if !isPermission(permission) {
+			return nil, errors.NewInvalid("%v: unknown permission %v", path, permission)
+		}
+	}
+	log.Infof("Loaded the admin authorization from %v", path)
+	return authz, nil
+}
+
+func isPermission(permission Permission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize returns a Forbidden error unless the caller of the RPC has the permission
+func (a *Authorization) authorize(ctx context.Context, permission Permission) error {
+	caller := getCaller(ctx)
+	if a != nil {
+		for _, c := range a.Permissions[permission] {
+			if c == AnyCaller || c == caller {
+				return nil
+			}
+		}
+	}
+	log.Warnf("Caller %q is denied permission %v", caller, permission)
+	return errors.NewForbidden("caller %q is not granted permission %v", caller, permission)
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/peer"
+)
+
+func peerContext(ip string, port int) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: port}})
+}
+
+func TestAuthorizeWithoutTLS(t *testing.T) {
+	authz := &Authorization{Permissions: map[Permission][]string{
+		PermissionPolicyWrite: {"10.0.0.1", "::1"},
+		PermissionXAppDrain:   {AnyCaller},
+	}}
+
+	// the port of the peer changes with every connection and is not part of the caller
+	assert.Equal(t, "10.0.0.1", getCaller(peerContext("10.0.0.1", 40000)))
+	assert.NoError(t, authz.authorize(peerContext("10.0.0.1", 40000), PermissionPolicyWrite))
+	assert.NoError(t, authz.authorize(peerContext("10.0.0.1", 40001), PermissionPolicyWrite))
+	assert.NoError(t, authz.authorize(peerContext("::1", 40000), PermissionPolicyWrite))
+
+	err := authz.authorize(peerContext("10.0.0.2", 40000), PermissionPolicyWrite)
+	assert.True(t, errors.IsForbidden(err))
+	err = authz.authorize(peerContext("10.0.0.1", 40000), PermissionXAppSession)
+	assert.True(t, errors.IsForbidden(err))
+	assert.NoError(t, authz.authorize(peerContext("10.0.0.2", 40000), PermissionXAppDrain))
+
+	var none *Authorization
+	err = none.authorize(peerContext("10.0.0.1", 40000), PermissionXAppDrain)
+	assert.True(t, errors.IsForbidden(err))
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+func (s *Server) BulkPolicyOperations(ctx context.Context, request *BulkPolicyOperationsRequest) (*BulkPolicyOperationsResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionPolicyWrite); err != nil {
+		return nil, err
+	}
+	log.Infof("Bulk policy operations (operations: %d, atomic: %v)", len(request.Operations), request.Atomic)
+	operations := make([]controller.BulkOperation, 0, len(request.Operations))
+	for i, op := range request.Operations {
+		action, err := controller.ParseBulkAction(op.Action)
+		if err != nil {
+			return nil, errors.NewInvalid("operation %d: %v", i, err)
+		}
+		params := make(map[string]string)
+		if op.NotificationDestination != "" {
+			params[utils.NotificationDestination] = op.NotificationDestination
+		}
+		var policyObject map[string]interface{}
+		if op.PolicyObject != "" {
+			err = json.Unmarshal([]byte(op.PolicyObject), &policyObject)
+			if err != nil {
+				return nil, errors.NewInvalid("operation %d: %v", i, err)
+			}
+		}
+		operations = append(operations, controller.BulkOperation{
+			Action:       action,
+			PolicyTypeID: op.PolicyTypeId,
+			PolicyID:     op.PolicyObjectId,
+			Params:       params,
+			PolicyObject: policyObject,
+		})
+	}
+
+	results, err := s.ctrlBroker.A1PController().HandlePolicyBulk(getRequestContext(ctx), operations, request.Atomic)
+	resp := &BulkPolicyOperationsResponse{
+		Success: err == nil,
+		Results: make([]*BulkPolicyResult, 0, len(results)),
+	}
+	if err != nil {
+		resp.Reason = err.Error()
+	}
+	for _, r := range results {
+		result := &BulkPolicyResult{
+			Index:          int32(r.Index),
+			PolicyTypeId:   r.PolicyTypeID,
+			PolicyObjectId: r.PolicyID,
+			Action:         r.Action.String(),
+			Applied:        r.Applied,
+			Compensated:    r.Compensated,
+		}
+		if r.Err != nil {
+			result.Reason = r.Err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+
+	"github.com/onosproject/onos-a1t/pkg/controller"
+)
+
+// circuitEventBufferSize is the number of circuit events buffered for a watching client
+const circuitEventBufferSize = 64
+
+func (s *Server) ListCircuitBreakers(ctx context.Context, request *ListCircuitBreakersRequest) (*ListCircuitBreakersResponse, error) {
+	log.Infof("List circuit breakers (xApp ID: %v)", request.XappId)
+	resp := &ListCircuitBreakersResponse{
+		CircuitBreakers: make([]*CircuitBreaker, 0),
+	}
+	for _, status := range s.ctrlBroker.A1PController().CircuitBreakers() {
+		if request.XappId != "" && request.XappId != status.XAppID {
+			continue
+		}
+		resp.CircuitBreakers = append(resp.CircuitBreakers, &CircuitBreaker{
+			XappId:              status.XAppID,
+			State:               status.State.String(),
+			ConsecutiveFailures: int32(status.ConsecutiveFailures),
+			LastError:           status.LastError,
+			Since:               status.Since,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) WatchCircuitBreakers(request *WatchCircuitBreakersRequest, server A1TAdminExtService_WatchCircuitBreakersServer) error {
+	log.Infof("Watch circuit breakers (xApp ID: %v)", request.XappId)
+	ch := make(chan controller.CircuitEvent, circuitEventBufferSize)
+	err := s.ctrlBroker.A1PController().WatchCircuitBreakers(server.Context(), ch)
+	if err != nil {
+		return err
+	}
+
+	for event := range ch {
+		if request.XappId != "" && request.XappId != event.XAppID {
+			continue
+		}
+		err = server.Send(&WatchCircuitBreakersResponse{
+			XappId:    event.XAppID,
+			FromState: event.From.String(),
+			ToState:   event.To.String(),
+			Reason:    event.Reason,
+			Timestamp: event.Timestamp,
+		})
+		if err != nil {
+			log.Warn(err)
+			return err
+		}
+	}
+	return nil
+}
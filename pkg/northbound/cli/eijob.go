@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// The EI job RPCs only read the EI jobs store, so that they work while the Non-RT RIC is unreachable
+
+func (s *Server) ListEITypes(ctx context.Context, request *ListEITypesRequest) (*ListEITypesResponse, error) {
+	log.Info("List EI types")
+	eiTypes := make(map[string]*EIType)
+	for _, info := range s.ctrlBroker.A1EIController().HandleGetEITypeCatalogue(ctx) {
+		eiTypes[info.EITypeID] = &EIType{
+			EiTypeId: info.EITypeID,
+			XappIds:  info.Consumers,
+		}
+	}
+	// the types of the jobs may no longer be declared by any xApp
+	for _, job := range s.ctrlBroker.A1EIController().HandleListInfoJobs(ctx, "", "") {
+		eiType, ok := eiTypes[job.InfoTypeID]
+		if !ok {
+			eiType = &EIType{
+				EiTypeId: job.InfoTypeID,
+			}
+			eiTypes[job.InfoTypeID] = eiType
+		}
+		eiType.Jobs++
+	}
+
+	resp := &ListEITypesResponse{
+		EiTypes: make([]*EIType, 0, len(eiTypes)),
+	}
+	for _, eiType := range eiTypes {
+		resp.EiTypes = append(resp.EiTypes, eiType)
+	}
+	sort.Slice(resp.EiTypes, func(i, j int) bool {
+		return resp.EiTypes[i].EiTypeId < resp.EiTypes[j].EiTypeId
+	})
+	return resp, nil
+}
+
+func (s *Server) ListEIJobs(ctx context.Context, request *ListEIJobsRequest) (*ListEIJobsResponse, error) {
+	log.Infof("List EI jobs (EI type ID: %v, xApp ID: %v)", request.EiTypeId, request.XappId)
+	resp := &ListEIJobsResponse{
+		Jobs: make([]*EIJob, 0),
+	}
+	for _, job := range s.ctrlBroker.A1EIController().HandleListInfoJobs(ctx, request.EiTypeId, request.XappId) {
+		resp.Jobs = append(resp.Jobs, newEIJob(job, false))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetEIJob(ctx context.Context, request *GetEIJobRequest) (*GetEIJobResponse, error) {
+	log.Infof("Get EI job %v", request.EiJobId)
+	if request.EiJobId == "" {
+		return nil, errors.NewInvalid("EI job ID is required")
+	}
+	for _, job := range s.ctrlBroker.A1EIController().HandleListInfoJobs(ctx, "", "") {
+		if job.InfoJobID == request.EiJobId {
+			return &GetEIJobResponse{
+				Job: newEIJob(job, true),
+			}, nil
+		}
+	}
+	return nil, errors.NewNotFound("EI job %v not found", request.EiJobId)
+}
+
+func (s *Server) ForceDeleteEIJob(ctx context.Context, request *ForceDeleteEIJobRequest) (*ForceDeleteEIJobResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionEIJobWrite); err != nil {
+		return nil, err
+	}
+	log.Infof("Force-delete EI job %v", request.EiJobId)
+	if request.EiJobId == "" {
+		return nil, errors.NewInvalid("EI job ID is required")
+	}
+	remoteDeleted, err := s.ctrlBroker.A1EIController().HandleForceDeleteInfoJob(ctx, request.EiJobId)
+	if err != nil {
+		return nil, err
+	}
+	return &ForceDeleteEIJobResponse{
+		EiJobId:       request.EiJobId,
+		RemoteDeleted: remoteDeleted,
+	}, nil
+}
+
+// newEIJob converts the job; the job definition and the last result are only sent for a single job
+func newEIJob(job *controller.InfoJobInfo, details bool) *EIJob {
+	consumers := make([]string, 0, len(job.Consumers))
+	for xAppID := range job.Consumers {
+		consumers = append(consumers, xAppID)
+	}
+	sort.Strings(consumers)
+	eiJob := &EIJob{
+		EiJobId:             job.InfoJobID,
+		EiTypeId:            job.InfoTypeID,
+		Owner:               job.JobOwner,
+		ConsumerXappIds:     consumers,
+		JobResultUri:        job.JobResultURI,
+		Status:              job.Status,
+		Orphaned:            job.Orphaned,
+		Timestamp:           job.Timestamp,
+		LastResultTimestamp: job.LastResultTimestamp,
+		LastDeliveryError:   job.LastDeliveryError,
+	}
+	if details {
+		if b, err := json.Marshal(job.JobDefinition); err == nil {
+			eiJob.JobDefinition = string(b)
+		}
+		eiJob.LastResult = string(job.LastResult)
+	}
+	return eiJob
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/onosproject/onos-a1t/pkg/audit"
+)
+
+func (s *Server) GetPolicyHistory(request *GetPolicyHistoryRequest, server A1TAdminExtService_GetPolicyHistoryServer) error {
+	log.Info("Get policy history")
+	filter := audit.Filter{
+		PolicyTypeID: request.PolicyTypeId,
+		PolicyID:     request.PolicyObjectId,
+		Since:        request.Since,
+		Until:        request.Until,
+	}
+
+	for _, r := range s.auditLog.Query(server.Context(), filter) {
+		resp := &GetPolicyHistoryResponse{
+			Sequence:       r.Sequence,
+			Timestamp:      r.Timestamp,
+			Operation:      r.Operation.String(),
+			PolicyTypeId:   r.PolicyTypeID,
+			PolicyObjectId: r.PolicyID,
+			Caller:         r.Caller,
+			RequestId:      r.RequestID,
+			Revision:       r.Revision,
+			TargetXappIds:  r.TargetXApps,
+			XappOutcomes:   make([]*XAppOutcome, 0, len(r.Outcomes)),
+		}
+		if r.OldBody != nil {
+			oldObj, err := json.Marshal(r.OldBody)
+			if err != nil {
+				return err
+			}
+			resp.OldPolicyObject = string(oldObj)
+		}
+		if r.NewBody != nil {
+			newObj, err := json.Marshal(r.NewBody)
+			if err != nil {
+				return err
+			}
+			resp.NewPolicyObject = string(newObj)
+		}
+		for _, o := range r.Outcomes {
+			resp.XappOutcomes = append(resp.XappOutcomes, &XAppOutcome{
+				XappId:  o.XAppID,
+				Success: o.Success,
+				Reason:  o.Reason,
+			})
+		}
+
+		err := server.Send(resp)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ A1TAdminExtServiceServer = &Server{}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/onosproject/onos-a1t/pkg/inventory"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+func (s *Server) GetPolicyInventory(ctx context.Context, request *GetPolicyInventoryRequest) (*GetPolicyInventoryResponse, error) {
+	report, err := s.getInventoryReport(ctx, request.Rescan)
+	if err != nil {
+		return nil, err
+	}
+	resp := &GetPolicyInventoryResponse{
+		Timestamp: report.Timestamp,
+		Xapps:     make([]*XAppPolicyInventory, 0, len(report.XApps)),
+	}
+	for _, xApp := range report.XApps {
+		if request.XappId != "" && request.XappId != xApp.XAppID {
+			continue
+		}
+		xAppInventory := &XAppPolicyInventory{
+			XappId:    xApp.XAppID,
+			Timestamp: xApp.Timestamp,
+			Policies:  make([]*XAppPolicy, 0, len(xApp.Policies)),
+			Errors:    xApp.Errors,
+		}
+		for _, p := range xApp.Policies {
+			policy := &XAppPolicy{
+				PolicyTypeId:   p.PolicyTypeID,
+				PolicyObjectId: p.PolicyID,
+			}
+			if p.PolicyObject != nil {
+				obj, err := json.Marshal(p.PolicyObject)
+				if err != nil {
+					return nil, err
+				}
+				policy.PolicyObject = string(obj)
+			}
+			xAppInventory.Policies = append(xAppInventory.Policies, policy)
+		}
+		resp.Xapps = append(resp.Xapps, xAppInventory)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetDivergenceReport(ctx context.Context, request *GetDivergenceReportRequest) (*GetDivergenceReportResponse, error) {
+	report, err := s.getInventoryReport(ctx, request.Rescan)
+	if err != nil {
+		return nil, err
+	}
+	resp := &GetDivergenceReportResponse{
+		Timestamp:   report.Timestamp,
+		Divergences: make([]*PolicyDivergence, 0, len(report.Divergences)),
+	}
+	for _, d := range report.Divergences {
+		if request.XappId != "" && request.XappId != d.XAppID || request.Kind != "" && request.Kind != d.Kind.String() {
+			continue
+		}
+		resp.Divergences = append(resp.Divergences, &PolicyDivergence{
+			XappId:         d.XAppID,
+			PolicyTypeId:   d.PolicyTypeID,
+			PolicyObjectId: d.PolicyID,
+			Kind:           d.Kind.String(),
+			Reason:         d.Reason,
+			Repaired:       d.Repaired,
+		})
+	}
+	return resp, nil
+}
+
+// getInventoryReport returns the report of the last scan, or scans the xApps first if rescan is set; as a scan may
+// repair the xApps, rescanning requires the permission to reconcile them
+func (s *Server) getInventoryReport(ctx context.Context, rescan bool) (*inventory.Report, error) {
+	if rescan {
+		if err := s.authz.authorize(ctx, PermissionXAppReconcile); err != nil {
+			return nil, err
+		}
+		log.Infof("Policy inventory scan requested by %v", getCaller(ctx))
+		return s.inventory.Scan(ctx), nil
+	}
+	report := s.inventory.Report()
+	if report == nil {
+		return nil, errors.NewUnavailable("the policy inventory has not scanned the xApps yet")
+	}
+	return report, nil
+}
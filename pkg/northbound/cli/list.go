@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	a1p "github.com/onosproject/onos-a1t/pkg/northbound/a1ap/policy_management"
+	"github.com/onosproject/onos-a1t/pkg/policydiff"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// pageCursor is the last entry of a page; the page token is its encoding
+type pageCursor struct {
+	PolicyTypeID string `json:"t"`
+	PolicyID     string `json:"p,omitempty"`
+}
+
+func (c pageCursor) after(policyTypeID, policyID string) bool {
+	return policyTypeID > c.PolicyTypeID || policyTypeID == c.PolicyTypeID && policyID > c.PolicyID
+}
+
+func (c pageCursor) token() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func parsePageToken(token string) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	cursor := &pageCursor{}
+	if err == nil {
+		err = json.Unmarshal(b, cursor)
+	}
+	if err != nil {
+		return nil, errors.NewInvalid("invalid page token %q", token)
+	}
+	return cursor, nil
+}
+
+func getPageSize(pageSize int32) (int, error) {
+	switch {
+	case pageSize < 0:
+		return 0, errors.NewInvalid("invalid page size %d", pageSize)
+	case pageSize == 0:
+		return DefaultPageSize, nil
+	case pageSize > MaxPageSize:
+		return MaxPageSize, nil
+	}
+	return int(pageSize), nil
+}
+
+// ListPolicyTypeObjects returns a page of the policy types with the policy IDs each xApp holds; a type whose schema or
+// policies cannot be fully read is returned with an error
+func (s *Server) ListPolicyTypeObjects(ctx context.Context, request *ListPolicyTypeObjectsRequest) (*ListPolicyTypeObjectsResponse, error) {
+	cursor, err := parsePageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := getPageSize(request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = getAdminContext(ctx)
+	resp := &ListPolicyTypeObjectsResponse{
+		PolicyTypes: make([]*PolicyTypeObject, 0),
+	}
+	for _, pt := range s.listPolicyTypeIDs(ctx, request.PolicyTypeId) {
+		if cursor != nil && !cursor.after(pt, "") {
+			continue
+		}
+		if len(resp.PolicyTypes) == pageSize {
+			resp.NextPageToken = pageCursor{PolicyTypeID: resp.PolicyTypes[pageSize-1].PolicyTypeId}.token()
+			break
+		}
+		resp.PolicyTypes = append(resp.PolicyTypes, s.getPolicyTypeObject(ctx, pt))
+	}
+	return resp, nil
+}
+
+func (s *Server) getPolicyTypeObject(ctx context.Context, policyTypeID string) *PolicyTypeObject {
+	entry := &PolicyTypeObject{
+		PolicyTypeId: policyTypeID,
+	}
+	errs := make([]string, 0)
+	policyTypeSchema, statusSchema, err := s.ctrlBroker.A1PController().HandleGetPolicytypesPolicyTypeId(ctx, policyTypeID)
+	if err != nil {
+		errs = append(errs, err.Error())
+	} else {
+		pto, err := json.Marshal(a1p.PolicyTypeObject{
+			PolicySchema: policyTypeSchema,
+			StatusSchema: (*a1p.JsonSchema)(&statusSchema),
+		})
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		entry.PolicyTypeObject = string(pto)
+	}
+
+	policyIDs, xAppResults, err := s.queryPolicyIDs(ctx, policyTypeID)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	entry.PolicyIds = policyIDs
+	entry.XappResults = xAppResults
+	entry.Error = strings.Join(errs, "; ")
+	return entry
+}
+
+// queryPolicyIDs returns the union of the policy IDs of the type held by the xApps, with the answer of each xApp
+func (s *Server) queryPolicyIDs(ctx context.Context, policyTypeID string) ([]string, []*XAppQueryResult, error) {
+	results, err := s.ctrlBroker.A1PController().HandleQueryXApps(ctx, "", policyTypeID, a1.PayloadType_POLICY)
+	if err != nil {
+		return nil, nil, err
+	}
+	xAppResults := newXAppQueryResults(results)
+	union := make(map[string]bool)
+	var values []interface{}
+	for _, r := range xAppResults {
+		if !r.Success {
+			continue
+		}
+		var policyIDs []string
+		if err := json.Unmarshal([]byte(r.Payload), &policyIDs); err != nil {
+			r.Success = false
+			r.Reason = err.Error()
+			continue
+		}
+		sort.Strings(policyIDs)
+		values = append(values, policyIDs)
+		for _, policyID := range policyIDs {
+			union[policyID] = true
+		}
+	}
+	policyIDs := make([]string, 0, len(union))
+	for policyID := range union {
+		policyIDs = append(policyIDs, policyID)
+	}
+	sort.Strings(policyIDs)
+	return policyIDs, xAppResults, checkXAppQueryResults(xAppResults, values, &policydiff.Comparator{})
+}
+
+// ListPolicyObjects returns a page of the policy objects with the answer of each xApp
+func (s *Server) ListPolicyObjects(ctx context.Context, request *ListPolicyObjectsRequest) (*ListPolicyObjectsResponse, error) {
+	return s.listPolicyObjects(ctx, request, a1.PayloadType_POLICY)
+}
+
+// ListPolicyObjectStatuses returns a page of the policy statuses with the answer of each xApp
+func (s *Server) ListPolicyObjectStatuses(ctx context.Context, request *ListPolicyObjectsRequest) (*ListPolicyObjectsResponse, error) {
+	return s.listPolicyObjects(ctx, request, a1.PayloadType_STATUS)
+}
+
+// listPolicyObjects queries the policies of the page only; a policy type whose policies cannot be fully listed has an
+// entry without policy ID carrying the error
+func (s *Server) listPolicyObjects(ctx context.Context, request *ListPolicyObjectsRequest, payloadType a1.PayloadType) (*ListPolicyObjectsResponse, error) {
+	cursor, err := parsePageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := getPageSize(request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = getAdminContext(ctx)
+	resp := &ListPolicyObjectsResponse{
+		Policies: make([]*PolicyObject, 0),
+	}
+	for _, pt := range s.listPolicyTypeIDs(ctx, request.PolicyTypeId) {
+		if cursor != nil && pt < cursor.PolicyTypeID {
+			continue
+		}
+		entries := make([]*PolicyObject, 0)
+		if request.PolicyObjectId != "" {
+			entries = append(entries, &PolicyObject{PolicyTypeId: pt, PolicyObjectId: request.PolicyObjectId})
+		} else {
+			policyIDs, xAppResults, err := s.queryPolicyIDs(ctx, pt)
+			if err != nil {
+				entries = append(entries, &PolicyObject{PolicyTypeId: pt, XappResults: xAppResults, Error: err.Error()})
+			}
+			for _, policyID := range policyIDs {
+				entries = append(entries, &PolicyObject{PolicyTypeId: pt, PolicyObjectId: policyID})
+			}
+		}
+
+		for _, entry := range entries {
+			if cursor != nil && !cursor.after(entry.PolicyTypeId, entry.PolicyObjectId) {
+				continue
+			}
+			if len(resp.Policies) == pageSize {
+				last := resp.Policies[pageSize-1]
+				resp.NextPageToken = pageCursor{PolicyTypeID: last.PolicyTypeId, PolicyID: last.PolicyObjectId}.token()
+				return resp, nil
+			}
+			if entry.PolicyObjectId != "" {
+				s.getPolicyObject(ctx, entry, payloadType)
+			}
+			resp.Policies = append(resp.Policies, entry)
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) getPolicyObject(ctx context.Context, entry *PolicyObject, payloadType a1.PayloadType) {
+	if payloadType == a1.PayloadType_STATUS {
+		s.getPolicyObjectStatus(ctx, entry)
+		return
+	}
+	results, err := s.ctrlBroker.A1PController().HandleQueryXApps(ctx, entry.PolicyObjectId, entry.PolicyTypeId, payloadType)
+	if err != nil {
+		entry.Error = err.Error()
+		return
+	}
+	entry.XappResults = newXAppQueryResults(results)
+	var values []interface{}
+	for _, r := range entry.XappResults {
+		if !r.Success {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(r.Payload), &value); err != nil {
+			r.Success = false
+			r.Reason = err.Error()
+			continue
+		}
+		values = append(values, value)
+	}
+	comparator := policydiff.ForPayloadType(entry.PolicyTypeId, payloadType)
+	if err := checkXAppQueryResults(entry.XappResults, values, comparator); err != nil {
+		entry.Error = err.Error()
+		return
+	}
+	if len(values) > 0 {
+		entry.PolicyObject = entry.XappResults[0].Payload
+	}
+}
+
+// getPolicyObjectStatus sets the aggregated status of the policy, with the status of each xApp
+func (s *Server) getPolicyObjectStatus(ctx context.Context, entry *PolicyObject) {
+	aggregated, err := s.ctrlBroker.A1PController().HandleGetAggregatedPolicyStatus(ctx, entry.PolicyObjectId, entry.PolicyTypeId)
+	if err != nil {
+		entry.Error = err.Error()
+		return
+	}
+	status, err := aggregated.Status.Marshal()
+	if err != nil {
+		entry.Error = err.Error()
+		return
+	}
+	entry.PolicyObject = string(status)
+	entry.XappResults = make([]*XAppQueryResult, 0, len(aggregated.XApps))
+	for _, x := range aggregated.XApps {
+		xAppResult := &XAppQueryResult{
+			XappId:  x.XAppID,
+			Success: x.Err == nil,
+			Cached:  x.Cached,
+		}
+		if x.Err != nil {
+			xAppResult.Reason = x.Err.Error()
+		} else if payload, err := x.Status.Marshal(); err == nil {
+			xAppResult.Payload = string(payload)
+		}
+		entry.XappResults = append(entry.XappResults, xAppResult)
+	}
+}
+
+func newXAppQueryResults(results []*controller.XAppQueryResult) []*XAppQueryResult {
+	xAppResults := make([]*XAppQueryResult, 0, len(results))
+	for _, r := range results {
+		xAppResult := &XAppQueryResult{
+			XappId:  r.XAppID,
+			Payload: string(r.Payload),
+			Success: r.Err == nil,
+		}
+		if r.Err != nil {
+			xAppResult.Reason = r.Err.Error()
+		}
+		xAppResults = append(xAppResults, xAppResult)
+	}
+	return xAppResults
+}
+
+// checkXAppQueryResults returns an error if an xApp failed or the xApps answered different values
+func checkXAppQueryResults(xAppResults []*XAppQueryResult, values []interface{}, comparator *policydiff.Comparator) error {
+	failed := make([]string, 0)
+	for _, r := range xAppResults {
+		if !r.Success {
+			failed = append(failed, fmt.Sprintf("%v: %v", r.XappId, r.Reason))
+		}
+	}
+	if len(failed) > 0 {
+		return errors.NewUnavailable("%d of %d xApps failed: %v", len(failed), len(xAppResults), strings.Join(failed, ", "))
+	}
+	for i := 1; i < len(values); i++ {
+		diffs, err := comparator.Diff(values[0], values[i])
+		if err != nil {
+			return err
+		}
+		if len(diffs) > 0 {
+			return errors.NewConflict("the xApps hold different values at %v", strings.Join(policydiff.Paths(diffs), ", "))
+		}
+	}
+	return nil
+}
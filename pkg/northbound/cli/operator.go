@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// PutPolicyObject creates or updates the policy object like the PUT of the REST API
+func (s *Server) PutPolicyObject(ctx context.Context, request *PutPolicyObjectRequest) (*PutPolicyObjectResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionPolicyWrite); err != nil {
+		return nil, err
+	}
+	if request.PolicyTypeId == "" || request.PolicyObjectId == "" {
+		return nil, errors.NewInvalid("policy type ID and policy object ID are required")
+	}
+	log.Infof("Put policy %v of type %v", request.PolicyObjectId, request.PolicyTypeId)
+	policyObject := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(request.PolicyObject), &policyObject); err != nil {
+		return nil, errors.NewInvalid("invalid policy object: %v", err)
+	}
+	if !utils.JsonValidateWithTypeID(request.PolicyTypeId, request.PolicyObject) {
+		return nil, errors.NewInvalid("PolicyObject validation failed: policyObject %v", policyObject)
+	}
+	params := make(map[string]string)
+	if request.NotificationDestination != "" {
+		params[utils.NotificationDestination] = request.NotificationDestination
+	}
+
+	reqCtx := controller.WithPrecondition(getRequestContext(ctx), controller.Precondition{
+		IfMatch:     request.IfMatch,
+		IfNoneMatch: request.IfNoneMatch,
+	})
+	created, etag, err := s.ctrlBroker.A1PController().HandlePolicyPut(reqCtx, request.PolicyObjectId, request.PolicyTypeId, params, policyObject)
+	if err != nil {
+		return nil, err
+	}
+	return &PutPolicyObjectResponse{
+		Created: created,
+		Etag:    etag,
+	}, nil
+}
+
+// DeletePolicyObject deletes the policy object like the DELETE of the REST API
+func (s *Server) DeletePolicyObject(ctx context.Context, request *DeletePolicyObjectRequest) (*DeletePolicyObjectResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionPolicyWrite); err != nil {
+		return nil, err
+	}
+	if request.PolicyTypeId == "" || request.PolicyObjectId == "" {
+		return nil, errors.NewInvalid("policy type ID and policy object ID are required")
+	}
+	log.Infof("Delete policy %v of type %v", request.PolicyObjectId, request.PolicyTypeId)
+	reqCtx := controller.WithPrecondition(getRequestContext(ctx), controller.Precondition{
+		IfMatch: request.IfMatch,
+	})
+	if err := s.ctrlBroker.A1PController().HandlePolicyDelete(reqCtx, request.PolicyObjectId, request.PolicyTypeId); err != nil {
+		return nil, err
+	}
+	return &DeletePolicyObjectResponse{}, nil
+}
+
+func (s *Server) ReconcileXApp(ctx context.Context, request *ReconcileXAppRequest) (*ReconcileXAppResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionXAppReconcile); err != nil {
+		return nil, err
+	}
+	if request.XappId == "" {
+		return nil, errors.NewInvalid("xApp ID is required")
+	}
+	log.Infof("Reconcile xApp %v", request.XappId)
+	results, err := s.ctrlBroker.A1PController().HandleReconcileXApp(getRequestContext(ctx), request.XappId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ReconcileXAppResponse{
+		Policies: make([]*PolicyReconcile, 0, len(results)),
+	}
+	for _, r := range results {
+		policy := &PolicyReconcile{
+			PolicyTypeId:   r.PolicyTypeID,
+			PolicyObjectId: r.PolicyID,
+			Action:         r.Action.String(),
+			Success:        r.Err == nil,
+		}
+		if r.Err != nil {
+			policy.Reason = r.Err.Error()
+		}
+		resp.Policies = append(resp.Policies, policy)
+	}
+	return resp, nil
+}
+
+func (s *Server) DisconnectXApp(ctx context.Context, request *DisconnectXAppRequest) (*DisconnectXAppResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionXAppSession); err != nil {
+		return nil, err
+	}
+	if request.XappId == "" {
+		return nil, errors.NewInvalid("xApp ID is required")
+	}
+	err := s.sbManager.Disconnect(request.XappId)
+	s.auditXAppOperation(getRequestContext(ctx), audit.XAppDisconnect, request.XappId, err)
+	if err != nil {
+		return nil, err
+	}
+	return &DisconnectXAppResponse{}, nil
+}
+
+func (s *Server) ReconnectXApp(ctx context.Context, request *ReconnectXAppRequest) (*ReconnectXAppResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionXAppSession); err != nil {
+		return nil, err
+	}
+	if request.XappId == "" {
+		return nil, errors.NewInvalid("xApp ID is required")
+	}
+	err := s.sbManager.Reconnect(request.XappId)
+	s.auditXAppOperation(getRequestContext(ctx), audit.XAppReconnect, request.XappId, err)
+	if err != nil {
+		return nil, err
+	}
+	return &ReconnectXAppResponse{}, nil
+}
+
+func (s *Server) DrainXApp(ctx context.Context, request *DrainXAppRequest) (*DrainXAppResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionXAppDrain); err != nil {
+		return nil, err
+	}
+	if request.XappId == "" {
+		return nil, errors.NewInvalid("xApp ID is required")
+	}
+	a1pController := s.ctrlBroker.A1PController()
+	if err := a1pController.HandleDrainXApp(getRequestContext(ctx), request.XappId, request.Drained); err != nil {
+		return nil, err
+	}
+	return &DrainXAppResponse{
+		DrainedXappIds: a1pController.DrainedXApps(),
+	}, nil
+}
+
+// auditXAppOperation appends the audit record of an operation on the southbound sessions of the xApp
+func (s *Server) auditXAppOperation(ctx context.Context, operation audit.Operation, xAppID string, err error) {
+	caller, requestID := audit.CallerFromContext(ctx)
+	record := audit.NewRecord(operation, "", "", caller, requestID)
+	record.SetTargetXApps([]string{xAppID})
+	record.AddOutcome(xAppID, err)
+	if err := s.auditLog.Append(ctx, record); err != nil {
+		log.Warn(err)
+	}
+}
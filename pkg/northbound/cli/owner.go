@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+func (s *Server) ListPolicyOwners(ctx context.Context, request *ListPolicyOwnersRequest) (*ListPolicyOwnersResponse, error) {
+	log.Infof("List policy owners (policy type ID: %v, owner: %v)", request.PolicyTypeId, request.Owner)
+	resp := &ListPolicyOwnersResponse{
+		Policies: make([]*PolicyOwner, 0),
+	}
+	for _, o := range s.ctrlBroker.A1PController().HandleListPolicyOwners(ctx, request.PolicyTypeId, request.Owner) {
+		resp.Policies = append(resp.Policies, &PolicyOwner{
+			PolicyTypeId:   o.PolicyTypeID,
+			PolicyObjectId: o.PolicyID,
+			Owner:          o.Owner,
+			Revision:       o.Revision,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) TransferPolicyOwnership(ctx context.Context, request *TransferPolicyOwnershipRequest) (*TransferPolicyOwnershipResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionPolicyWrite); err != nil {
+		return nil, err
+	}
+	log.Infof("Transfer policy %v of type %v to %q", request.PolicyObjectId, request.PolicyTypeId, request.Owner)
+	if request.PolicyTypeId == "" || request.PolicyObjectId == "" {
+		return nil, errors.NewInvalid("policy type ID and policy object ID are required")
+	}
+	o, err := s.ctrlBroker.A1PController().HandlePolicyTransfer(getRequestContext(ctx), request.PolicyTypeId, request.PolicyObjectId, request.Owner)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferPolicyOwnershipResponse{
+		Policy: &PolicyOwner{
+			PolicyTypeId:   o.PolicyTypeID,
+			PolicyObjectId: o.PolicyID,
+			Owner:          o.Owner,
+			Revision:       o.Revision,
+		},
+	}, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+
+	"github.com/onosproject/onos-a1t/pkg/policysource"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+func (s *Server) GetPolicySourceStatus(ctx context.Context, request *GetPolicySourceStatusRequest) (*PolicySourceStatus, error) {
+	log.Info("Get policy source status")
+	if s.policySource == nil {
+		return nil, errors.NewUnavailable("policy source is not enabled")
+	}
+	return newPolicySourceStatus(s.policySource.Status()), nil
+}
+
+func (s *Server) SyncPolicySource(ctx context.Context, request *SyncPolicySourceRequest) (*PolicySourceStatus, error) {
+	if err := s.authz.authorize(ctx, PermissionPolicyWrite); err != nil {
+		return nil, err
+	}
+	log.Infof("Sync policy source (dry run: %v)", request.DryRun)
+	if s.policySource == nil {
+		return nil, errors.NewUnavailable("policy source is not enabled")
+	}
+	return newPolicySourceStatus(s.policySource.Sync(ctx, request.DryRun)), nil
+}
+
+func newPolicySourceStatus(status *policysource.Status) *PolicySourceStatus {
+	resp := &PolicySourceStatus{
+		Timestamp: status.Timestamp,
+		Digest:    status.Digest,
+		DryRun:    status.DryRun,
+		Manifests: int32(status.Manifests),
+		Changes:   make([]*PolicySourceChange, 0, len(status.Changes)),
+		Conflicts: make([]*PolicySourceConflict, 0, len(status.Conflicts)),
+		Drift:     make([]*PolicySourceDrift, 0, len(status.Drift)),
+		Errors:    make([]string, 0, len(status.Errors)),
+	}
+	for _, c := range status.Changes {
+		change := &PolicySourceChange{
+			Action:         c.Action.String(),
+			PolicyTypeId:   c.PolicyTypeID,
+			PolicyObjectId: c.PolicyID,
+			File:           c.File,
+			Applied:        c.Applied,
+		}
+		if c.Err != nil {
+			change.Reason = c.Err.Error()
+		}
+		resp.Changes = append(resp.Changes, change)
+	}
+	for _, c := range status.Conflicts {
+		resp.Conflicts = append(resp.Conflicts, &PolicySourceConflict{
+			PolicyTypeId:   c.PolicyTypeID,
+			PolicyObjectId: c.PolicyID,
+			File:           c.File,
+			Owner:          c.Owner,
+			Reason:         c.Reason,
+		})
+	}
+	for _, d := range status.Drift {
+		drift := &PolicySourceDrift{
+			PolicyTypeId:   d.PolicyTypeID,
+			PolicyObjectId: d.PolicyID,
+			Preexisting:    d.Preexisting,
+			Corrected:      d.Corrected,
+		}
+		if d.Err != nil {
+			drift.Reason = d.Err.Error()
+		}
+		resp.Drift = append(resp.Drift, drift)
+	}
+	for _, err := range status.Errors {
+		resp.Errors = append(resp.Errors, err.Error())
+	}
+	return resp
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+)
+
+func (s *Server) RollbackPolicy(ctx context.Context, request *RollbackPolicyRequest) (*RollbackPolicyResponse, error) {
+	if err := s.authz.authorize(ctx, PermissionPolicyWrite); err != nil {
+		return nil, err
+	}
+	log.Infof("Rollback policy %v of type %v (revision: %v, timestamp: %v, dry run: %v)", request.PolicyObjectId, request.PolicyTypeId,
+		request.Revision, request.Timestamp, request.DryRun)
+	rollbacks, err := s.ctrlBroker.A1PController().HandlePolicyRollback(getRequestContext(ctx), request.PolicyTypeId, request.PolicyObjectId,
+		request.Revision, request.Timestamp, request.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &RollbackPolicyResponse{
+		Rollbacks: make([]*PolicyRollback, 0, len(rollbacks)),
+	}
+	for _, r := range rollbacks {
+		rollback := &PolicyRollback{
+			PolicyTypeId:   r.PolicyTypeID,
+			PolicyObjectId: r.PolicyID,
+			Action:         r.Action.String(),
+			FromRevision:   r.FromRevision,
+			ToRevision:     r.ToRevision,
+			ChangedFields:  r.ChangedFields,
+			Success:        r.Err == nil,
+		}
+		if r.Err != nil {
+			rollback.Reason = r.Err.Error()
+		}
+		if r.CurrentObject != nil {
+			obj, err := json.Marshal(r.CurrentObject)
+			if err != nil {
+				return nil, err
+			}
+			rollback.CurrentPolicyObject = string(obj)
+		}
+		if r.TargetObject != nil {
+			obj, err := json.Marshal(r.TargetObject)
+			if err != nil {
+				return nil, err
+			}
+			rollback.TargetPolicyObject = string(obj)
+		}
+		resp.Rollbacks = append(resp.Rollbacks, rollback)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+)
+
+func (s *Server) ListPolicySchedules(ctx context.Context, request *ListPolicySchedulesRequest) (*ListPolicySchedulesResponse, error) {
+	log.Infof("List policy schedules (policy type ID: %v)", request.PolicyTypeId)
+	resp := &ListPolicySchedulesResponse{
+		Policies: make([]*PolicySchedule, 0),
+	}
+	for _, p := range s.ctrlBroker.A1PController().HandleListPolicySchedules(ctx, request.PolicyTypeId) {
+		resp.Policies = append(resp.Policies, &PolicySchedule{
+			PolicyTypeId:    p.PolicyTypeID,
+			PolicyObjectId:  p.PolicyID,
+			Owner:           p.Owner,
+			ValidFrom:       p.Schedule.ValidFrom,
+			ValidUntil:      p.Schedule.ValidUntil,
+			Recurrence:      p.Schedule.Recurrence.String(),
+			RecurrenceUntil: p.Schedule.RecurrenceUntil,
+			Deployed:        p.Deployed,
+			NextTransition:  p.NextTransition,
+		})
+	}
+	return resp, nil
+}
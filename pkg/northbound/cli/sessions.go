@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+)
+
+func (s *Server) ListXAppSessions(ctx context.Context, request *ListXAppSessionsRequest) (*ListXAppSessionsResponse, error) {
+	log.Infof("List southbound sessions (xApp ID: %v)", request.XappId)
+	resp := &ListXAppSessionsResponse{
+		Sessions: make([]*XAppSession, 0),
+	}
+	for _, session := range s.sbManager.Sessions() {
+		if request.XappId != "" && request.XappId != session.XAppID {
+			continue
+		}
+		resp.Sessions = append(resp.Sessions, &XAppSession{
+			XappId:    session.XAppID,
+			A1Service: session.A1Service.String(),
+			Endpoint:  session.Endpoint,
+			State:     session.State.String(),
+			Attempts:  int32(session.Attempts),
+			LastError: session.LastError,
+			Since:     session.Since,
+		})
+	}
+	return resp, nil
+}
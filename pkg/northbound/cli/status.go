@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/onosproject/onos-a1t/pkg/controller"
+)
+
+func (s *Server) WatchPolicyObjectStatus(request *WatchPolicyObjectStatusRequest, server A1TAdminExtService_WatchPolicyObjectStatusServer) error {
+	log.Infof("Watch policy object status (policy type ID: %v, policy object ID: %v, xApp ID: %v)", request.PolicyTypeId, request.PolicyObjectId, request.XappId)
+	options := controller.PolicyStatusWatchOptions{
+		Filter: controller.PolicyStatusFilter{
+			PolicyTypeID: request.PolicyTypeId,
+			PolicyID:     request.PolicyObjectId,
+			XAppID:       request.XappId,
+		},
+		Snapshot: request.Snapshot,
+	}
+	ch := make(chan controller.PolicyStatusEvent)
+	err := s.ctrlBroker.A1PController().WatchPolicyStatus(getAdminContext(server.Context()), options, ch)
+	if err != nil {
+		return err
+	}
+
+	for event := range ch {
+		err = server.Send(&WatchPolicyObjectStatusResponse{
+			PolicyTypeId:       event.PolicyTypeID,
+			PolicyObjectId:     event.PolicyID,
+			XappId:             event.XAppID,
+			PolicyObjectStatus: string(event.Payload),
+			Timestamp:          event.Timestamp,
+		})
+		if err != nil {
+			log.Warn(err)
+			return err
+		}
+	}
+	return nil
+}
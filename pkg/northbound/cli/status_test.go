@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeStatusController sends the events of its statuses matching the filter of a watch until the watch is canceled
+type fakeStatusController struct {
+	controller.A1PController
+	statuses []controller.PolicyStatusEvent
+	options  chan controller.PolicyStatusWatchOptions
+}
+
+func (c *fakeStatusController) WatchPolicyStatus(ctx context.Context, options controller.PolicyStatusWatchOptions, ch chan<- controller.PolicyStatusEvent) error {
+	c.options <- options
+	go func() {
+		defer close(ch)
+		for _, event := range c.statuses {
+			if options.Filter.XAppID != "" && options.Filter.XAppID != event.XAppID {
+				continue
+			}
+			select {
+			case ch <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+		<-ctx.Done()
+	}()
+	return nil
+}
+
+func newTestAdminExtClient(t *testing.T, server *Server) A1TAdminExtServiceClient {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterA1TAdminExtServiceServer(s, server)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return NewA1TAdminExtServiceClient(conn)
+}
+
+func TestWatchPolicyObjectStatus(t *testing.T) {
+	timestamp := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	a1pController := &fakeStatusController{
+		statuses: []controller.PolicyStatusEvent{
+			{PolicyTypeID: "type-1", PolicyID: "policy-1", XAppID: "xapp-1", Payload: []byte(`{"enforceStatus":"ENFORCED"}`), Timestamp: timestamp},
+			{PolicyTypeID: "type-1", PolicyID: "policy-1", XAppID: "xapp-2", Payload: []byte(`{"enforceStatus":"NOT_ENFORCED"}`), Timestamp: timestamp},
+		},
+		options: make(chan controller.PolicyStatusWatchOptions, 1),
+	}
+	client := newTestAdminExtClient(t, &Server{ctrlBroker: &fakeBroker{a1pController: a1pController}})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watch, err := client.WatchPolicyObjectStatus(ctx, &WatchPolicyObjectStatusRequest{
+		PolicyTypeId:   "type-1",
+		PolicyObjectId: "policy-1",
+		XappId:         "xapp-2",
+		Snapshot:       true,
+	})
+	require.NoError(t, err)
+	resp, err := watch.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, controller.PolicyStatusWatchOptions{
+		Filter:   controller.PolicyStatusFilter{PolicyTypeID: "type-1", PolicyID: "policy-1", XAppID: "xapp-2"},
+		Snapshot: true,
+	}, <-a1pController.options)
+	assert.Equal(t, "type-1", resp.PolicyTypeId)
+	assert.Equal(t, "policy-1", resp.PolicyObjectId)
+	assert.Equal(t, "xapp-2", resp.XappId)
+	assert.Equal(t, `{"enforceStatus":"NOT_ENFORCED"}`, resp.PolicyObjectStatus)
+	assert.True(t, timestamp.Equal(resp.Timestamp))
+
+	// the watch lasts until the client cancels it
+	cancel()
+	_, err = watch.Recv()
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"net"
+
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const requestIDMetadataKey = "x-request-id"
+
+// getCaller returns the identity of the gRPC caller: the client certificate subject if mTLS is used, otherwise the peer
+// address without its port, which changes with every connection
+func getCaller(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+		return tlsInfo.State.PeerCertificates[0].Subject.CommonName
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
+
+// getAdminContext returns a context of a privileged caller, as the admin API manages the policies of every tenant
+func getAdminContext(ctx context.Context) context.Context {
+	return controller.WithPrivileged(ctx)
+}
+
+// getRequestContext returns an admin context carrying the caller identity and request ID for auditing
+func getRequestContext(ctx context.Context) context.Context {
+	requestID := uuid.New().String()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestIDMetadataKey); len(ids) > 0 {
+			requestID = ids[0]
+		}
+	}
+	return audit.WithCaller(getAdminContext(ctx), getCaller(ctx), requestID)
+}
@@ -6,11 +6,13 @@ package rest
 
 import (
 	"context"
+	"net"
 	"os"
 	"os/signal"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 
 	"github.com/onosproject/onos-a1t/pkg/controller"
 	"github.com/onosproject/onos-a1t/pkg/handler"
@@ -21,13 +23,21 @@ type Server struct {
 	baseURL string
 }
 
-func NewRestServer(baseURL string, broker controller.Broker) (*Server, error) {
+// NewRestServer returns the REST server serving the given A1AP spec versions, see handler.APIVersions; the client
+// address is taken from X-Forwarded-For only if the request comes from one of the trusted proxy CIDRs
+func NewRestServer(baseURL string, versions []string, trustedProxies []string, broker controller.Broker) (*Server, error) {
 	e := echo.New()
+	ipExtractor, err := newIPExtractor(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	e.IPExtractor = ipExtractor
 	// Log all requests
 	// e.Use(echomiddleware.Logger())
 
-	handler.SetRESTA1PWraper(e, "v1", broker.A1PController())
-	handler.SetRESTA1EIWraper(e, "v1", broker.A1EIController())
+	if err := handler.SetRESTHandlers(e, versions, broker.A1PController(), broker.A1EIController()); err != nil {
+		return nil, err
+	}
 
 	rest := &Server{
 		baseURL: baseURL,
@@ -36,6 +46,23 @@ func NewRestServer(baseURL string, broker controller.Broker) (*Server, error) {
 	return rest, nil
 }
 
+// newIPExtractor returns the extractor of the client address: the remote address of the connection unless proxies
+// are trusted, so that a client cannot forge the caller recorded in the audit log
+func newIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, proxy := range trustedProxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, errors.NewInvalid("invalid trusted proxy %v: %v", proxy, err)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
 func (r *Server) Start() {
 	r.echo.Logger.Fatal(r.echo.Start(r.baseURL))
 
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPExtractor(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.1:40000"
+	req.Header.Set("X-Forwarded-For", "192.0.2.1")
+	req.Header.Set("X-Real-IP", "192.0.2.1")
+
+	extractor, err := newIPExtractor(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", extractor(req))
+
+	extractor, err = newIPExtractor([]string{"10.0.0.0/24"})
+	assert.NoError(t, err)
+	assert.Equal(t, "192.0.2.1", extractor(req))
+
+	extractor, err = newIPExtractor([]string{"10.0.1.0/24"})
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", extractor(req))
+
+	_, err = newIPExtractor([]string{"10.0.0.1"})
+	assert.Error(t, err)
+}
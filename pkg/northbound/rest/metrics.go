@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var log = logging.GetLogger()
+
+// MetricsServer serves the Prometheus metrics apart from the A1 REST API, so that the A1 clients do not see the
+// internal state of A1T
+type MetricsServer struct {
+	server *http.Server
+}
+
+// NewMetricsServer returns the server of the Prometheus metrics at /metrics of the given address
+func NewMetricsServer(address string) *MetricsServer {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return &MetricsServer{
+		server: &http.Server{
+			Addr:              address,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+func (m *MetricsServer) Start() {
+	go func() {
+		log.Infof("Serving metrics on %v", m.server.Addr)
+		if err := m.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Errorf("Metrics server failed: %v", err)
+		}
+	}()
+}
+
+func (m *MetricsServer) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	if err := m.server.Shutdown(ctx); err != nil {
+		log.Warn(err)
+	}
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policydiff
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func canonicalizeJSON(t *testing.T, document string) string {
+	var value interface{}
+	assert.NoError(t, json.Unmarshal([]byte(document), &value))
+	b, err := Canonicalize(value)
+	assert.NoError(t, err)
+	return string(b)
+}
+
+// TestCanonicalize checks the examples of RFC 8785 section 3.2
+func TestCanonicalize(t *testing.T) {
+	assert.Equal(t,
+		`{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+		canonicalizeJSON(t, `{
+  "numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+  "string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+  "literals": [null, true, false]
+}`))
+
+	assert.Equal(t,
+		"{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\",\"\u00f6\":\"Latin Small Letter O With Diaeresis\","+
+			"\"\u20ac\":\"Euro Sign\",\"\U0001f600\":\"Emoji: Grinning Face\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}",
+		canonicalizeJSON(t, `{
+  "\u20ac": "Euro Sign",
+  "\r": "Carriage Return",
+  "\ufb33": "Hebrew Letter Dalet With Dagesh",
+  "1": "One",
+  "\ud83d\ude00": "Emoji: Grinning Face",
+  "\u0080": "Control",
+  "\u00f6": "Latin Small Letter O With Diaeresis"
+}`))
+
+	assert.Equal(t, `{"a":{"b":[1,2],"c":"d"}}`, canonicalizeJSON(t, `{ "a" : { "c" : "d", "b" : [ 1.0, 2 ] } }`))
+}
+
+// TestCanonicalizeNumbers checks the IEEE 754 test vectors of RFC 8785 appendix B
+func TestCanonicalizeNumbers(t *testing.T) {
+	vectors := []struct {
+		bits     uint64
+		expected string
+	}{
+		{0x0000000000000000, "0"},
+		{0x8000000000000000, "0"},
+		{0x0000000000000001, "5e-324"},
+		{0x8000000000000001, "-5e-324"},
+		{0x7fefffffffffffff, "1.7976931348623157e+308"},
+		{0xffefffffffffffff, "-1.7976931348623157e+308"},
+		{0x4340000000000000, "9007199254740992"},
+		{0xc340000000000000, "-9007199254740992"},
+		{0x4430000000000000, "295147905179352830000"},
+		{0x44b52d02c7e14af5, "9.999999999999997e+22"},
+		{0x44b52d02c7e14af6, "1e+23"},
+		{0x44b52d02c7e14af7, "1.0000000000000001e+23"},
+		{0x444b1ae4d6e2ef4e, "999999999999999700000"},
+		{0x444b1ae4d6e2ef4f, "999999999999999900000"},
+		{0x444b1ae4d6e2ef50, "1e+21"},
+		{0x3eb0c6f7a0b5ed8c, "9.999999999999997e-7"},
+		{0x3eb0c6f7a0b5ed8d, "0.000001"},
+		{0x41b3de4355555553, "333333333.3333332"},
+		{0x41b3de4355555554, "333333333.33333325"},
+		{0x41b3de4355555555, "333333333.3333333"},
+		{0x41b3de4355555556, "333333333.3333334"},
+		{0x41b3de4355555557, "333333333.33333343"},
+		{0xbecbf647612f3696, "-0.0000033333333333333333"},
+		{0x43143ff3c1cb0959, "1424953923781206.2"},
+	}
+	for _, v := range vectors {
+		b, err := Canonicalize(math.Float64frombits(v.bits))
+		assert.NoError(t, err)
+		assert.Equal(t, v.expected, string(b), "%016x", v.bits)
+	}
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err := Canonicalize(f)
+		assert.Error(t, err)
+	}
+}
+
+func TestCanonicalizeGoValues(t *testing.T) {
+	b, err := Canonicalize(map[string]interface{}{"b": 1, "a": json.Number("2.50"), "c": []interface{}{uint8(3), float32(0.5)}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":2.5,"b":1,"c":[3,0.5]}`, string(b))
+
+	b, err = Canonicalize(struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}{Name: "x", Count: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"count":2,"name":"x"}`, string(b))
+}
@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policydiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	policyschemas "github.com/onosproject/onos-a1-dm/go/policy_schemas"
+	policystatusv2 "github.com/onosproject/onos-a1-dm/go/policy_status/v2"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+)
+
+var log = logging.GetLogger()
+
+type DifferenceKind int
+
+const (
+	// Added is a value only the second document has
+	Added DifferenceKind = iota
+	// Removed is a value only the first document has
+	Removed
+	// Changed is a value the documents both have, but differently
+	Changed
+)
+
+func (k DifferenceKind) String() string {
+	return [...]string{"Added", "Removed", "Changed"}[k]
+}
+
+// Difference is a value which differs between two JSON documents; Path is its JSON pointer (RFC 6901)
+type Difference struct {
+	Path string
+	Kind DifferenceKind
+	Old  interface{}
+	New  interface{}
+}
+
+// Comparator compares JSON documents after canonicalizing them; the object members a JSON schema declares with a
+// default are ignored when they are absent from a document or equal to their default; the zero Comparator has no schema
+type Comparator struct {
+	schema map[string]interface{}
+}
+
+// NewComparator returns a comparator for the JSON schema; an empty schema compares the canonical documents only
+func NewComparator(schema string) (*Comparator, error) {
+	c := &Comparator{}
+	if schema == "" {
+		return c, nil
+	}
+	if err := json.Unmarshal([]byte(schema), &c.schema); err != nil {
+		return nil, errors.NewInvalid("invalid JSON schema: %v", err)
+	}
+	return c, nil
+}
+
+var (
+	policyTypeComparators sync.Map
+	statusComparator      = mustComparator(policystatusv2.RawSchema)
+)
+
+// ForPolicyType returns the comparator of the policy objects of the type; it ignores no member if the type has no
+// schema in onos-a1-dm
+func ForPolicyType(policyTypeID string) *Comparator {
+	if c, ok := policyTypeComparators.Load(policyTypeID); ok {
+		return c.(*Comparator)
+	}
+	c, err := NewComparator(policyschemas.PolicySchemas[policyTypeID])
+	if err != nil {
+		log.Warnf("Comparing the policies of type %v without their schema: %v", policyTypeID, err)
+		c = &Comparator{}
+	}
+	actual, _ := policyTypeComparators.LoadOrStore(policyTypeID, c)
+	return actual.(*Comparator)
+}
+
+// ForPolicyStatus returns the comparator of the policy statuses
+func ForPolicyStatus() *Comparator {
+	return statusComparator
+}
+
+// ForPayloadType returns the comparator of the policy objects of the type or of their statuses
+func ForPayloadType(policyTypeID string, payloadType a1.PayloadType) *Comparator {
+	if payloadType == a1.PayloadType_STATUS {
+		return ForPolicyStatus()
+	}
+	return ForPolicyType(policyTypeID)
+}
+
+func mustComparator(schema string) *Comparator {
+	c, err := NewComparator(schema)
+	if err != nil {
+		log.Warn(err)
+		return &Comparator{}
+	}
+	return c
+}
+
+// Equal returns whether the documents are the same
+func (c *Comparator) Equal(a, b interface{}) (bool, error) {
+	diffs, err := c.Diff(a, b)
+	if err != nil {
+		return false, err
+	}
+	return len(diffs) == 0, nil
+}
+
+// Diff returns the differences from the first document to the second one, sorted by path
+func (c *Comparator) Diff(a, b interface{}) ([]*Difference, error) {
+	na, err := normalize(a)
+	if err != nil {
+		return nil, err
+	}
+	nb, err := normalize(b)
+	if err != nil {
+		return nil, err
+	}
+	if c.schema != nil {
+		na = c.stripDefaults(c.schema, na)
+		nb = c.stripDefaults(c.schema, nb)
+	}
+	diffs := make([]*Difference, 0)
+	if err := diffValues("", na, nb, &diffs); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+	return diffs, nil
+}
+
+// Paths returns the paths of the differences
+func Paths(diffs []*Difference) []string {
+	paths := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		paths = append(paths, d.Path)
+	}
+	return paths
+}
+
+func diffValues(path string, a, b interface{}, diffs *[]*Difference) error {
+	aMap, aIsMap := a.(map[string]interface{})
+	bMap, bIsMap := b.(map[string]interface{})
+	if aIsMap && bIsMap {
+		for k, v := range aMap {
+			w, ok := bMap[k]
+			if !ok {
+				*diffs = append(*diffs, &Difference{Path: joinPointer(path, k), Kind: Removed, Old: v})
+				continue
+			}
+			if err := diffValues(joinPointer(path, k), v, w, diffs); err != nil {
+				return err
+			}
+		}
+		for k, w := range bMap {
+			if _, ok := aMap[k]; !ok {
+				*diffs = append(*diffs, &Difference{Path: joinPointer(path, k), Kind: Added, New: w})
+			}
+		}
+		return nil
+	}
+
+	aList, aIsList := a.([]interface{})
+	bList, bIsList := b.([]interface{})
+	if aIsList && bIsList {
+		for i := 0; i < len(aList) || i < len(bList); i++ {
+			p := joinPointer(path, strconv.Itoa(i))
+			switch {
+			case i >= len(bList):
+				*diffs = append(*diffs, &Difference{Path: p, Kind: Removed, Old: aList[i]})
+			case i >= len(aList):
+				*diffs = append(*diffs, &Difference{Path: p, Kind: Added, New: bList[i]})
+			default:
+				if err := diffValues(p, aList[i], bList[i], diffs); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	equal, err := canonicalEqual(a, b)
+	if err != nil {
+		return err
+	}
+	if !equal {
+		*diffs = append(*diffs, &Difference{Path: path, Kind: Changed, Old: a, New: b})
+	}
+	return nil
+}
+
+func canonicalEqual(a, b interface{}) (bool, error) {
+	ca, err := Canonicalize(a)
+	if err != nil {
+		return false, err
+	}
+	cb, err := Canonicalize(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(ca, cb), nil
+}
+
+// stripDefaults removes the object members equal to the default of their schema
+func (c *Comparator) stripDefaults(schema map[string]interface{}, value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties := c.properties(schema)
+		results := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			propertySchema, ok := properties[k]
+			if !ok {
+				results[k] = e
+				continue
+			}
+			if def, ok := propertySchema["default"]; ok {
+				if equal, err := canonicalEqual(e, def); err == nil && equal {
+					continue
+				}
+			}
+			results[k] = c.stripDefaults(propertySchema, e)
+		}
+		return results
+	case []interface{}:
+		items, _ := c.resolve(schema)["items"].(map[string]interface{})
+		if items == nil {
+			return v
+		}
+		results := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			results = append(results, c.stripDefaults(items, e))
+		}
+		return results
+	}
+	return value
+}
+
+// properties returns the schemas of the object members, including the ones of the allOf, anyOf and oneOf subschemas
+func (c *Comparator) properties(schema map[string]interface{}) map[string]map[string]interface{} {
+	results := make(map[string]map[string]interface{})
+	c.collectProperties(schema, results, 0)
+	return results
+}
+
+// maxRefDepth bounds the resolution of recursive schemas
+const maxRefDepth = 16
+
+func (c *Comparator) collectProperties(schema map[string]interface{}, results map[string]map[string]interface{}, depth int) {
+	if depth > maxRefDepth {
+		return
+	}
+	schema = c.resolve(schema)
+	if properties, ok := schema["properties"].(map[string]interface{}); ok {
+		for k, p := range properties {
+			if ps, ok := p.(map[string]interface{}); ok {
+				if _, exists := results[k]; !exists {
+					results[k] = c.resolve(ps)
+				}
+			}
+		}
+	}
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		subschemas, _ := schema[keyword].([]interface{})
+		for _, s := range subschemas {
+			if ss, ok := s.(map[string]interface{}); ok {
+				c.collectProperties(ss, results, depth+1)
+			}
+		}
+	}
+}
+
+// resolve follows the local $ref of the schema, e.g. #/definitions/Foo
+func (c *Comparator) resolve(schema map[string]interface{}) map[string]interface{} {
+	for i := 0; i < maxRefDepth; i++ {
+		ref, ok := schema["$ref"].(string)
+		if !ok || !strings.HasPrefix(ref, "#/") {
+			return schema
+		}
+		var node interface{} = c.schema
+		for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			m, ok := node.(map[string]interface{})
+			if !ok {
+				return schema
+			}
+			node = m[unescapePointer(token)]
+		}
+		resolved, ok := node.(map[string]interface{})
+		if !ok {
+			return schema
+		}
+		schema = resolved
+	}
+	return schema
+}
+
+func joinPointer(path, token string) string {
+	return path + "/" + strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func unescapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
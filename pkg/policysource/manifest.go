@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policysource
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// Manifest is the desired state of a policy; a manifest file holds either one manifest or a list of them
+type Manifest struct {
+	PolicyTypeID            string                 `json:"policyTypeId"`
+	PolicyID                string                 `json:"policyId"`
+	NotificationDestination string                 `json:"notificationDestination,omitempty"`
+	Policy                  map[string]interface{} `json:"policy"`
+	// File is the manifest file, relative to the source directory
+	File string `json:"-"`
+}
+
+func (m *Manifest) key() store.PolicyIntentKey {
+	return store.PolicyIntentKey{PolicyTypeID: m.PolicyTypeID, PolicyID: m.PolicyID}
+}
+
+func (m *Manifest) validate() error {
+	if m.PolicyTypeID == "" || m.PolicyID == "" {
+		return errors.NewInvalid("%v: policyTypeId and policyId are required", m.File)
+	}
+	if m.Policy == nil {
+		return errors.NewInvalid("%v: policy %v of type %v has no body", m.File, m.PolicyID, m.PolicyTypeID)
+	}
+	return nil
+}
+
+// manifestSet is the content of the manifest files
+type manifestSet struct {
+	manifests map[store.PolicyIntentKey]*Manifest
+	// failed are the policies declared by invalid manifests, with their file; an unparsable file declares no policy
+	// that can be known, which is why any error makes the set incomplete
+	failed map[store.PolicyIntentKey]string
+	errs   []error
+	digest string
+}
+
+// complete returns whether every manifest file could be read and every manifest is valid
+func (s *manifestSet) complete() bool {
+	return len(s.errs) == 0
+}
+
+// loadManifests reads the YAML and JSON manifests under dir, skipping hidden files and directories such as .git.
+// It returns the valid manifests, the policies and errors of the invalid ones and a digest of the manifest files.
+func loadManifests(dir string) (*manifestSet, error) {
+	files := make([]string, 0)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	set := &manifestSet{
+		manifests: make(map[store.PolicyIntentKey]*Manifest),
+		failed:    make(map[store.PolicyIntentKey]string),
+		errs:      make([]error, 0),
+	}
+	hash := sha256.New()
+	for _, path := range files {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			rel = path
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			set.errs = append(set.errs, err)
+			continue
+		}
+		hash.Write([]byte(rel))
+		hash.Write(data)
+
+		fileManifests, err := parseManifests(data)
+		if err != nil {
+			set.errs = append(set.errs, errors.NewInvalid("%v: %v", rel, err))
+			continue
+		}
+		for _, m := range fileManifests {
+			m.File = rel
+			if err := m.validate(); err != nil {
+				set.errs = append(set.errs, err)
+				if m.PolicyTypeID != "" && m.PolicyID != "" {
+					set.failed[m.key()] = rel
+				}
+				continue
+			}
+			if other, ok := set.manifests[m.key()]; ok {
+				set.errs = append(set.errs, errors.NewInvalid("%v: policy %v of type %v is already defined in %v", rel, m.PolicyID, m.PolicyTypeID, other.File))
+				continue
+			}
+			set.manifests[m.key()] = m
+		}
+	}
+	set.digest = hex.EncodeToString(hash.Sum(nil))
+	return set, nil
+}
+
+func parseManifests(data []byte) ([]*Manifest, error) {
+	doc, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	doc = bytes.TrimSpace(doc)
+	if bytes.HasPrefix(doc, []byte("[")) {
+		manifests := make([]*Manifest, 0)
+		if err := json.Unmarshal(doc, &manifests); err != nil {
+			return nil, err
+		}
+		return manifests, nil
+	}
+	m := &Manifest{}
+	if err := json.Unmarshal(doc, m); err != nil {
+		return nil, err
+	}
+	return []*Manifest{m}, nil
+}
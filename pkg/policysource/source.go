@@ -0,0 +1,386 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policysource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-a1t/pkg/audit"
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/policydiff"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/utils"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+)
+
+var log = logging.GetLogger()
+
+// Caller is the identity of the policy source in the audit log
+const Caller = "policy-source"
+
+type Config struct {
+	// Dir is the directory of the policy manifests, e.g. a Git checkout
+	Dir      string
+	Interval time.Duration
+	// Owner labels the policies managed by the source; policies with another owner are never touched
+	Owner string
+	// DryRun only computes the changes without applying them
+	DryRun bool
+	// CorrectDrift deletes the policies reported by xApps that are neither in the manifests nor known to A1T; as the
+	// policy intents do not survive a restart of A1T, the policies the xApps held when the source first listed their
+	// type are never deleted
+	CorrectDrift bool
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Interval: 30 * time.Second,
+		Owner:    Caller,
+	}
+}
+
+// Change is a create, update or delete needed to reach the desired state; Err is set if applying it failed
+type Change struct {
+	Action       controller.BulkAction
+	PolicyTypeID string
+	PolicyID     string
+	File         string
+	Applied      bool
+	Err          error
+}
+
+// Conflict is a manifest for a policy owned by someone else, which the source leaves alone; Owner is empty if the
+// xApps hold the policy with another body but A1T has no intent for it, so that its owner is unknown
+type Conflict struct {
+	PolicyTypeID string
+	PolicyID     string
+	File         string
+	Owner        string
+	Reason       string
+}
+
+// Drift is a policy reported by the xApps that is neither in the manifests nor known to A1T
+type Drift struct {
+	PolicyTypeID string
+	PolicyID     string
+	// Preexisting is set if the xApps held the policy when the source first listed its type, e.g. a policy created
+	// before A1T restarted; its owner is unknown, so it is never corrected
+	Preexisting bool
+	Corrected   bool
+	Err         error
+}
+
+// Status is the report of a sync
+type Status struct {
+	Timestamp time.Time
+	Digest    string
+	DryRun    bool
+	Manifests int
+	Changes   []*Change
+	Conflicts []*Conflict
+	Drift     []*Drift
+	Errors    []error
+}
+
+type Source interface {
+	// Run syncs the policies every interval until the context is done
+	Run(ctx context.Context)
+	// Sync syncs the policies once; with dryRun, the changes are only computed
+	Sync(ctx context.Context, dryRun bool) *Status
+	// Status returns the report of the last sync
+	Status() *Status
+}
+
+func NewSource(config Config, policyIntentStore store.Store, a1pController controller.A1PController) Source {
+	return &source{
+		config:            config,
+		policyIntentStore: policyIntentStore,
+		a1pController:     a1pController,
+		status:            &Status{},
+		preexisting:       make(map[string]map[string]bool),
+	}
+}
+
+type source struct {
+	config            Config
+	policyIntentStore store.Store
+	a1pController     controller.A1PController
+	status            *Status
+	// preexisting are the policies held by the xApps without a policy intent when the source first listed their
+	// type, by policy type
+	preexisting map[string]map[string]bool
+	syncMu      sync.Mutex
+	statusMu    sync.RWMutex
+}
+
+func (s *source) Run(ctx context.Context) {
+	log.Infof("Start syncing policies from %v every %v (owner: %v, dry run: %v)", s.config.Dir, s.config.Interval, s.config.Owner, s.config.DryRun)
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+	for {
+		s.Sync(ctx, s.config.DryRun)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *source) Status() *Status {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+	return s.status
+}
+
+func (s *source) Sync(ctx context.Context, dryRun bool) *Status {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	ctx = controller.WithPrivileged(ctx)
+	status := &Status{
+		Timestamp: time.Now(),
+		DryRun:    dryRun,
+		Changes:   make([]*Change, 0),
+		Conflicts: make([]*Conflict, 0),
+		Drift:     make([]*Drift, 0),
+	}
+	set, err := loadManifests(s.config.Dir)
+	if err != nil {
+		log.Warn(err)
+		status.Errors = []error{err}
+		s.setStatus(status)
+		return status
+	}
+	manifests := set.manifests
+	status.Digest = set.digest
+	status.Manifests = len(manifests)
+	status.Errors = set.errs
+
+	intents := store.ListPolicyIntents(ctx, s.policyIntentStore, "")
+	held := s.listHeldPolicies(ctx, intents)
+	s.diff(ctx, status, set, intents, held)
+
+	// writes are labelled with the owner of the source, while the drift check has to see the policies of every owner
+	writeCtx := controller.WithOwner(audit.WithCaller(ctx, Caller, uuid.New().String()), s.config.Owner)
+	if !dryRun {
+		for _, c := range status.Changes {
+			if c.Err != nil {
+				continue
+			}
+			c.Err = s.apply(writeCtx, c, manifests[store.PolicyIntentKey{PolicyTypeID: c.PolicyTypeID, PolicyID: c.PolicyID}])
+			c.Applied = c.Err == nil
+			if c.Err != nil {
+				log.Warnf("%v of policy %v of type %v from %v failed: %v", c.Action, c.PolicyID, c.PolicyTypeID, c.File, c.Err)
+			}
+		}
+	}
+
+	status.Drift = s.findDrift(set, intents, held)
+	if s.config.CorrectDrift && !dryRun {
+		for _, d := range status.Drift {
+			if d.Preexisting {
+				continue
+			}
+			if !set.complete() {
+				d.Err = errors.NewUnavailable("not corrected: some manifest files are invalid")
+				continue
+			}
+			d.Err = s.a1pController.HandlePolicyDelete(writeCtx, d.PolicyID, d.PolicyTypeID)
+			d.Corrected = d.Err == nil
+		}
+	}
+
+	for _, err := range status.Errors {
+		log.Warn(err)
+	}
+	log.Infof("Policy source sync: %d manifests, %d changes, %d conflicts, %d drifted policies, %d errors (dry run: %v)",
+		status.Manifests, len(status.Changes), len(status.Conflicts), len(status.Drift), len(status.Errors), dryRun)
+	s.setStatus(status)
+	return status
+}
+
+func (s *source) setStatus(status *Status) {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+	s.status = status
+}
+
+// diff fills in the changes from the policy intents to the manifests and the conflicts with policies of other owners;
+// as long as a manifest file is invalid, the policies missing from the manifests are not deleted, since they may be
+// declared by that file, and the deletes are reported as failed
+func (s *source) diff(ctx context.Context, status *Status, set *manifestSet, intents map[store.PolicyIntentKey]*store.PolicyIntentValue, held map[store.PolicyIntentKey]bool) {
+	manifests := set.manifests
+	for key, m := range manifests {
+		intent, ok := intents[key]
+		if !ok || !intent.Exists() {
+			action := controller.BulkCreate
+			if held[key] {
+				// the xApps hold a policy A1T has no intent for: it is adopted only if it already has the body of the manifest
+				if reason := s.checkHeldPolicy(ctx, m); reason != "" {
+					status.Conflicts = append(status.Conflicts, &Conflict{
+						PolicyTypeID: m.PolicyTypeID,
+						PolicyID:     m.PolicyID,
+						File:         m.File,
+						Reason:       reason,
+					})
+					continue
+				}
+				action = controller.BulkUpdate
+			}
+			status.Changes = append(status.Changes, newChange(action, m))
+			continue
+		}
+		latest := intent.Latest()
+		if latest.Owner != s.config.Owner {
+			status.Conflicts = append(status.Conflicts, &Conflict{
+				PolicyTypeID: m.PolicyTypeID,
+				PolicyID:     m.PolicyID,
+				File:         m.File,
+				Owner:        latest.Owner,
+			})
+			continue
+		}
+		equal, err := policydiff.ForPolicyType(m.PolicyTypeID).Equal(latest.PolicyObject, m.Policy)
+		if err != nil || !equal || latest.Params[utils.NotificationDestination] != m.NotificationDestination {
+			status.Changes = append(status.Changes, newChange(controller.BulkUpdate, m))
+		}
+	}
+
+	for key, intent := range intents {
+		if _, ok := manifests[key]; ok || !intent.Exists() || intent.Latest().Owner != s.config.Owner {
+			continue
+		}
+		change := &Change{
+			Action:       controller.BulkDelete,
+			PolicyTypeID: key.PolicyTypeID,
+			PolicyID:     key.PolicyID,
+		}
+		if file, ok := set.failed[key]; ok {
+			change.File = file
+			change.Err = errors.NewInvalid("not deleted: the manifest of the policy in %v is invalid", file)
+		} else if !set.complete() {
+			change.Err = errors.NewUnavailable("not deleted: some manifest files are invalid")
+		}
+		status.Changes = append(status.Changes, change)
+	}
+
+	sort.Slice(status.Changes, func(i, j int) bool {
+		if status.Changes[i].PolicyTypeID != status.Changes[j].PolicyTypeID {
+			return status.Changes[i].PolicyTypeID < status.Changes[j].PolicyTypeID
+		}
+		return status.Changes[i].PolicyID < status.Changes[j].PolicyID
+	})
+}
+
+func (s *source) apply(ctx context.Context, change *Change, m *Manifest) error {
+	if change.Action == controller.BulkDelete {
+		return s.a1pController.HandlePolicyDelete(ctx, change.PolicyID, change.PolicyTypeID)
+	}
+
+	obj, err := json.Marshal(m.Policy)
+	if err != nil {
+		return err
+	}
+	if !utils.JsonValidateWithTypeID(m.PolicyTypeID, string(obj)) {
+		return errors.NewInvalid("PolicyObject validation failed: policyObject %v", m.Policy)
+	}
+	params := make(map[string]string)
+	if m.NotificationDestination != "" {
+		params[utils.NotificationDestination] = m.NotificationDestination
+	}
+	if change.Action == controller.BulkCreate {
+		return s.a1pController.HandlePolicyCreate(ctx, m.PolicyID, m.PolicyTypeID, params, m.Policy)
+	}
+	return s.a1pController.HandlePolicyUpdate(ctx, m.PolicyID, m.PolicyTypeID, params, m.Policy)
+}
+
+// checkHeldPolicy returns why the policy of the manifest, held by the xApps without a policy intent, cannot be
+// adopted; empty if the xApps hold it with the body of the manifest
+func (s *source) checkHeldPolicy(ctx context.Context, m *Manifest) string {
+	body, err := s.a1pController.HandleGetPolicy(ctx, m.PolicyID, m.PolicyTypeID)
+	if err != nil {
+		return fmt.Sprintf("held by the xApps without a policy intent: %v", err)
+	}
+	diffs, err := policydiff.ForPolicyType(m.PolicyTypeID).Diff(body, m.Policy)
+	if err != nil {
+		return err.Error()
+	}
+	if len(diffs) > 0 {
+		return fmt.Sprintf("held by the xApps without a policy intent and with another body at %v", strings.Join(policydiff.Paths(diffs), ", "))
+	}
+	return ""
+}
+
+// listHeldPolicies queries the policies of every type from the xApps; the first time a type is listed, the policies
+// without a policy intent are recorded as preexisting
+func (s *source) listHeldPolicies(ctx context.Context, intents map[store.PolicyIntentKey]*store.PolicyIntentValue) map[store.PolicyIntentKey]bool {
+	held := make(map[store.PolicyIntentKey]bool)
+	for _, policyTypeID := range s.a1pController.HandleGetPolicyTypes(ctx) {
+		policyIDs, err := s.a1pController.HandleGetPolicytypesPolicyTypeIdPolicies(ctx, policyTypeID)
+		if err != nil {
+			log.Warnf("Failed to list the policies of type %v: %v", policyTypeID, err)
+			continue
+		}
+		_, listed := s.preexisting[policyTypeID]
+		if !listed {
+			s.preexisting[policyTypeID] = make(map[string]bool)
+		}
+		for _, policyID := range policyIDs {
+			key := store.PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID}
+			held[key] = true
+			if intent, ok := intents[key]; !listed && (!ok || !intent.Exists()) {
+				s.preexisting[policyTypeID][policyID] = true
+			}
+		}
+	}
+	return held
+}
+
+// findDrift returns the policies held by the xApps that A1T does not know; the policies of invalid manifests are not
+// drift
+func (s *source) findDrift(set *manifestSet, intents map[store.PolicyIntentKey]*store.PolicyIntentValue, held map[store.PolicyIntentKey]bool) []*Drift {
+	drift := make([]*Drift, 0)
+	for key := range held {
+		if _, ok := set.manifests[key]; ok {
+			continue
+		}
+		if _, ok := set.failed[key]; ok {
+			continue
+		}
+		if intent, ok := intents[key]; ok && intent.Exists() {
+			continue
+		}
+		drift = append(drift, &Drift{
+			PolicyTypeID: key.PolicyTypeID,
+			PolicyID:     key.PolicyID,
+			Preexisting:  s.preexisting[key.PolicyTypeID][key.PolicyID],
+		})
+	}
+	sort.Slice(drift, func(i, j int) bool {
+		if drift[i].PolicyTypeID != drift[j].PolicyTypeID {
+			return drift[i].PolicyTypeID < drift[j].PolicyTypeID
+		}
+		return drift[i].PolicyID < drift[j].PolicyID
+	})
+	return drift
+}
+
+func newChange(action controller.BulkAction, m *Manifest) *Change {
+	return &Change{
+		Action:       action,
+		PolicyTypeID: m.PolicyTypeID,
+		PolicyID:     m.PolicyID,
+		File:         m.File,
+	}
+}
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policysource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onosproject/onos-a1t/pkg/controller"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPolicyTypeID = "ORAN_TrafficSteeringPreference_2.0.0"
+
+// fakeController serves the policies held by the xApps, by policy type and policy ID; the writes are not used by the
+// dry runs
+type fakeController struct {
+	controller.A1PController
+	held map[string]map[string]map[string]interface{}
+}
+
+func (c *fakeController) HandleGetPolicyTypes(ctx context.Context) []string {
+	policyTypeIDs := make([]string, 0, len(c.held))
+	for policyTypeID := range c.held {
+		policyTypeIDs = append(policyTypeIDs, policyTypeID)
+	}
+	return policyTypeIDs
+}
+
+func (c *fakeController) HandleGetPolicytypesPolicyTypeIdPolicies(ctx context.Context, policyTypeID string) ([]string, error) {
+	policyIDs := make([]string, 0, len(c.held[policyTypeID]))
+	for policyID := range c.held[policyTypeID] {
+		policyIDs = append(policyIDs, policyID)
+	}
+	return policyIDs, nil
+}
+
+func (c *fakeController) HandleGetPolicy(ctx context.Context, policyID, policyTypeID string) (map[string]interface{}, error) {
+	body, ok := c.held[policyTypeID][policyID]
+	if !ok {
+		return nil, errors.NewNotFound("policy %v of type %v not found", policyID, policyTypeID)
+	}
+	return body, nil
+}
+
+func writeManifest(t *testing.T, dir, name, content string) {
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+}
+
+func addIntent(ctx context.Context, t *testing.T, s store.Store, policyID, owner string, policyObject map[string]interface{}) {
+	_, err := store.AddPolicyRevision(ctx, s, testPolicyTypeID, policyID, map[string]string{}, policyObject, false, owner)
+	assert.NoError(t, err)
+}
+
+func newTestSource(dir string, s store.Store, c controller.A1PController) Source {
+	config := DefaultConfig()
+	config.Dir = dir
+	return NewSource(config, s, c)
+}
+
+// changes returns the planned actions by policy ID, with whether they failed
+func changes(status *Status) map[string]string {
+	results := make(map[string]string)
+	for _, c := range status.Changes {
+		results[c.PolicyID] = c.Action.String()
+		if c.Err != nil {
+			results[c.PolicyID] += " failed"
+		}
+	}
+	return results
+}
+
+func TestSyncPlan(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writeManifest(t, dir, "policies.yaml", `
+- policyTypeId: ORAN_TrafficSteeringPreference_2.0.0
+  policyId: create
+  policy: {priority: 1}
+- policyTypeId: ORAN_TrafficSteeringPreference_2.0.0
+  policyId: update
+  policy: {priority: 2}
+- policyTypeId: ORAN_TrafficSteeringPreference_2.0.0
+  policyId: unchanged
+  policy: {priority: 3}
+- policyTypeId: ORAN_TrafficSteeringPreference_2.0.0
+  policyId: other-owner
+  policy: {priority: 4}
+- policyTypeId: ORAN_TrafficSteeringPreference_2.0.0
+  policyId: adopt
+  policy: {priority: 5}
+- policyTypeId: ORAN_TrafficSteeringPreference_2.0.0
+  policyId: held
+  policy: {priority: 6}
+`)
+	writeManifest(t, dir, ".hidden.yaml", "not: [a manifest")
+
+	s := store.NewStore()
+	addIntent(ctx, t, s, "update", Caller, map[string]interface{}{"priority": 1})
+	addIntent(ctx, t, s, "unchanged", Caller, map[string]interface{}{"priority": 3.0})
+	addIntent(ctx, t, s, "other-owner", "tenant-1", map[string]interface{}{"priority": 4})
+	addIntent(ctx, t, s, "removed", Caller, map[string]interface{}{"priority": 7})
+	addIntent(ctx, t, s, "unowned", "", map[string]interface{}{"priority": 8})
+
+	c := &fakeController{held: map[string]map[string]map[string]interface{}{
+		testPolicyTypeID: {
+			"adopt": {"priority": 5},
+			"held":  {"priority": 0},
+		},
+	}}
+	status := newTestSource(dir, s, c).Sync(ctx, true)
+	assert.True(t, status.DryRun)
+	assert.Empty(t, status.Errors)
+	assert.Equal(t, 6, status.Manifests)
+	assert.NotEmpty(t, status.Digest)
+	assert.Equal(t, map[string]string{
+		"create":  controller.BulkCreate.String(),
+		"update":  controller.BulkUpdate.String(),
+		"adopt":   controller.BulkUpdate.String(),
+		"removed": controller.BulkDelete.String(),
+	}, changes(status))
+	for _, change := range status.Changes {
+		assert.False(t, change.Applied)
+	}
+
+	if assert.Len(t, status.Conflicts, 2) {
+		conflicts := make(map[string]*Conflict)
+		for _, conflict := range status.Conflicts {
+			conflicts[conflict.PolicyID] = conflict
+		}
+		assert.Equal(t, "tenant-1", conflicts["other-owner"].Owner)
+		assert.Empty(t, conflicts["held"].Owner)
+		assert.Contains(t, conflicts["held"].Reason, "/priority")
+	}
+	assert.Empty(t, status.Drift)
+}
+
+func TestSyncInvalidManifest(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writeManifest(t, dir, "invalid.json", `{"policyTypeId": "ORAN_TrafficSteeringPreference_2.0.0", "policyId": "invalid"}`)
+
+	s := store.NewStore()
+	addIntent(ctx, t, s, "invalid", Caller, map[string]interface{}{"priority": 1})
+	addIntent(ctx, t, s, "removed", Caller, map[string]interface{}{"priority": 2})
+
+	status := newTestSource(dir, s, &fakeController{}).Sync(ctx, true)
+	assert.Len(t, status.Errors, 1)
+	assert.Equal(t, 0, status.Manifests)
+	assert.Equal(t, map[string]string{
+		"invalid": controller.BulkDelete.String() + " failed",
+		"removed": controller.BulkDelete.String() + " failed",
+	}, changes(status))
+	for _, change := range status.Changes {
+		if change.PolicyID == "invalid" {
+			assert.True(t, errors.IsInvalid(change.Err))
+			assert.Equal(t, "invalid.json", change.File)
+		} else {
+			assert.True(t, errors.IsUnavailable(change.Err))
+		}
+	}
+}
+
+func TestSyncDrift(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writeManifest(t, dir, "policy.json", `{"policyTypeId": "ORAN_TrafficSteeringPreference_2.0.0", "policyId": "managed", "policy": {"priority": 1}}`)
+
+	s := store.NewStore()
+	addIntent(ctx, t, s, "managed", Caller, map[string]interface{}{"priority": 1})
+	addIntent(ctx, t, s, "known", "tenant-1", map[string]interface{}{"priority": 2})
+	c := &fakeController{held: map[string]map[string]map[string]interface{}{
+		testPolicyTypeID: {
+			"managed":     {"priority": 1},
+			"known":       {"priority": 2},
+			"preexisting": {"priority": 3},
+		},
+	}}
+	source := newTestSource(dir, s, c)
+	status := source.Sync(ctx, true)
+	assert.Empty(t, status.Changes)
+	if assert.Len(t, status.Drift, 1) {
+		assert.Equal(t, "preexisting", status.Drift[0].PolicyID)
+		assert.True(t, status.Drift[0].Preexisting)
+	}
+
+	// a policy which shows up after the type was first listed is drift the source may correct
+	c.held[testPolicyTypeID]["drifted"] = map[string]interface{}{"priority": 4}
+	status = source.Sync(ctx, true)
+	if assert.Len(t, status.Drift, 2) {
+		assert.Equal(t, "drifted", status.Drift[0].PolicyID)
+		assert.False(t, status.Drift[0].Preexisting)
+		assert.False(t, status.Drift[0].Corrected)
+		assert.Equal(t, "preexisting", status.Drift[1].PolicyID)
+		assert.True(t, status.Drift[1].Preexisting)
+	}
+	assert.Equal(t, status, source.Status())
+}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policytype
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"sigs.k8s.io/yaml"
+)
+
+var log = logging.GetLogger()
+
+// Scopes are the policy scope identifiers a conflict rule can compare
+var Scopes = []string{"cellId", "ueId", "sliceId", "groupId"}
+
+// ConflictAction defines what happens to a policy whose scope overlaps with the scope of an existing policy
+type ConflictAction int
+
+const (
+	// ConflictWarn applies the policy and reports the overlap to the caller
+	ConflictWarn ConflictAction = iota
+	// ConflictReject rejects the policy
+	ConflictReject
+	// ConflictPriority applies the policy, with a warning, unless an overlapping policy has a higher priority
+	ConflictPriority
+)
+
+func (c ConflictAction) String() string {
+	return [...]string{"Warn", "Reject", "Priority"}[c]
+}
+
+func ParseConflictAction(action string) (ConflictAction, error) {
+	for _, c := range []ConflictAction{ConflictWarn, ConflictReject, ConflictPriority} {
+		if strings.EqualFold(action, c.String()) {
+			return c, nil
+		}
+	}
+	return ConflictWarn, errors.NewInvalid("unknown conflict action %v", action)
+}
+
+func (c ConflictAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func (c *ConflictAction) UnmarshalJSON(data []byte) error {
+	var action string
+	if err := json.Unmarshal(data, &action); err != nil {
+		return err
+	}
+	parsed, err := ParseConflictAction(action)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// ConflictRule defines when policies of a type conflict with other policies
+type ConflictRule struct {
+	Action ConflictAction `json:"action"`
+	// Scopes are the scope identifiers compared to find overlaps; empty compares all of them
+	Scopes []string `json:"scopes,omitempty"`
+	// RelatedTypes are the other policy types whose policies may contradict the policies of this type
+	RelatedTypes []string `json:"relatedTypes,omitempty"`
+	Priority     int      `json:"priority,omitempty"`
+}
+
+// ComparesScope returns true if the rule compares the scope identifier
+func (r *ConflictRule) ComparesScope(scope string) bool {
+	if len(r.Scopes) == 0 {
+		return true
+	}
+	for _, s := range r.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type PolicyType struct {
+	PolicyTypeID string        `json:"policyTypeId"`
+	Conflicts    *ConflictRule `json:"conflicts,omitempty"`
+}
+
+type registryFile struct {
+	PolicyTypes []*PolicyType `json:"policyTypes"`
+}
+
+// Registry holds the A1T settings of the policy types
+type Registry interface {
+	Get(policyTypeID string) (*PolicyType, bool)
+	List() []*PolicyType
+	// Related returns true if either type lists the other one as related
+	Related(policyTypeID1, policyTypeID2 string) bool
+}
+
+func NewRegistry(policyTypes ...*PolicyType) Registry {
+	r := &registry{
+		policyTypes: make(map[string]*PolicyType),
+	}
+	for _, t := range policyTypes {
+		r.policyTypes[t.PolicyTypeID] = t
+	}
+	return r
+}
+
+// LoadRegistry reads the registry from a YAML or JSON file
+func LoadRegistry(path string) (Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, errors.NewInvalid("%v: %v", path, err)
+	}
+	file := &registryFile{}
+	if err := json.Unmarshal(doc, file); err != nil {
+		return nil, errors.NewInvalid("%v: %v", path, err)
+	}
+	for _, t := range file.PolicyTypes {
+		if t.PolicyTypeID == "" {
+			return nil, errors.NewInvalid("%v: policyTypeId is required", path)
+		}
+		if t.Conflicts != nil {
+			for _, s := range t.Conflicts.Scopes {
+				if !isScope(s) {
+					return nil, errors.NewInvalid("%v: unknown scope %v of policy type %v", path, s, t.PolicyTypeID)
+				}
+			}
+		}
+	}
+	log.Infof("Loaded %d policy types from %v", len(file.PolicyTypes), path)
+	return NewRegistry(file.PolicyTypes...), nil
+}
+
+type registry struct {
+	policyTypes map[string]*PolicyType
+	mu          sync.RWMutex
+}
+
+func (r *registry) Get(policyTypeID string) (*PolicyType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.policyTypes[policyTypeID]
+	return t, ok
+}
+
+func (r *registry) List() []*PolicyType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	results := make([]*PolicyType, 0, len(r.policyTypes))
+	for _, t := range r.policyTypes {
+		results = append(results, t)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].PolicyTypeID < results[j].PolicyTypeID
+	})
+	return results
+}
+
+func (r *registry) Related(policyTypeID1, policyTypeID2 string) bool {
+	return r.lists(policyTypeID1, policyTypeID2) || r.lists(policyTypeID2, policyTypeID1)
+}
+
+func (r *registry) lists(policyTypeID, relatedTypeID string) bool {
+	t, ok := r.Get(policyTypeID)
+	if !ok || t.Conflicts == nil {
+		return false
+	}
+	for _, related := range t.Conflicts.RelatedTypes {
+		if related == relatedTypeID {
+			return true
+		}
+	}
+	return false
+}
+
+func isScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,7 @@ package rnib
 import (
 	"context"
 	"crypto/md5"
+	"encoding/json"
 
 	gogotypes "github.com/gogo/protobuf/types"
 	uuid2 "github.com/google/uuid"
@@ -31,6 +32,24 @@ type TopoClient interface {
 	GetXappRelationTopoID(xappID topoapi.ID) topoapi.ID
 	GetPolicyTypes(ctx context.Context) (map[topoapi.PolicyTypeID]*topoapi.A1PolicyType, error)
 	GetXAppIDsForPolicyTypeID(ctx context.Context, policyTypeID string) ([]string, error)
+	GetXappEITypes(ctx context.Context, xappID topoapi.ID) ([]*EIType, error)
+}
+
+// EITypesAspect is the topo aspect in which an xApp declares the EI types it consumes; the XAppInfo aspect has no
+// EI types yet
+const EITypesAspect = "onos.a1t.EITypes"
+
+// EIType is an EI type consumed by an xApp
+type EIType struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// EITypes is the value of the EITypesAspect aspect
+type EITypes struct {
+	EITypes []*EIType `json:"eiTypes"`
 }
 
 // NewClient creates a new topo SDK client
@@ -104,6 +123,26 @@ func (c *Client) GetXappAspects(ctx context.Context, xappID topoapi.ID) (*topoap
 	return xAppInfo, err
 }
 
+// GetXappEITypes returns the EI types declared by the xApp; NotFound if the xApp does not declare its EI types
+func (c *Client) GetXappEITypes(ctx context.Context, xappID topoapi.ID) ([]*EIType, error) {
+	object, err := c.client.Get(ctx, xappID)
+	if err != nil {
+		return nil, err
+	}
+	if object.GetAspects()[EITypesAspect] == nil {
+		return nil, errors.NewNotFound("xApp %v does not declare its EI types", xappID)
+	}
+	b, err := object.GetAspectBytes(EITypesAspect)
+	if err != nil {
+		return nil, err
+	}
+	eiTypes := &EITypes{}
+	if err := json.Unmarshal(b, eiTypes); err != nil {
+		return nil, errors.NewInvalid("invalid %v aspect of xApp %v: %v", EITypesAspect, xappID, err)
+	}
+	return eiTypes.EITypes, nil
+}
+
 func getXappFilter() *topoapi.Filters {
 	controlRelationFilter := &topoapi.Filters{
 		KindFilter: &topoapi.Filter{
@@ -6,13 +6,14 @@ package sbclient
 
 import (
 	"context"
+	"fmt"
 	"io"
 
-	"github.com/google/uuid"
 	"github.com/onosproject/onos-a1t/pkg/stream"
 	"github.com/onosproject/onos-api/go/onos/a1t/a1"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"google.golang.org/grpc"
 )
 
 var log = logging.GetLogger()
@@ -24,16 +25,15 @@ func NewA1EIClient(ctx context.Context, targetXAppID string, ipAddress string, p
 		return nil, err
 	}
 
-	// create broker session
-	createStream(ctx, targetXAppID, stream.EnrichmentInformation, streamBroker)
-
 	return &a1eiClient{
 		targetXAppID: targetXAppID,
 		ipAddress:    ipAddress,
 		port:         port,
+		conn:         conn,
 		grpcClient:   a1.NewEIServiceClient(conn),
 		streamBroker: streamBroker,
 		sessions:     make(map[stream.A1SBIRPCType]interface{}),
+		errCh:        make(chan error, 1),
 	}, nil
 }
 
@@ -41,27 +41,27 @@ type a1eiClient struct {
 	targetXAppID string
 	ipAddress    string
 	port         uint32
+	conn         *grpc.ClientConn
 	grpcClient   a1.EIServiceClient
 	streamBroker stream.Broker
 	sessions     map[stream.A1SBIRPCType]interface{}
+	errCh        chan error
 }
 
-func (a *a1eiClient) Run(ctx context.Context) error {
-	err := a.createSessions(ctx)
-	if err != nil {
-		return err
-	}
+func (a *a1eiClient) Connect(ctx context.Context) error {
+	return a.createSessions(ctx)
+}
 
+func (a *a1eiClient) Run(ctx context.Context) error {
 	a.runIncomingMsgForwarder(ctx)
+	go watchConnectivity(ctx, a.conn, a.errCh)
 
-	err = a.runOutgoingMsgDispatcher(ctx)
-	if err != nil {
-		log.Warn(err)
-		a.Close()
+	select {
+	case err := <-a.errCh:
 		return err
+	case <-ctx.Done():
+		return errors.NewCanceled("A1EI SBI client for %v is just closed - due to the context done", a.Endpoint())
 	}
-
-	return nil
 }
 
 func (a *a1eiClient) createSessions(ctx context.Context) error {
@@ -159,7 +159,6 @@ func (a *a1eiClient) runIncomingMsgForwarder(ctx context.Context) {
 }
 
 func (a *a1eiClient) incomingEIQueryForwarder(ctx context.Context) {
-	defer a.Close()
 	for {
 		select {
 		case <-ctx.Done():
@@ -173,13 +172,16 @@ func (a *a1eiClient) incomingEIQueryForwarder(ctx context.Context) {
 			msg, err := a.sessions[stream.EIQuery].(a1.EIService_EIQueryClient).Recv()
 			if err == io.EOF || err == context.Canceled {
 				log.Warn("A1EI SBI client incoming forwarder for EI Query service is just closed")
+				sessionFailed(a.errCh, errors.NewUnavailable("EI Query session of xApp %v closed", a.targetXAppID))
 				return
 			}
 			if err != nil {
 				log.Warn(err)
+				sessionFailed(a.errCh, err)
 				return
 			}
 			sbMessage := stream.NewSBStreamMessage(a.targetXAppID, stream.EIRequestMessage, stream.EIQuery, stream.EnrichmentInformation, msg)
+			sbMessage.Endpoint = a.Endpoint()
 			_, nbID := stream.GetStreamID(stream.A1EIController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.EnrichmentInformation))
 			err = a.streamBroker.Send(nbID, sbMessage)
 			if err != nil {
@@ -190,7 +192,6 @@ func (a *a1eiClient) incomingEIQueryForwarder(ctx context.Context) {
 }
 
 func (a *a1eiClient) incomingEIJobSetupForwarder(ctx context.Context) {
-	defer a.Close()
 	for {
 		select {
 		case <-ctx.Done():
@@ -204,13 +205,16 @@ func (a *a1eiClient) incomingEIJobSetupForwarder(ctx context.Context) {
 			msg, err := a.sessions[stream.EIJobSetup].(a1.EIService_EIJobSetupClient).Recv()
 			if err == io.EOF || err == context.Canceled {
 				log.Warn("A1EI SBI client incoming forwarder for EI Job Setup service is just closed")
+				sessionFailed(a.errCh, errors.NewUnavailable("EI Job Setup session of xApp %v closed", a.targetXAppID))
 				return
 			}
 			if err != nil {
 				log.Warn(err)
+				sessionFailed(a.errCh, err)
 				return
 			}
 			sbMessage := stream.NewSBStreamMessage(a.targetXAppID, stream.EIRequestMessage, stream.EIJobSetup, stream.EnrichmentInformation, msg)
+			sbMessage.Endpoint = a.Endpoint()
 			_, nbID := stream.GetStreamID(stream.A1EIController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.EnrichmentInformation))
 			err = a.streamBroker.Send(nbID, sbMessage)
 			if err != nil {
@@ -221,7 +225,6 @@ func (a *a1eiClient) incomingEIJobSetupForwarder(ctx context.Context) {
 }
 
 func (a *a1eiClient) incomingEIJobUpdateForwarder(ctx context.Context) {
-	defer a.Close()
 	for {
 		select {
 		case <-ctx.Done():
@@ -235,13 +238,16 @@ func (a *a1eiClient) incomingEIJobUpdateForwarder(ctx context.Context) {
 			msg, err := a.sessions[stream.EIJobUpdate].(a1.EIService_EIJobUpdateClient).Recv()
 			if err == io.EOF || err == context.Canceled {
 				log.Warn("A1EI SBI client incoming forwarder for EI Job Update service is just closed")
+				sessionFailed(a.errCh, errors.NewUnavailable("EI Job Update session of xApp %v closed", a.targetXAppID))
 				return
 			}
 			if err != nil {
 				log.Warn(err)
+				sessionFailed(a.errCh, err)
 				return
 			}
 			sbMessage := stream.NewSBStreamMessage(a.targetXAppID, stream.EIRequestMessage, stream.EIJobUpdate, stream.EnrichmentInformation, msg)
+			sbMessage.Endpoint = a.Endpoint()
 			_, nbID := stream.GetStreamID(stream.A1EIController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.EnrichmentInformation))
 			err = a.streamBroker.Send(nbID, sbMessage)
 			if err != nil {
@@ -252,7 +258,6 @@ func (a *a1eiClient) incomingEIJobUpdateForwarder(ctx context.Context) {
 }
 
 func (a *a1eiClient) incomingEIJobDeleteForwarder(ctx context.Context) {
-	defer a.Close()
 	for {
 		select {
 		case <-ctx.Done():
@@ -266,13 +271,16 @@ func (a *a1eiClient) incomingEIJobDeleteForwarder(ctx context.Context) {
 			msg, err := a.sessions[stream.EIJobDelete].(a1.EIService_EIJobDeleteClient).Recv()
 			if err == io.EOF || err == context.Canceled {
 				log.Warn("A1EI SBI client incoming forwarder for EI Job Delete service is just closed")
+				sessionFailed(a.errCh, errors.NewUnavailable("EI Job Delete session of xApp %v closed", a.targetXAppID))
 				return
 			}
 			if err != nil {
 				log.Warn(err)
+				sessionFailed(a.errCh, err)
 				return
 			}
 			sbMessage := stream.NewSBStreamMessage(a.targetXAppID, stream.EIRequestMessage, stream.EIJobDelete, stream.EnrichmentInformation, msg)
+			sbMessage.Endpoint = a.Endpoint()
 			_, nbID := stream.GetStreamID(stream.A1EIController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.EnrichmentInformation))
 			err = a.streamBroker.Send(nbID, sbMessage)
 			if err != nil {
@@ -283,7 +291,6 @@ func (a *a1eiClient) incomingEIJobDeleteForwarder(ctx context.Context) {
 }
 
 func (a *a1eiClient) incomingEIJobStatusQueryForwarder(ctx context.Context) {
-	defer a.Close()
 	for {
 		select {
 		case <-ctx.Done():
@@ -297,13 +304,16 @@ func (a *a1eiClient) incomingEIJobStatusQueryForwarder(ctx context.Context) {
 			msg, err := a.sessions[stream.EIJobStatusQuery].(a1.EIService_EIJobStatusQueryClient).Recv()
 			if err == io.EOF || err == context.Canceled {
 				log.Warn("A1EI SBI client incoming forwarder for EI Job Status Query service is just closed")
+				sessionFailed(a.errCh, errors.NewUnavailable("EI Job Status Query session of xApp %v closed", a.targetXAppID))
 				return
 			}
 			if err != nil {
 				log.Warn(err)
+				sessionFailed(a.errCh, err)
 				return
 			}
 			sbMessage := stream.NewSBStreamMessage(a.targetXAppID, stream.EIRequestMessage, stream.EIJobStatusQuery, stream.EnrichmentInformation, msg)
+			sbMessage.Endpoint = a.Endpoint()
 			_, nbID := stream.GetStreamID(stream.A1EIController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.EnrichmentInformation))
 			err = a.streamBroker.Send(nbID, sbMessage)
 			if err != nil {
@@ -313,86 +323,44 @@ func (a *a1eiClient) incomingEIJobStatusQueryForwarder(ctx context.Context) {
 	}
 }
 
-func (a *a1eiClient) runOutgoingMsgDispatcher(ctx context.Context) error {
-	msgCh := make(chan *stream.SBStreamMessage)
-	sbID, _ := stream.GetStreamID(stream.A1EIController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.EnrichmentInformation))
-	watcherID := uuid.New()
-	err := a.streamBroker.Watch(sbID, msgCh, watcherID)
-	if err != nil {
-		return err
-	}
-
-	go func(msgCh chan *stream.SBStreamMessage) {
-		for msg := range msgCh {
-			a.outgoingMsgDispatcher(ctx, msg)
-		}
-	}(msgCh)
-
-	<-ctx.Done()
-	return errors.NewCanceled("A1EI SBI client outgoing message dispatcher is just closed - due to the context done")
-}
-
-func (a *a1eiClient) outgoingMsgDispatcher(ctx context.Context, msg *stream.SBStreamMessage) {
+func (a *a1eiClient) Dispatch(ctx context.Context, msg *stream.SBStreamMessage) (*stream.SBStreamMessage, error) {
+	var ack *a1.EIAckMessage
 	var err error
 	switch msg.A1SBIRPCType {
 	case stream.EIQuery:
-		err = a.sessions[stream.EIQuery].(a1.EIService_EIQueryClient).Send(msg.Payload.(*a1.EIResultMessage))
-		if err != nil {
-			log.Warn(err)
-		}
+		return nil, a.sessions[stream.EIQuery].(a1.EIService_EIQueryClient).Send(msg.Payload.(*a1.EIResultMessage))
 	case stream.EIJobSetup:
-		err = a.sessions[stream.EIJobSetup].(a1.EIService_EIJobSetupClient).Send(msg.Payload.(*a1.EIResultMessage))
-		if err != nil {
-			log.Warn(err)
-		}
+		return nil, a.sessions[stream.EIJobSetup].(a1.EIService_EIJobSetupClient).Send(msg.Payload.(*a1.EIResultMessage))
 	case stream.EIJobUpdate:
-		err = a.sessions[stream.EIJobUpdate].(a1.EIService_EIJobUpdateClient).Send(msg.Payload.(*a1.EIResultMessage))
-		if err != nil {
-			log.Warn(err)
-		}
+		return nil, a.sessions[stream.EIJobUpdate].(a1.EIService_EIJobUpdateClient).Send(msg.Payload.(*a1.EIResultMessage))
 	case stream.EIJobDelete:
-		err = a.sessions[stream.EIJobDelete].(a1.EIService_EIJobDeleteClient).Send(msg.Payload.(*a1.EIResultMessage))
-		if err != nil {
-			log.Warn(err)
-		}
+		return nil, a.sessions[stream.EIJobDelete].(a1.EIService_EIJobDeleteClient).Send(msg.Payload.(*a1.EIResultMessage))
 	case stream.EIJobStatusQuery:
-		err = a.sessions[stream.EIJobStatusQuery].(a1.EIService_EIJobStatusQueryClient).Send(msg.Payload.(*a1.EIResultMessage))
-		if err != nil {
-			log.Warn(err)
-		}
+		return nil, a.sessions[stream.EIJobStatusQuery].(a1.EIService_EIJobStatusQueryClient).Send(msg.Payload.(*a1.EIResultMessage))
 	case stream.EIJobStatusNotify:
-		ack, err := a.grpcClient.EIJobStatusNotify(ctx, msg.Payload.(*a1.EIStatusMessage))
-		if err != nil {
-			log.Warn(err)
-		}
-		a.forwardResponseMsg(ack, stream.EIAckMessage, stream.EIJobStatusNotify)
+		ack, err = a.grpcClient.EIJobStatusNotify(ctx, msg.Payload.(*a1.EIStatusMessage))
 	case stream.EIJobResultDelivery:
-		ack, err := a.grpcClient.EIJobResultDelivery(ctx, msg.Payload.(*a1.EIResultMessage))
-		if err != nil {
-			log.Warn(err)
-		}
-		a.forwardResponseMsg(ack, stream.EIAckMessage, stream.EIJobResultDelivery)
+		ack, err = a.grpcClient.EIJobResultDelivery(ctx, msg.Payload.(*a1.EIResultMessage))
+	default:
+		return nil, errors.NewNotSupported("RPC type %v is not supported by the A1EI SBI client", msg.A1SBIRPCType)
 	}
-}
-
-func (a *a1eiClient) forwardResponseMsg(msg interface{}, messageType stream.A1SBIMessageType, rpcType stream.A1SBIRPCType) {
-	sbMessage := stream.NewSBStreamMessage(a.targetXAppID, messageType, rpcType, stream.EnrichmentInformation, msg)
-	_, nbID := stream.GetStreamID(stream.A1EIController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.EnrichmentInformation))
-	err := a.streamBroker.Send(nbID, sbMessage)
 	if err != nil {
-		log.Warn(err)
+		return nil, err
 	}
+	resp := stream.NewSBStreamMessage(a.targetXAppID, stream.EIAckMessage, msg.A1SBIRPCType, stream.EnrichmentInformation, ack)
+	resp.Endpoint = a.Endpoint()
+	return resp, nil
+}
+
+func (a *a1eiClient) Endpoint() string {
+	return fmt.Sprintf("%s:%d", a.ipAddress, a.port)
 }
 
 func (a *a1eiClient) Close() {
-	defer delete(a.sessions, stream.EIQuery)
-	defer delete(a.sessions, stream.EIJobSetup)
-	defer delete(a.sessions, stream.EIJobUpdate)
-	defer delete(a.sessions, stream.EIJobDelete)
-	defer delete(a.sessions, stream.EIJobStatusQuery)
-
-	// delete stream
-	deleteStream(a.targetXAppID, stream.EnrichmentInformation, a.streamBroker)
+	err := a.conn.Close()
+	if err != nil {
+		log.Warn(err)
+	}
 }
 
 var _ Client = &a1eiClient{}
@@ -6,13 +6,13 @@ package sbclient
 
 import (
 	"context"
+	"fmt"
 	"io"
-	"time"
 
-	"github.com/google/uuid"
 	"github.com/onosproject/onos-a1t/pkg/stream"
 	"github.com/onosproject/onos-api/go/onos/a1t/a1"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"google.golang.org/grpc"
 )
 
 func NewA1PClient(ctx context.Context, targetXAppID string, ipAddress string, port uint32, streamBroker stream.Broker) (Client, error) {
@@ -22,16 +22,15 @@ func NewA1PClient(ctx context.Context, targetXAppID string, ipAddress string, po
 		return nil, err
 	}
 
-	// create broker session
-	createStream(ctx, targetXAppID, stream.PolicyManagement, streamBroker)
-
 	return &a1pClient{
 		targetXAppID: targetXAppID,
 		ipAddress:    ipAddress,
 		port:         port,
+		conn:         conn,
 		grpcClient:   a1.NewPolicyServiceClient(conn),
 		streamBroker: streamBroker,
 		sessions:     make(map[stream.A1SBIRPCType]interface{}),
+		errCh:        make(chan error, 1),
 	}, nil
 }
 
@@ -39,27 +38,27 @@ type a1pClient struct {
 	targetXAppID string
 	ipAddress    string
 	port         uint32
+	conn         *grpc.ClientConn
 	grpcClient   a1.PolicyServiceClient
 	streamBroker stream.Broker
 	sessions     map[stream.A1SBIRPCType]interface{}
+	errCh        chan error
 }
 
-func (a *a1pClient) Run(ctx context.Context) error {
-	err := a.createSessions(ctx)
-	if err != nil {
-		return err
-	}
+func (a *a1pClient) Connect(ctx context.Context) error {
+	return a.createSessions(ctx)
+}
 
+func (a *a1pClient) Run(ctx context.Context) error {
 	a.runIncomingMsgForwarder(ctx)
+	go watchConnectivity(ctx, a.conn, a.errCh)
 
-	err = a.runOutgoingMsgDispatcher(ctx)
-
-	if err != nil {
-		log.Warn(err)
-		a.Close()
+	select {
+	case err := <-a.errCh:
 		return err
+	case <-ctx.Done():
+		return errors.NewCanceled("A1P SBI client for %v is just closed - due to the context done", a.Endpoint())
 	}
-	return nil
 }
 
 func (a *a1pClient) createSessions(ctx context.Context) error {
@@ -86,7 +85,6 @@ func (a *a1pClient) runIncomingMsgForwarder(ctx context.Context) {
 }
 
 func (a *a1pClient) incomingPolicyStatusForwarder(ctx context.Context) {
-	defer a.Close()
 	for {
 		select {
 		case <-ctx.Done():
@@ -100,13 +98,16 @@ func (a *a1pClient) incomingPolicyStatusForwarder(ctx context.Context) {
 			msg, err := a.sessions[stream.PolicyStatus].(a1.PolicyService_PolicyStatusClient).Recv()
 			if err == io.EOF || err == context.Canceled {
 				log.Warn("A1P SBI client incoming forwarder for Policy Status service is just closed")
+				sessionFailed(a.errCh, errors.NewUnavailable("Policy Status session of xApp %v closed", a.targetXAppID))
 				return
 			}
 			if err != nil {
 				log.Warn(err)
+				sessionFailed(a.errCh, err)
 				return
 			}
 			sbMessage := stream.NewSBStreamMessage(a.targetXAppID, stream.PolicyStatusMessage, stream.PolicyStatus, stream.PolicyManagement, msg)
+			sbMessage.Endpoint = a.Endpoint()
 			_, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.PolicyManagement))
 			err = a.streamBroker.Send(nbID, sbMessage)
 			if err != nil {
@@ -116,81 +117,48 @@ func (a *a1pClient) incomingPolicyStatusForwarder(ctx context.Context) {
 	}
 }
 
-func (a *a1pClient) runOutgoingMsgDispatcher(ctx context.Context) error {
-	msgCh := make(chan *stream.SBStreamMessage)
-	sbID, _ := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.PolicyManagement))
-	watcherID := uuid.New()
-	err := a.streamBroker.Watch(sbID, msgCh, watcherID)
-	if err != nil {
-		return err
-	}
-
-	go func(msgCh chan *stream.SBStreamMessage) {
-		for msg := range msgCh {
-			go a.outgoingMsgDispatcher(ctx, msg)
-		}
-	}(msgCh)
-
-	<-ctx.Done()
-	return errors.NewCanceled("A1P SBI client outgoing message dispatcher is just closed - due to the context done")
-}
-
-func (a *a1pClient) outgoingMsgDispatcher(ctx context.Context, msg *stream.SBStreamMessage) {
-	log.Infof("Received message from controller: %v", *msg)
-	tCtx, tCancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (a *a1pClient) Dispatch(ctx context.Context, msg *stream.SBStreamMessage) (*stream.SBStreamMessage, error) {
+	log.Infof("Sending message from controller to %v: %v", a.Endpoint(), *msg)
+	tCtx, tCancel := context.WithTimeout(ctx, GRPCTimeout)
 	defer tCancel()
+	var result *a1.PolicyResultMessage
 	var err error
 	switch msg.A1SBIRPCType {
 	case stream.PolicySetup:
 		log.Info("Sending PolicySetup Request message")
-		result, err := a.grpcClient.PolicySetup(tCtx, msg.Payload.(*a1.PolicyRequestMessage))
-		if err != nil {
-			log.Warn(err)
-		}
-		a.forwardResponseMsg(result, stream.PolicyResultMessage, stream.PolicySetup)
+		result, err = a.grpcClient.PolicySetup(tCtx, msg.Payload.(*a1.PolicyRequestMessage))
 	case stream.PolicyUpdate:
 		log.Info("Sending PolicyUpdate Request message")
-		result, err := a.grpcClient.PolicyUpdate(tCtx, msg.Payload.(*a1.PolicyRequestMessage))
-		if err != nil {
-			log.Warn(err)
-		}
-		a.forwardResponseMsg(result, stream.PolicyResultMessage, stream.PolicyUpdate)
+		result, err = a.grpcClient.PolicyUpdate(tCtx, msg.Payload.(*a1.PolicyRequestMessage))
 	case stream.PolicyDelete:
 		log.Info("Sending PolicyDelete Request message")
-		result, err := a.grpcClient.PolicyDelete(tCtx, msg.Payload.(*a1.PolicyRequestMessage))
-		if err != nil {
-			log.Warn(err)
-		}
-		a.forwardResponseMsg(result, stream.PolicyResultMessage, stream.PolicyDelete)
+		result, err = a.grpcClient.PolicyDelete(tCtx, msg.Payload.(*a1.PolicyRequestMessage))
 	case stream.PolicyQuery:
 		log.Info("Sending PolicyQuery Request message")
-		result, err := a.grpcClient.PolicyQuery(tCtx, msg.Payload.(*a1.PolicyRequestMessage))
-		if err != nil {
-			log.Warn(err)
-		}
-		a.forwardResponseMsg(result, stream.PolicyResultMessage, stream.PolicyQuery)
+		result, err = a.grpcClient.PolicyQuery(tCtx, msg.Payload.(*a1.PolicyRequestMessage))
 	case stream.PolicyStatus:
 		log.Info("Sending PolicAck message")
-		err = a.sessions[stream.PolicyStatus].(a1.PolicyService_PolicyStatusClient).Send(msg.Payload.(*a1.PolicyAckMessage))
-		if err != nil {
-			log.Warn(err)
-		}
+		return nil, a.sessions[stream.PolicyStatus].(a1.PolicyService_PolicyStatusClient).Send(msg.Payload.(*a1.PolicyAckMessage))
+	default:
+		return nil, errors.NewNotSupported("RPC type %v is not supported by the A1P SBI client", msg.A1SBIRPCType)
 	}
-}
-
-func (a *a1pClient) forwardResponseMsg(msg interface{}, messageType stream.A1SBIMessageType, rpcType stream.A1SBIRPCType) {
-	log.Info("Forwarding response message")
-	sbMessage := stream.NewSBStreamMessage(a.targetXAppID, messageType, rpcType, stream.PolicyManagement, msg)
-	_, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(a.targetXAppID, stream.PolicyManagement))
-	err := a.streamBroker.Send(nbID, sbMessage)
 	if err != nil {
-		log.Warn(err)
+		return nil, err
 	}
+	resp := stream.NewSBStreamMessage(a.targetXAppID, stream.PolicyResultMessage, msg.A1SBIRPCType, stream.PolicyManagement, result)
+	resp.Endpoint = a.Endpoint()
+	return resp, nil
+}
+
+func (a *a1pClient) Endpoint() string {
+	return fmt.Sprintf("%s:%d", a.ipAddress, a.port)
 }
 
 func (a *a1pClient) Close() {
-	defer delete(a.sessions, stream.PolicyStatus)
-	deleteStream(a.targetXAppID, stream.PolicyManagement, a.streamBroker)
+	err := a.conn.Close()
+	if err != nil {
+		log.Warn(err)
+	}
 }
 
 var _ Client = &a1pClient{}
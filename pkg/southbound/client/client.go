@@ -4,9 +4,20 @@
 
 package sbclient
 
-import "context"
+import (
+	"context"
+
+	"github.com/onosproject/onos-a1t/pkg/stream"
+)
 
 type Client interface {
+	// Connect opens the streaming sessions with the xApp
+	Connect(ctx context.Context) error
+	// Run forwards the messages coming from the xApp to the broker until a session or the connection fails
 	Run(ctx context.Context) error
+	// Dispatch sends a message of the controller to the xApp; it returns the response for unary RPCs
+	Dispatch(ctx context.Context, msg *stream.SBStreamMessage) (*stream.SBStreamMessage, error)
+	// Endpoint returns the address of the xApp endpoint the client is connected to
+	Endpoint() string
 	Close()
 }
@@ -8,9 +8,12 @@ import (
 	"context"
 	"fmt"
 	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/grpc/retry"
 	"github.com/onosproject/onos-ric-sdk-go/pkg/utils/creds"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
 	"time"
 )
@@ -31,7 +34,8 @@ func createGRPCConn(ipAddress string, port uint32) (*grpc.ClientConn, error) {
 	return grpc.Dial(fmt.Sprintf("%s:%d", ipAddress, port), opts...)
 }
 
-func createStream(ctx context.Context, xAppID string, a1Service stream.A1Service, streamBroker stream.Broker) {
+// CreateStream creates the broker streams between the controller and the southbound client of the xApp
+func CreateStream(ctx context.Context, xAppID string, a1Service stream.A1Service, streamBroker stream.Broker) {
 	switch a1Service {
 	case stream.PolicyManagement:
 		sbID, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(xAppID, stream.PolicyManagement))
@@ -44,7 +48,8 @@ func createStream(ctx context.Context, xAppID string, a1Service stream.A1Service
 	}
 }
 
-func deleteStream(xAppID string, a1Service stream.A1Service, streamBroker stream.Broker) {
+// DeleteStream closes the broker streams between the controller and the southbound client of the xApp
+func DeleteStream(xAppID string, a1Service stream.A1Service, streamBroker stream.Broker) {
 	switch a1Service {
 	case stream.PolicyManagement:
 		sbID, nbID := stream.GetStreamID(stream.A1PController, stream.GetEndpointIDWithTargetXAppID(xAppID, stream.PolicyManagement))
@@ -56,3 +61,74 @@ func deleteStream(xAppID string, a1Service stream.A1Service, streamBroker stream
 		streamBroker.Close(sbID)
 	}
 }
+
+// watchConnectivity reports a session failure once the connection goes into transient failure or shuts down
+func watchConnectivity(ctx context.Context, conn *grpc.ClientConn, errCh chan error) {
+	for {
+		state := conn.GetState()
+		if state == connectivity.TransientFailure || state == connectivity.Shutdown {
+			sessionFailed(errCh, errors.NewUnavailable("gRPC connection to %v is %v", conn.Target(), state))
+			return
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return
+		}
+	}
+}
+
+// sessionFailed reports the first failure of a client to its Run loop without blocking the caller
+func sessionFailed(errCh chan error, err error) {
+	select {
+	case errCh <- err:
+	default:
+	}
+}
+
+// NewFailureResponse builds the response to a message that could not be delivered to the xApp, so that the controller
+// gets the error under the request ID instead of waiting for the timeout; it returns nil if no response is expected
+func NewFailureResponse(msg *stream.SBStreamMessage, err error) *stream.SBStreamMessage {
+	var resp *stream.SBStreamMessage
+	switch req := msg.Payload.(type) {
+	case *a1.PolicyRequestMessage:
+		resp = stream.NewSBStreamMessage(msg.TargetXAppID, stream.PolicyResultMessage, msg.A1SBIRPCType, msg.A1Service, newPolicyFailureResult(req, err))
+	case *a1.EIStatusMessage:
+		resp = stream.NewSBStreamMessage(msg.TargetXAppID, stream.EIAckMessage, msg.A1SBIRPCType, msg.A1Service, newEIFailureAck(req.GetEiJobId(), req.GetMessage().GetHeader(), err))
+	case *a1.EIResultMessage:
+		if msg.A1SBIRPCType != stream.EIJobResultDelivery {
+			return nil
+		}
+		resp = stream.NewSBStreamMessage(msg.TargetXAppID, stream.EIAckMessage, msg.A1SBIRPCType, msg.A1Service, newEIFailureAck(req.GetEiJobId(), req.GetMessage().GetHeader(), err))
+	default:
+		return nil
+	}
+	resp.Error = err
+	return resp
+}
+
+func newPolicyFailureResult(req *a1.PolicyRequestMessage, err error) *a1.PolicyResultMessage {
+	return &a1.PolicyResultMessage{
+		PolicyId:   req.GetPolicyId(),
+		PolicyType: req.GetPolicyType(),
+		Message: &a1.ResultMessage{
+			Header: req.GetMessage().GetHeader(),
+			Result: &a1.Result{
+				Success: false,
+				Reason:  err.Error(),
+			},
+		},
+		NotificationDestination: req.GetNotificationDestination(),
+	}
+}
+
+func newEIFailureAck(eiJobID string, header *a1.Header, err error) *a1.EIAckMessage {
+	return &a1.EIAckMessage{
+		EiJobId: eiJobID,
+		Message: &a1.AckMessage{
+			Header: header,
+			Result: &a1.Result{
+				Success: false,
+				Reason:  err.Error(),
+			},
+		},
+	}
+}
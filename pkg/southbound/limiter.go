@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package southbound
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type RateLimitConfig struct {
+	// Rate is the number of requests per second sent to an xApp; 0 disables rate limiting
+	Rate float64
+	// Burst is the number of requests that can be sent at once above Rate
+	Burst int
+	// MaxInFlight is the number of requests an xApp may be processing at the same time; 0 leaves it bounded only by the
+	// workers dispatching the policy messages
+	MaxInFlight int
+	// QueueTimeout is how long a request may wait for a token and an in-flight slot before it is rejected
+	QueueTimeout time.Duration
+}
+
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Rate:         0,
+		Burst:        1,
+		MaxInFlight:  16,
+		QueueTimeout: 2 * time.Second,
+	}
+}
+
+// OverloadError is the error of a request that expired in the queue of an xApp
+type OverloadError struct {
+	XAppID     string
+	RetryAfter time.Duration
+}
+
+func (e *OverloadError) Error() string {
+	return fmt.Sprintf("xApp %v is overloaded - retry after %v", e.XAppID, e.RetryAfter)
+}
+
+// IsOverloaded returns the OverloadError if the request expired in the queue of an xApp
+func IsOverloaded(err error) (*OverloadError, bool) {
+	e, ok := err.(*OverloadError)
+	return e, ok
+}
+
+// limiter queues the requests to an xApp until both a rate token and an in-flight slot are available
+type limiter struct {
+	xAppID      string
+	config      RateLimitConfig
+	rateLimiter *rate.Limiter
+	slots       chan struct{}
+}
+
+func newLimiter(xAppID string, config RateLimitConfig) *limiter {
+	l := &limiter{
+		xAppID: xAppID,
+		config: config,
+	}
+	if config.Rate > 0 {
+		burst := config.Burst
+		if burst <= 0 {
+			burst = 1
+		}
+		l.rateLimiter = rate.NewLimiter(rate.Limit(config.Rate), burst)
+	}
+	if config.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, config.MaxInFlight)
+	}
+	return l
+}
+
+// acquire waits for the request's turn; the returned function has to be called once the request is done
+func (l *limiter) acquire(ctx context.Context) (func(), error) {
+	if l.config.QueueTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.config.QueueTimeout)
+		defer cancel()
+	}
+
+	queueDepth.WithLabelValues(l.xAppID).Inc()
+	defer queueDepth.WithLabelValues(l.xAppID).Dec()
+
+	if l.rateLimiter != nil {
+		if err := l.rateLimiter.Wait(ctx); err != nil {
+			return nil, l.overloaded()
+		}
+	}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, l.overloaded()
+		}
+	}
+
+	inFlight.WithLabelValues(l.xAppID).Inc()
+	return func() {
+		inFlight.WithLabelValues(l.xAppID).Dec()
+		if l.slots != nil {
+			<-l.slots
+		}
+	}, nil
+}
+
+func (l *limiter) overloaded() error {
+	queueTimeouts.WithLabelValues(l.xAppID).Inc()
+	retryAfter := time.Duration(math.Ceil(l.config.QueueTimeout.Seconds())) * time.Second
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+	return &OverloadError{
+		XAppID:     l.xAppID,
+		RetryAfter: retryAfter,
+	}
+}
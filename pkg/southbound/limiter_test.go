@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package southbound
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiterInFlight(t *testing.T) {
+	l := newLimiter("xapp-1", RateLimitConfig{MaxInFlight: 2, QueueTimeout: 50 * time.Millisecond})
+
+	release1, err := l.acquire(context.Background())
+	assert.NoError(t, err)
+	release2, err := l.acquire(context.Background())
+	assert.NoError(t, err)
+
+	_, err = l.acquire(context.Background())
+	overloadErr, ok := IsOverloaded(err)
+	assert.True(t, ok)
+	assert.Equal(t, "xapp-1", overloadErr.XAppID)
+	assert.Equal(t, time.Second, overloadErr.RetryAfter)
+
+	// a queued request gets the slot of the first request done
+	acquired := make(chan error)
+	go func() {
+		release, err := l.acquire(context.Background())
+		if err == nil {
+			release()
+		}
+		acquired <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	release1()
+	assert.NoError(t, <-acquired)
+	release2()
+}
+
+func TestLimiterRate(t *testing.T) {
+	l := newLimiter("xapp-1", RateLimitConfig{Rate: 1, Burst: 2, QueueTimeout: 100 * time.Millisecond})
+
+	for i := 0; i < 2; i++ {
+		release, err := l.acquire(context.Background())
+		assert.NoError(t, err)
+		release()
+	}
+	_, err := l.acquire(context.Background())
+	_, ok := IsOverloaded(err)
+	assert.True(t, ok)
+}
+
+func TestLimiterCanceled(t *testing.T) {
+	l := newLimiter("xapp-1", RateLimitConfig{MaxInFlight: 1})
+	release, err := l.acquire(context.Background())
+	assert.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx)
+	_, ok := IsOverloaded(err)
+	assert.True(t, ok)
+}
+
+func TestLimiterUnlimited(t *testing.T) {
+	l := newLimiter("xapp-1", RateLimitConfig{})
+	for i := 0; i < 100; i++ {
+		_, err := l.acquire(context.Background())
+		assert.NoError(t, err)
+	}
+}
@@ -6,55 +6,110 @@ package southbound
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	sbclient "github.com/onosproject/onos-a1t/pkg/southbound/client"
 	"github.com/onosproject/onos-a1t/pkg/store"
 	"github.com/onosproject/onos-a1t/pkg/stream"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 )
 
 var log = logging.GetLogger()
 
-func NewSouthboundManager(broker stream.Broker, subStore store.Store) Manager {
+type Config struct {
+	Backoff             BackoffConfig
+	LoadBalancingPolicy LoadBalancingPolicy
+	RateLimit           RateLimitConfig
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Backoff:             DefaultBackoffConfig(),
+		LoadBalancingPolicy: PickFirst,
+		RateLimit:           DefaultRateLimitConfig(),
+	}
+}
+
+func NewSouthboundManager(broker stream.Broker, subStore store.Store, config Config) Manager {
 	return &manager{
 		streamBroker: broker,
-		a1pClients:   make(map[string]sbclient.Client),
-		a1eiClients:  make(map[string]sbclient.Client),
+		a1pClients:   make(map[string]*endpointPool),
+		a1eiClients:  make(map[string]*endpointPool),
+		disconnected: make(map[string]bool),
 		subStore:     subStore,
+		config:       config,
 	}
 }
 
 type Manager interface {
 	Run(ctx context.Context) error
 	Close(xAppID string, a1Service stream.A1Service)
+	// Sessions returns the state of the southbound sessions with all xApp endpoints
+	Sessions() []SessionStatus
+	// Disconnect closes the southbound sessions with the xApp and keeps them closed until Reconnect is called
+	Disconnect(xAppID string) error
+	// Reconnect opens the southbound sessions with the xApp again
+	Reconnect(xAppID string) error
 }
 
 type manager struct {
 	streamBroker stream.Broker
-	a1pClients   map[string]sbclient.Client
-	a1eiClients  map[string]sbclient.Client
+	a1pClients   map[string]*endpointPool
+	a1eiClients  map[string]*endpointPool
+	disconnected map[string]bool
 	subStore     store.Store
+	config       Config
 	clientMu     sync.RWMutex
+	ctx          context.Context
 }
 
 func (m *manager) Close(xAppID string, a1Service stream.A1Service) {
+	var p *endpointPool
+	var ok bool
+	m.clientMu.Lock()
 	switch a1Service {
 	case stream.PolicyManagement:
 		log.Infof("Closing A1 policy management southbound client for xApp ID %v", xAppID)
-		m.clientMu.Lock()
+		p, ok = m.a1pClients[xAppID]
 		delete(m.a1pClients, xAppID)
-		m.clientMu.Unlock()
 	case stream.EnrichmentInformation:
 		log.Infof("Closing A1 EI southbound client for xApp ID %v", xAppID)
-		m.clientMu.Lock()
+		p, ok = m.a1eiClients[xAppID]
 		delete(m.a1eiClients, xAppID)
-		m.clientMu.Unlock()
 	}
+	m.clientMu.Unlock()
+	if ok {
+		p.stop()
+	}
+}
+
+func (m *manager) Sessions() []SessionStatus {
+	m.clientMu.RLock()
+	defer m.clientMu.RUnlock()
+	results := make([]SessionStatus, 0, len(m.a1pClients)+len(m.a1eiClients))
+	for _, p := range m.a1pClients {
+		results = append(results, p.sessions()...)
+	}
+	for _, p := range m.a1eiClients {
+		results = append(results, p.sessions()...)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].XAppID != results[j].XAppID {
+			return results[i].XAppID < results[j].XAppID
+		}
+		return results[i].A1Service < results[j].A1Service
+	})
+	return results
 }
 
 func (m *manager) Run(ctx context.Context) error {
 	log.Info("Run southbound manager")
+	m.clientMu.Lock()
+	m.ctx = ctx
+	m.clientMu.Unlock()
 	return m.watchSubStore(ctx)
 }
 
@@ -101,41 +156,41 @@ func (m *manager) createEventSubStoreHandler(ctx context.Context, entry *store.E
 	value := entry.Value.(*store.SubscriptionValue)
 	m.clientMu.Lock()
 	defer m.clientMu.Unlock()
+	xAppID := string(key.TargetXAppID)
+	if m.disconnected[xAppID] {
+		log.Infof("xApp %v was disconnected by an operator - not connecting it", xAppID)
+		return nil
+	}
 	// todo: currently, a1ei is default session. If not for the future, it should be optional
-	if _, ok := m.a1eiClients[string(key.TargetXAppID)]; !ok {
-		// call NewClient function
-		a1eiClient, err := sbclient.NewA1EIClient(ctx, string(key.TargetXAppID), value.A1EndpointIP, value.A1EndpointPort, m.streamBroker)
+	if p, ok := m.a1eiClients[xAppID]; ok {
+		p.update(value.A1Endpoints)
+	} else {
+		p = newEndpointPool(xAppID, stream.EnrichmentInformation, func(ctx context.Context, endpoint store.A1Endpoint) (sbclient.Client, error) {
+			return sbclient.NewA1EIClient(ctx, xAppID, endpoint.IP, endpoint.Port, m.streamBroker)
+		}, m.streamBroker, m.config)
+		err := p.start(ctx, value.A1Endpoints)
 		if err != nil {
 			return err
 		}
-		// store the created client to the map
-		m.a1eiClients[string(key.TargetXAppID)] = a1eiClient
-		go func() {
-			err = a1eiClient.Run(ctx)
-			if err != nil {
-				log.Warn(err)
-			}
-		}()
+		// store the created pool to the map
+		m.a1eiClients[xAppID] = p
 	}
 	for _, c := range value.A1ServiceCapabilities {
 		switch c.A1Service {
 		case store.PolicyManagement:
-			if _, ok := m.a1pClients[string(key.TargetXAppID)]; ok {
+			if p, ok := m.a1pClients[xAppID]; ok {
+				p.update(value.A1Endpoints)
 				break
 			}
-			// call NewClient function
-			a1pClient, err := sbclient.NewA1PClient(ctx, string(key.TargetXAppID), value.A1EndpointIP, value.A1EndpointPort, m.streamBroker)
+			p := newEndpointPool(xAppID, stream.PolicyManagement, func(ctx context.Context, endpoint store.A1Endpoint) (sbclient.Client, error) {
+				return sbclient.NewA1PClient(ctx, xAppID, endpoint.IP, endpoint.Port, m.streamBroker)
+			}, m.streamBroker, m.config)
+			err := p.start(ctx, value.A1Endpoints)
 			if err != nil {
 				return err
 			}
-			// store the created client to the map
-			m.a1pClients[string(key.TargetXAppID)] = a1pClient
-			go func() {
-				err = a1pClient.Run(ctx)
-				if err != nil {
-					log.Warn(err)
-				}
-			}()
+			// store the created pool to the map
+			m.a1pClients[xAppID] = p
 		}
 	}
 	return nil
@@ -144,12 +199,40 @@ func (m *manager) createEventSubStoreHandler(ctx context.Context, entry *store.E
 func (m *manager) deleteEventSubStoreHandler(ctx context.Context, entry *store.Entry) error {
 	log.Infof("Subscription store entry %v was just deleted", *entry)
 	key := entry.Key.(store.SubscriptionKey)
-	if _, ok := m.a1eiClients[string(key.TargetXAppID)]; ok {
-		m.Close(string(key.TargetXAppID), stream.EnrichmentInformation)
-	}
-	if _, ok := m.a1pClients[string(key.TargetXAppID)]; ok {
-		m.Close(string(key.TargetXAppID), stream.PolicyManagement)
-	}
+	m.Close(string(key.TargetXAppID), stream.EnrichmentInformation)
+	m.Close(string(key.TargetXAppID), stream.PolicyManagement)
+
+	return nil
+}
 
+func (m *manager) Disconnect(xAppID string) error {
+	if _, err := m.subStore.Get(context.Background(), store.SubscriptionKey{TargetXAppID: topoapi.ID(xAppID)}); err != nil {
+		return err
+	}
+	m.clientMu.Lock()
+	m.disconnected[xAppID] = true
+	m.clientMu.Unlock()
+	log.Infof("Disconnecting xApp %v", xAppID)
+	m.Close(xAppID, stream.EnrichmentInformation)
+	m.Close(xAppID, stream.PolicyManagement)
 	return nil
 }
+
+func (m *manager) Reconnect(xAppID string) error {
+	entry, err := m.subStore.Get(context.Background(), store.SubscriptionKey{TargetXAppID: topoapi.ID(xAppID)})
+	if err != nil {
+		return err
+	}
+	m.clientMu.Lock()
+	delete(m.disconnected, xAppID)
+	ctx := m.ctx
+	m.clientMu.Unlock()
+	if ctx == nil {
+		return errors.NewUnavailable("southbound manager is not running")
+	}
+	log.Infof("Reconnecting xApp %v", xAppID)
+	// the sessions are set up from scratch, as after a restart of A1T
+	m.Close(xAppID, stream.EnrichmentInformation)
+	m.Close(xAppID, stream.PolicyManagement)
+	return m.createEventSubStoreHandler(ctx, entry)
+}
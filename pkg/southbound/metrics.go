@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package southbound
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	queueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "a1t",
+		Subsystem: "southbound",
+		Name:      "queue_depth",
+		Help:      "Number of requests waiting to be sent to an xApp",
+	}, []string{"xapp_id"})
+
+	inFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "a1t",
+		Subsystem: "southbound",
+		Name:      "in_flight_requests",
+		Help:      "Number of requests being processed by an xApp",
+	}, []string{"xapp_id"})
+
+	queueTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "a1t",
+		Subsystem: "southbound",
+		Name:      "queue_timeouts_total",
+		Help:      "Number of requests that expired in the queue of an xApp",
+	}, []string{"xapp_id"})
+)
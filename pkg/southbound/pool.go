@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package southbound
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	sbclient "github.com/onosproject/onos-a1t/pkg/southbound/client"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// LoadBalancingPolicy defines how the requests of the controller are spread over the endpoints of an xApp
+type LoadBalancingPolicy int
+
+const (
+	// PickFirst sends every request to the first healthy endpoint
+	PickFirst LoadBalancingPolicy = iota
+	// RoundRobin rotates the requests over the healthy endpoints
+	RoundRobin
+)
+
+func (p LoadBalancingPolicy) String() string {
+	return [...]string{"PickFirst", "RoundRobin"}[p]
+}
+
+func ParseLoadBalancingPolicy(policy string) (LoadBalancingPolicy, error) {
+	for _, p := range []LoadBalancingPolicy{PickFirst, RoundRobin} {
+		if strings.EqualFold(policy, p.String()) {
+			return p, nil
+		}
+	}
+	return PickFirst, errors.NewInvalid("unknown load balancing policy %v", policy)
+}
+
+// maxDispatchWorkers bounds the policy messages sent at the same time to an xApp whose in-flight requests are unlimited
+const maxDispatchWorkers = 64
+
+// endpointPool routes the messages of the controller for an A1 service to the healthy endpoints of an xApp;
+// the broker streams of the xApp live as long as the pool
+type endpointPool struct {
+	xAppID       string
+	a1Service    stream.A1Service
+	newClient    clientFactory
+	streamBroker stream.Broker
+	config       Config
+	endpoints    []store.A1Endpoint
+	supervisors  map[store.A1Endpoint]*supervisor
+	limiter      *limiter
+	next         atomic.Uint32
+	ctx          context.Context
+	cancel       context.CancelFunc
+	mu           sync.RWMutex
+}
+
+func newEndpointPool(xAppID string, a1Service stream.A1Service, newClient clientFactory, streamBroker stream.Broker, config Config) *endpointPool {
+	return &endpointPool{
+		xAppID:       xAppID,
+		a1Service:    a1Service,
+		newClient:    newClient,
+		streamBroker: streamBroker,
+		config:       config,
+		endpoints:    make([]store.A1Endpoint, 0),
+		supervisors:  make(map[store.A1Endpoint]*supervisor),
+		limiter:      newLimiter(xAppID, config.RateLimit),
+	}
+}
+
+func (p *endpointPool) start(ctx context.Context, endpoints []store.A1Endpoint) error {
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	sbclient.CreateStream(p.ctx, p.xAppID, p.a1Service, p.streamBroker)
+
+	msgCh := make(chan *stream.SBStreamMessage)
+	sbID, _ := p.streamIDs()
+	err := p.streamBroker.Watch(sbID, msgCh, uuid.New())
+	if err != nil {
+		p.cancel()
+		return err
+	}
+	go p.runOutgoingMsgDispatcher(msgCh)
+
+	p.update(endpoints)
+	return nil
+}
+
+// update starts the supervisors of new endpoints and stops the ones of the endpoints that are gone
+func (p *endpointPool) update(endpoints []store.A1Endpoint) {
+	p.mu.Lock()
+	stopped := make([]*supervisor, 0)
+	current := make(map[store.A1Endpoint]bool)
+	for _, e := range endpoints {
+		current[e] = true
+		if _, ok := p.supervisors[e]; !ok {
+			s := newSupervisor(p.xAppID, p.a1Service, e, p.newClient, p.config.Backoff)
+			p.supervisors[e] = s
+			s.start(p.ctx)
+		}
+	}
+	for e, s := range p.supervisors {
+		if !current[e] {
+			log.Infof("Endpoint %v of xApp %v is gone", e, p.xAppID)
+			delete(p.supervisors, e)
+			stopped = append(stopped, s)
+		}
+	}
+	p.endpoints = append(make([]store.A1Endpoint, 0, len(endpoints)), endpoints...)
+	p.mu.Unlock()
+
+	for _, s := range stopped {
+		s.stop()
+	}
+}
+
+// stop stops all supervisors and closes the broker streams of the xApp
+func (p *endpointPool) stop() {
+	p.cancel()
+	p.mu.Lock()
+	supervisors := p.supervisors
+	p.supervisors = make(map[store.A1Endpoint]*supervisor)
+	p.mu.Unlock()
+	for _, s := range supervisors {
+		s.stop()
+	}
+	sbclient.DeleteStream(p.xAppID, p.a1Service, p.streamBroker)
+}
+
+func (p *endpointPool) sessions() []SessionStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	results := make([]SessionStatus, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		if s, ok := p.supervisors[e]; ok {
+			results = append(results, s.getStatus())
+		}
+	}
+	return results
+}
+
+// runOutgoingMsgDispatcher dispatches the messages of the controller until the broker stream is closed; policy
+// requests are independent unary calls sent concurrently by as many workers as the xApp may have requests in flight,
+// while EI messages go on streams and must keep their order
+func (p *endpointPool) runOutgoingMsgDispatcher(msgCh chan *stream.SBStreamMessage) {
+	workers := 1
+	if p.a1Service == stream.PolicyManagement {
+		workers = p.config.RateLimit.MaxInFlight
+		if workers <= 0 {
+			workers = maxDispatchWorkers
+		}
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for msg := range msgCh {
+				p.dispatch(msg)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// dispatch sends the message to the endpoint picked by the load balancing policy; idempotent requests fail over to
+// the other healthy endpoints
+func (p *endpointPool) dispatch(msg *stream.SBStreamMessage) {
+	// only the policy requests are rate limited - acks and EI stream messages answer the xApp
+	if msg.A1SBIMessageType == stream.PolicyRequestMessage {
+		release, err := p.limiter.acquire(p.ctx)
+		if err != nil {
+			log.Warnf("Dropping %v to xApp %v: %v", msg.A1SBIRPCType, p.xAppID, err)
+			p.forwardFailure(msg, err)
+			return
+		}
+		defer release()
+	}
+
+	var resp *stream.SBStreamMessage
+	err := errors.NewUnavailable("no healthy endpoint of xApp %v for %v", p.xAppID, p.a1Service)
+	for _, client := range p.pick(msg) {
+		resp, err = client.Dispatch(p.ctx, msg)
+		if err == nil {
+			break
+		}
+		log.Warnf("Failed to send %v to xApp %v at %v: %v", msg.A1SBIRPCType, p.xAppID, client.Endpoint(), err)
+		if !msg.A1SBIRPCType.IsIdempotent() {
+			break
+		}
+	}
+	if err != nil {
+		p.forwardFailure(msg, err)
+		return
+	}
+	p.forward(resp)
+}
+
+func (p *endpointPool) forwardFailure(msg *stream.SBStreamMessage, err error) {
+	if resp := sbclient.NewFailureResponse(msg, err); resp != nil {
+		p.forward(resp)
+	}
+}
+
+func (p *endpointPool) forward(resp *stream.SBStreamMessage) {
+	_, nbID := p.streamIDs()
+	err := p.streamBroker.Send(nbID, resp)
+	if err != nil {
+		log.Warn(err)
+	}
+}
+
+// pick returns the clients of the healthy endpoints in the order they should be tried
+func (p *endpointPool) pick(msg *stream.SBStreamMessage) []sbclient.Client {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	clients := make([]sbclient.Client, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		// replies on the streaming sessions have to go back to the endpoint the request came from
+		if msg.Endpoint != "" && msg.Endpoint != e.String() {
+			continue
+		}
+		if client, ok := p.supervisors[e].getClient(); ok {
+			clients = append(clients, client)
+		}
+	}
+	if p.config.LoadBalancingPolicy == RoundRobin && len(clients) > 1 {
+		n := int(p.next.Add(1)-1) % len(clients)
+		clients = append(append(make([]sbclient.Client, 0, len(clients)), clients[n:]...), clients[:n]...)
+	}
+	return clients
+}
+
+func (p *endpointPool) streamIDs() (stream.ID, stream.ID) {
+	controllerID := stream.EndpointID(stream.A1PController)
+	if p.a1Service == stream.EnrichmentInformation {
+		controllerID = stream.A1EIController
+	}
+	return stream.GetStreamID(controllerID, stream.GetEndpointIDWithTargetXAppID(p.xAppID, p.a1Service))
+}
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package southbound
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	sbclient "github.com/onosproject/onos-a1t/pkg/southbound/client"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingClient holds the dispatched messages until released and records how many it held at the same time
+type blockingClient struct {
+	release    chan struct{}
+	dispatched chan struct{}
+	inFlight   int
+	peak       int
+	mu         sync.Mutex
+}
+
+func (c *blockingClient) Connect(ctx context.Context) error {
+	return nil
+}
+
+func (c *blockingClient) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (c *blockingClient) Dispatch(ctx context.Context, msg *stream.SBStreamMessage) (*stream.SBStreamMessage, error) {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.peak {
+		c.peak = c.inFlight
+	}
+	c.mu.Unlock()
+	c.dispatched <- struct{}{}
+
+	<-c.release
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+	return &stream.SBStreamMessage{
+		TargetXAppID:     msg.TargetXAppID,
+		A1SBIMessageType: stream.PolicyResultMessage,
+		A1SBIRPCType:     msg.A1SBIRPCType,
+		A1Service:        msg.A1Service,
+	}, nil
+}
+
+func (c *blockingClient) Endpoint() string {
+	return "10.0.0.1:5150"
+}
+
+func (c *blockingClient) Close() {}
+
+// endpointClient answers the messages sent to an endpoint, or fails them if the endpoint is broken
+type endpointClient struct {
+	endpoint   string
+	broken     bool
+	dispatched atomic.Int32
+}
+
+func (c *endpointClient) Connect(ctx context.Context) error {
+	return nil
+}
+
+func (c *endpointClient) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (c *endpointClient) Dispatch(ctx context.Context, msg *stream.SBStreamMessage) (*stream.SBStreamMessage, error) {
+	c.dispatched.Add(1)
+	if c.broken {
+		return nil, errors.NewUnavailable("endpoint %v is broken", c.endpoint)
+	}
+	resp := stream.NewSBStreamMessage(msg.TargetXAppID, stream.PolicyResultMessage, msg.A1SBIRPCType, msg.A1Service, nil)
+	resp.Endpoint = c.endpoint
+	return resp, nil
+}
+
+func (c *endpointClient) Endpoint() string {
+	return c.endpoint
+}
+
+func (c *endpointClient) Close() {}
+
+// startTestPool starts a pool of the policy sessions of xapp-1 and waits for the sessions with all endpoints
+func startTestPool(t *testing.T, newClient clientFactory, streamBroker stream.Broker, config Config, endpoints []store.A1Endpoint) *endpointPool {
+	p := newEndpointPool("xapp-1", stream.PolicyManagement, newClient, streamBroker, config)
+	require.NoError(t, p.start(context.Background(), endpoints))
+	t.Cleanup(p.stop)
+	assert.Eventually(t, func() bool {
+		for _, session := range p.sessions() {
+			if session.State != SessionReady {
+				return false
+			}
+		}
+		return len(p.sessions()) == len(endpoints)
+	}, time.Second, 10*time.Millisecond)
+	return p
+}
+
+func newTestPolicyRequest(rpcType stream.A1SBIRPCType, endpoint string) *stream.SBStreamMessage {
+	msg := stream.NewSBStreamMessage("xapp-1", stream.PolicyRequestMessage, rpcType, stream.PolicyManagement, &a1.PolicyRequestMessage{
+		Message: &a1.RequestMessage{Header: &a1.Header{RequestId: "request-1"}},
+	})
+	msg.Endpoint = endpoint
+	return msg
+}
+
+func TestPoolRouting(t *testing.T) {
+	endpoints := []store.A1Endpoint{{IP: "10.0.0.1", Port: 5150}, {IP: "10.0.0.2", Port: 5150}}
+	clients := map[store.A1Endpoint]*endpointClient{
+		endpoints[0]: {endpoint: endpoints[0].String(), broken: true},
+		endpoints[1]: {endpoint: endpoints[1].String()},
+	}
+	newClient := func(ctx context.Context, endpoint store.A1Endpoint) (sbclient.Client, error) {
+		return clients[endpoint], nil
+	}
+	streamBroker := stream.NewBroker(stream.DefaultBrokerConfig())
+	p := startTestPool(t, newClient, streamBroker, DefaultConfig(), endpoints)
+	sbID, nbID := p.streamIDs()
+	responses := make(chan *stream.SBStreamMessage, 1)
+	require.NoError(t, streamBroker.Watch(nbID, responses, uuid.New()))
+	request := func(msg *stream.SBStreamMessage) *stream.SBStreamMessage {
+		require.NoError(t, streamBroker.Send(sbID, msg))
+		select {
+		case resp := <-responses:
+			return resp
+		case <-time.After(time.Second):
+			t.Fatal("no response")
+			return nil
+		}
+	}
+
+	// queries fail over to the next healthy endpoint, while the other requests are answered with the failure
+	resp := request(newTestPolicyRequest(stream.PolicyQuery, ""))
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, endpoints[1].String(), resp.Endpoint)
+	resp = request(newTestPolicyRequest(stream.PolicySetup, ""))
+	assert.True(t, errors.IsUnavailable(resp.Error))
+	assert.Equal(t, int32(2), clients[endpoints[0]].dispatched.Load())
+	assert.Equal(t, int32(1), clients[endpoints[1]].dispatched.Load())
+
+	// a message for an endpoint only goes to that endpoint
+	resp = request(newTestPolicyRequest(stream.PolicySetup, endpoints[1].String()))
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, int32(2), clients[endpoints[0]].dispatched.Load())
+
+	// round robin rotates the requests over the endpoints
+	healthy := func(ctx context.Context, endpoint store.A1Endpoint) (sbclient.Client, error) {
+		return &endpointClient{endpoint: endpoint.String()}, nil
+	}
+	config := DefaultConfig()
+	config.LoadBalancingPolicy = RoundRobin
+	p = startTestPool(t, healthy, stream.NewBroker(stream.DefaultBrokerConfig()), config, endpoints)
+	picked := make(map[string]int)
+	for i := 0; i < 4; i++ {
+		picked[p.pick(newTestPolicyRequest(stream.PolicySetup, ""))[0].Endpoint()]++
+	}
+	assert.Equal(t, map[string]int{endpoints[0].String(): 2, endpoints[1].String(): 2}, picked)
+}
+
+func TestPoolDispatchWorkers(t *testing.T) {
+	client := &blockingClient{release: make(chan struct{}), dispatched: make(chan struct{}, maxDispatchWorkers*2)}
+	newClient := func(ctx context.Context, endpoint store.A1Endpoint) (sbclient.Client, error) {
+		return client, nil
+	}
+	config := DefaultConfig()
+	config.RateLimit = RateLimitConfig{}
+	streamBroker := stream.NewBroker(stream.BrokerConfig{StreamBufferSize: maxDispatchWorkers * 2, WatcherBufferSize: maxDispatchWorkers * 2, OverflowPolicy: stream.Block})
+	p := startTestPool(t, newClient, streamBroker, config, []store.A1Endpoint{{IP: "10.0.0.1", Port: 5150}})
+
+	// without an in-flight limit the messages beyond the workers wait for one of them to be done
+	sbID, _ := p.streamIDs()
+	count := maxDispatchWorkers + 8
+	for i := 0; i < count; i++ {
+		assert.NoError(t, streamBroker.Send(sbID, &stream.SBStreamMessage{
+			TargetXAppID:     "xapp-1",
+			A1SBIMessageType: stream.PolicyRequestMessage,
+			A1SBIRPCType:     stream.PolicySetup,
+			A1Service:        stream.PolicyManagement,
+		}))
+	}
+	for i := 0; i < maxDispatchWorkers; i++ {
+		<-client.dispatched
+	}
+	select {
+	case <-client.dispatched:
+		t.Fatal("dispatched more messages than workers")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(client.release)
+	for i := maxDispatchWorkers; i < count; i++ {
+		<-client.dispatched
+	}
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	assert.Equal(t, maxDispatchWorkers, client.peak)
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package southbound
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	sbclient "github.com/onosproject/onos-a1t/pkg/southbound/client"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+)
+
+// SessionState is the state of the southbound session with an xApp
+type SessionState int
+
+const (
+	// SessionConnecting means that the first connection to the xApp is being established
+	SessionConnecting SessionState = iota
+	// SessionReady means that all streaming sessions with the xApp are up
+	SessionReady
+	// SessionDegraded means that the sessions were lost and are being re-established
+	SessionDegraded
+	// SessionDown means that the xApp could not be reached for BackoffConfig.DownThreshold attempts in a row, or the session was stopped
+	SessionDown
+)
+
+func (s SessionState) String() string {
+	return [...]string{"Connecting", "Ready", "Degraded", "Down"}[s]
+}
+
+// SessionStatus is the state of the southbound session of an A1 service with an xApp
+type SessionStatus struct {
+	XAppID    string
+	A1Service stream.A1Service
+	Endpoint  string
+	State     SessionState
+	Attempts  int
+	LastError string
+	Since     time.Time
+}
+
+type BackoffConfig struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	// Jitter is the fraction of the interval that is randomized, between 0 and 1
+	Jitter float64
+	// DownThreshold is the number of failed attempts in a row after which the session is reported as down
+	DownThreshold int
+}
+
+func DefaultBackoffConfig() BackoffConfig {
+	return BackoffConfig{
+		InitialInterval: time.Second,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+		DownThreshold:   5,
+	}
+}
+
+// interval returns the jittered backoff interval before the given attempt
+func (b BackoffConfig) interval(attempt int) time.Duration {
+	interval := float64(b.InitialInterval)
+	for i := 1; i < attempt && interval < float64(b.MaxInterval); i++ {
+		interval *= b.Multiplier
+	}
+	if interval > float64(b.MaxInterval) {
+		interval = float64(b.MaxInterval)
+	}
+	interval += interval * b.Jitter * (2*rand.Float64() - 1)
+	return time.Duration(interval)
+}
+
+type clientFactory func(ctx context.Context, endpoint store.A1Endpoint) (sbclient.Client, error)
+
+// supervisor keeps the southbound client of an A1 service connected to an xApp endpoint, re-creating it with backoff
+// when the connection or one of its streaming sessions fails
+type supervisor struct {
+	xAppID    string
+	a1Service stream.A1Service
+	endpoint  store.A1Endpoint
+	newClient clientFactory
+	backoff   BackoffConfig
+	status    SessionStatus
+	client    sbclient.Client
+	cancel    context.CancelFunc
+	done      chan struct{}
+	mu        sync.RWMutex
+}
+
+func newSupervisor(xAppID string, a1Service stream.A1Service, endpoint store.A1Endpoint, newClient clientFactory, backoff BackoffConfig) *supervisor {
+	return &supervisor{
+		xAppID:    xAppID,
+		a1Service: a1Service,
+		endpoint:  endpoint,
+		newClient: newClient,
+		backoff:   backoff,
+		status: SessionStatus{
+			XAppID:    xAppID,
+			A1Service: a1Service,
+			Endpoint:  endpoint.String(),
+			State:     SessionConnecting,
+			Since:     time.Now(),
+		},
+		done: make(chan struct{}),
+	}
+}
+
+func (s *supervisor) start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	go s.run(ctx)
+}
+
+// stop stops the client and waits for it to exit
+func (s *supervisor) stop() {
+	s.cancel()
+	<-s.done
+}
+
+func (s *supervisor) run(ctx context.Context) {
+	defer close(s.done)
+	attempts := 0
+	connected := false
+	for {
+		ready, err := s.runClient(ctx)
+		if ctx.Err() != nil {
+			s.setState(SessionDown, attempts, ctx.Err())
+			log.Infof("Southbound %v session of xApp %v at %v stopped", s.a1Service, s.xAppID, s.endpoint)
+			return
+		}
+
+		if ready {
+			attempts = 0
+			connected = true
+		}
+		attempts++
+		state := SessionDegraded
+		if !connected {
+			state = SessionConnecting
+		}
+		if attempts >= s.backoff.DownThreshold {
+			state = SessionDown
+		}
+		s.setState(state, attempts, err)
+
+		interval := s.backoff.interval(attempts)
+		log.Warnf("Southbound %v session of xApp %v at %v failed (attempt %d): %v - reconnecting in %v", s.a1Service, s.xAppID, s.endpoint, attempts, err, interval)
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			s.setState(SessionDown, attempts, ctx.Err())
+			return
+		}
+	}
+}
+
+// runClient connects a new client and runs it until it fails; it returns true if the sessions were established
+func (s *supervisor) runClient(ctx context.Context) (bool, error) {
+	clientCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client, err := s.newClient(clientCtx, s.endpoint)
+	if err != nil {
+		return false, err
+	}
+	defer client.Close()
+
+	err = client.Connect(clientCtx)
+	if err != nil {
+		return false, err
+	}
+	s.setClient(client)
+	defer s.setClient(nil)
+	s.setState(SessionReady, 0, nil)
+	log.Infof("Southbound %v session of xApp %v at %v is ready", s.a1Service, s.xAppID, s.endpoint)
+
+	return true, client.Run(clientCtx)
+}
+
+func (s *supervisor) setClient(client sbclient.Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.client = client
+}
+
+// getClient returns the client if the sessions with the endpoint are ready
+func (s *supervisor) getClient() (sbclient.Client, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.client, s.client != nil && s.status.State == SessionReady
+}
+
+func (s *supervisor) setState(state SessionState, attempts int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.status.State != state {
+		s.status.Since = time.Now()
+	}
+	s.status.State = state
+	s.status.Attempts = attempts
+	if err != nil {
+		s.status.LastError = err.Error()
+	}
+}
+
+func (s *supervisor) getStatus() SessionStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status
+}
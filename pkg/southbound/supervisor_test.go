@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package southbound
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sbclient "github.com/onosproject/onos-a1t/pkg/southbound/client"
+	"github.com/onosproject/onos-a1t/pkg/store"
+	"github.com/onosproject/onos-a1t/pkg/stream"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingClient runs until its session fails
+type failingClient struct {
+	sessionErr chan error
+}
+
+func (c *failingClient) Connect(ctx context.Context) error {
+	return nil
+}
+
+func (c *failingClient) Run(ctx context.Context) error {
+	select {
+	case err := <-c.sessionErr:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *failingClient) Dispatch(ctx context.Context, msg *stream.SBStreamMessage) (*stream.SBStreamMessage, error) {
+	return nil, errors.NewNotSupported("dispatch")
+}
+
+func (c *failingClient) Endpoint() string {
+	return "10.0.0.1:5150"
+}
+
+func (c *failingClient) Close() {}
+
+func TestBackoffInterval(t *testing.T) {
+	backoff := BackoffConfig{InitialInterval: time.Second, MaxInterval: 5 * time.Second, Multiplier: 2}
+	assert.Equal(t, time.Second, backoff.interval(1))
+	assert.Equal(t, 4*time.Second, backoff.interval(3))
+	assert.Equal(t, 5*time.Second, backoff.interval(10))
+
+	backoff.Jitter = 0.2
+	for i := 0; i < 10; i++ {
+		interval := backoff.interval(1)
+		assert.True(t, interval >= 800*time.Millisecond && interval <= 1200*time.Millisecond, interval)
+	}
+}
+
+func TestSupervisorReconnect(t *testing.T) {
+	// each attempt to set up the client takes the next result, so that the state is checked between attempts
+	results := make(chan error)
+	client := &failingClient{sessionErr: make(chan error)}
+	newClient := func(ctx context.Context, endpoint store.A1Endpoint) (sbclient.Client, error) {
+		select {
+		case err := <-results:
+			if err != nil {
+				return nil, err
+			}
+			return client, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	backoff := BackoffConfig{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Multiplier: 2, DownThreshold: 3}
+	s := newSupervisor("xapp-1", stream.PolicyManagement, store.A1Endpoint{IP: "10.0.0.1", Port: 5150}, newClient, backoff)
+	s.start(context.Background())
+
+	assertState := func(state SessionState, attempts int) {
+		assert.Eventually(t, func() bool {
+			status := s.getStatus()
+			return status.State == state && status.Attempts == attempts
+		}, time.Second, time.Millisecond, "%v after %d attempts", state, attempts)
+	}
+
+	results <- errors.NewUnavailable("connection refused")
+	assertState(SessionConnecting, 1)
+	_, ok := s.getClient()
+	assert.False(t, ok)
+
+	results <- nil
+	assertState(SessionReady, 0)
+	_, ok = s.getClient()
+	assert.True(t, ok)
+
+	// a session which was ready degrades when it fails, and is down once reconnecting failed often enough
+	client.sessionErr <- errors.NewUnavailable("stream reset")
+	assertState(SessionDegraded, 1)
+	_, ok = s.getClient()
+	assert.False(t, ok)
+	results <- errors.NewUnavailable("connection refused")
+	assertState(SessionDegraded, 2)
+	results <- errors.NewUnavailable("connection refused")
+	assertState(SessionDown, 3)
+	assert.Equal(t, "connection refused", s.getStatus().LastError)
+
+	results <- nil
+	assertState(SessionReady, 0)
+
+	s.stop()
+	assert.Equal(t, SessionDown, s.getStatus().State)
+	_, ok = s.getClient()
+	assert.False(t, ok)
+}
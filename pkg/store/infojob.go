@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// GetInfoJob returns the information job with the given ID
+func GetInfoJob(ctx context.Context, s Store, infoJobID string) (*InfoJobValue, error) {
+	entry, err := s.Get(ctx, InfoJobKey{InfoJobID: infoJobID})
+	if err != nil {
+		return nil, err
+	}
+	value, ok := entry.Value.(*InfoJobValue)
+	if !ok {
+		return nil, errors.NewInvalid("entry %v is not an information job", entry.Key)
+	}
+	return value, nil
+}
+
+// PutInfoJob creates or replaces the information job
+func PutInfoJob(ctx context.Context, s Store, infoJobID string, value *InfoJobValue) error {
+	key := InfoJobKey{InfoJobID: infoJobID}
+	if _, err := s.Get(ctx, key); err == nil {
+		_, err = s.Update(ctx, key, value)
+		return err
+	}
+	_, err := s.Put(ctx, key, value)
+	return err
+}
+
+// DeleteInfoJob removes the information job
+func DeleteInfoJob(ctx context.Context, s Store, infoJobID string) error {
+	return s.Delete(ctx, InfoJobKey{InfoJobID: infoJobID})
+}
+
+// ListInfoJobs returns the information jobs of the given type; every type if infoTypeID is empty
+func ListInfoJobs(ctx context.Context, s Store, infoTypeID string) map[string]*InfoJobValue {
+	results := make(map[string]*InfoJobValue)
+	ch := make(chan *Entry)
+	go s.Entries(ctx, ch)
+	for e := range ch {
+		key, ok := e.Key.(InfoJobKey)
+		if !ok {
+			continue
+		}
+		value := e.Value.(*InfoJobValue)
+		if infoTypeID != "" && value.InfoTypeID != infoTypeID {
+			continue
+		}
+		results[key.InfoJobID] = value
+	}
+	return results
+}
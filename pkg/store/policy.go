@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// MaxPolicyRevisions is the number of revisions kept for each policy
+var MaxPolicyRevisions = 32
+
+// policyRevisionMu serializes read-modify-write of the revision lists
+var policyRevisionMu sync.Mutex
+
+// Latest returns the latest revision of the policy
+func (v *PolicyIntentValue) Latest() *PolicyRevision {
+	if len(v.Revisions) == 0 {
+		return nil
+	}
+	return v.Revisions[len(v.Revisions)-1]
+}
+
+// Revision returns the given revision of the policy
+func (v *PolicyIntentValue) Revision(revision uint64) *PolicyRevision {
+	for _, r := range v.Revisions {
+		if r.Revision == revision {
+			return r
+		}
+	}
+	return nil
+}
+
+// At returns the revision of the policy which was effective at the given time
+func (v *PolicyIntentValue) At(t time.Time) *PolicyRevision {
+	var result *PolicyRevision
+	for _, r := range v.Revisions {
+		if r.Timestamp.After(t) {
+			break
+		}
+		result = r
+	}
+	return result
+}
+
+// Exists returns true if the latest revision of the policy is not a deletion
+func (v *PolicyIntentValue) Exists() bool {
+	latest := v.Latest()
+	return latest != nil && !latest.Deleted
+}
+
+// GetPolicyIntent returns the revisions of the policy
+func GetPolicyIntent(ctx context.Context, s Store, policyTypeID, policyID string) (*PolicyIntentValue, error) {
+	entry, err := s.Get(ctx, PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID})
+	if err != nil {
+		return nil, err
+	}
+	value, ok := entry.Value.(*PolicyIntentValue)
+	if !ok {
+		return nil, errors.NewInvalid("entry %v is not a policy intent", entry.Key)
+	}
+	return value, nil
+}
+
+// AddPolicyRevision appends a new revision of the policy and returns it
+func AddPolicyRevision(ctx context.Context, s Store, policyTypeID, policyID string, params map[string]string, policyObject map[string]interface{}, deleted bool, owner string) (*PolicyRevision, error) {
+	policyRevisionMu.Lock()
+	defer policyRevisionMu.Unlock()
+
+	key := PolicyIntentKey{PolicyTypeID: policyTypeID, PolicyID: policyID}
+	revision := &PolicyRevision{
+		Revision:     1,
+		Timestamp:    time.Now(),
+		Deleted:      deleted,
+		Params:       params,
+		PolicyObject: policyObject,
+		Owner:        owner,
+	}
+
+	value, err := GetPolicyIntent(ctx, s, policyTypeID, policyID)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+		_, err = s.Put(ctx, key, &PolicyIntentValue{Revisions: []*PolicyRevision{revision}})
+		if err != nil {
+			return nil, err
+		}
+		return revision, nil
+	}
+
+	revision.Revision = value.Latest().Revision + 1
+	revisions := append(append(make([]*PolicyRevision, 0, len(value.Revisions)+1), value.Revisions...), revision)
+	if len(revisions) > MaxPolicyRevisions {
+		revisions = revisions[len(revisions)-MaxPolicyRevisions:]
+	}
+	_, err = s.Update(ctx, key, &PolicyIntentValue{Revisions: revisions})
+	if err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// ListPolicyIntents returns the revisions of every policy of the given type; every type if policyTypeID is empty
+func ListPolicyIntents(ctx context.Context, s Store, policyTypeID string) map[PolicyIntentKey]*PolicyIntentValue {
+	results := make(map[PolicyIntentKey]*PolicyIntentValue)
+	ch := make(chan *Entry)
+	go s.Entries(ctx, ch)
+	for e := range ch {
+		key, ok := e.Key.(PolicyIntentKey)
+		if !ok {
+			continue
+		}
+		if policyTypeID != "" && key.PolicyTypeID != policyTypeID {
+			continue
+		}
+		results[key] = e.Value.(*PolicyIntentValue)
+	}
+	return results
+}
+
+// SetPolicyDeployed records whether the xApps hold the scheduled policy
+func SetPolicyDeployed(ctx context.Context, s Store, key PolicyIntentKey, deployed bool) error {
+	_, err := s.Put(ctx, PolicyDeploymentKey(key), &PolicyDeploymentValue{Deployed: deployed})
+	return err
+}
+
+// DeletePolicyDeployment forgets whether the xApps hold the policy, once it is no longer scheduled
+func DeletePolicyDeployment(ctx context.Context, s Store, key PolicyIntentKey) error {
+	return s.Delete(ctx, PolicyDeploymentKey(key))
+}
+
+// ListPolicyDeployments returns whether the xApps hold each scheduled policy
+func ListPolicyDeployments(ctx context.Context, s Store) map[PolicyIntentKey]bool {
+	results := make(map[PolicyIntentKey]bool)
+	ch := make(chan *Entry)
+	go s.Entries(ctx, ch)
+	for e := range ch {
+		key, ok := e.Key.(PolicyDeploymentKey)
+		if !ok {
+			continue
+		}
+		results[PolicyIntentKey(key)] = e.Value.(*PolicyDeploymentValue).Deployed
+	}
+	return results
+}
@@ -63,6 +63,8 @@ func (s *store) Print() {
 			log.Infof("A1PM store - Key: %v, value: %v", k.(A1Key), v.Value.(*A1PMValue))
 		case *A1EIValue:
 			log.Infof("A1EI store - Key: %v, value: %v", k.(A1Key), v.Value.(*A1EIValue))
+		case *PolicyIntentValue:
+			log.Infof("Policy intent store - Key: %v, value: %v", k.(PolicyIntentKey), v.Value.(*PolicyIntentValue).Latest())
 		}
 	}
 }
@@ -4,7 +4,12 @@
 
 package store
 
-import topoapi "github.com/onosproject/onos-api/go/onos/topo"
+import (
+	"fmt"
+	"time"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+)
 
 type Entry struct {
 	Key   interface{}
@@ -54,15 +59,27 @@ type A1ServiceType struct {
 	TypeID    string
 }
 
+// AnyEIType is the type of the EI capability of the xApps which do not declare the EI types they consume
+const AnyEIType = ""
+
 // For subscription manager
 
 type SubscriptionKey struct {
 	TargetXAppID topoapi.ID
 }
 
+// A1Endpoint is an A1 interface exposed by an xApp; replicated xApps expose several of them
+type A1Endpoint struct {
+	IP   string
+	Port uint32
+}
+
+func (e A1Endpoint) String() string {
+	return fmt.Sprintf("%s:%d", e.IP, e.Port)
+}
+
 type SubscriptionValue struct {
-	A1EndpointIP          string
-	A1EndpointPort        uint32
+	A1Endpoints           []A1Endpoint
 	A1ServiceCapabilities []*A1ServiceType
 }
 
@@ -83,3 +100,61 @@ type A1PMValue struct {
 type A1EIValue struct {
 	A1EIJobObjects map[A1EIJobObjectID]A1ServiceType
 }
+
+// For A1-PM policy intents - revisions of the policy objects accepted by A1T
+
+type PolicyIntentKey struct {
+	PolicyTypeID string
+	PolicyID     string
+}
+
+type PolicyRevision struct {
+	Revision     uint64
+	Timestamp    time.Time
+	Deleted      bool
+	Params       map[string]string
+	PolicyObject map[string]interface{}
+	// Owner labels the client managing the policy; empty for unowned policies
+	Owner string
+}
+
+type PolicyIntentValue struct {
+	Revisions []*PolicyRevision
+}
+
+// For the policy scheduler - whether the xApps hold each scheduled policy
+
+type PolicyDeploymentKey struct {
+	PolicyTypeID string
+	PolicyID     string
+}
+
+type PolicyDeploymentValue struct {
+	Deployed bool
+}
+
+// For A1-DME information jobs - the jobs A1T created at the Non-RT RIC
+
+type InfoJobKey struct {
+	InfoJobID string
+}
+
+type InfoJobValue struct {
+	InfoTypeID    string
+	JobOwner      string
+	JobDefinition map[string]interface{}
+	// JobResultURI and JobStatusNotificationURI are the URIs given by the consumer; they are empty for the jobs of
+	// xApps, whose results and notifications go through the EI SBI
+	JobResultURI             string
+	JobStatusNotificationURI string
+	// Status is the last status notified by the Non-RT RIC, ENABLED or DISABLED
+	Status string
+	// Consumers are the xApps that set the job up through the EI SBI
+	Consumers map[string]bool
+	Timestamp time.Time
+	// LastResult is the last result delivered by the Non-RT RIC, and LastDeliveryError the failure to forward it to
+	// the consumers, if any
+	LastResult          []byte
+	LastResultTimestamp time.Time
+	LastDeliveryError   string
+}
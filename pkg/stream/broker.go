@@ -6,7 +6,9 @@ package stream
 
 import (
 	"context"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
@@ -15,36 +17,122 @@ import (
 
 var log = logging.GetLogger()
 
+// OverflowPolicy defines what the broker does when the buffer of a watcher is full
+type OverflowPolicy int
+
+const (
+	// Block waits up to SendTimeout for the full watchers to make room, then drops the message for the ones still
+	// full; the watchers of a stream share the wait, so that they hold up the stream for SendTimeout at most per message
+	Block OverflowPolicy = iota
+	// DropNewest drops the incoming message
+	DropNewest
+	// DropOldest drops the oldest buffered message to make room for the incoming one
+	DropOldest
+)
+
+func (o OverflowPolicy) String() string {
+	return [...]string{"Block", "DropNewest", "DropOldest"}[o]
+}
+
+func ParseOverflowPolicy(policy string) (OverflowPolicy, error) {
+	for _, o := range []OverflowPolicy{Block, DropNewest, DropOldest} {
+		if strings.EqualFold(policy, o.String()) {
+			return o, nil
+		}
+	}
+	return Block, errors.NewInvalid("unknown stream overflow policy %v", policy)
+}
+
+type BrokerConfig struct {
+	StreamBufferSize  int
+	WatcherBufferSize int
+	OverflowPolicy    OverflowPolicy
+}
+
+func DefaultBrokerConfig() BrokerConfig {
+	return BrokerConfig{
+		StreamBufferSize:  64,
+		WatcherBufferSize: 64,
+		OverflowPolicy:    Block,
+	}
+}
+
+// StreamStats are the delivery counters of a stream
+type StreamStats struct {
+	Sent      uint64
+	Delivered uint64
+	Dropped   uint64
+	Watchers  int
+}
+
+// StreamEventType is a change of the streams of the broker
+type StreamEventType int
+
+const (
+	// StreamAdded is a new stream, e.g. a stream of an xApp whose southbound sessions were set up again
+	StreamAdded StreamEventType = iota
+	// StreamClosed is a closed stream; its watchers are gone with it
+	StreamClosed
+)
+
+func (t StreamEventType) String() string {
+	return [...]string{"StreamAdded", "StreamClosed"}[t]
+}
+
+// StreamEvent is a stream added to or closed by the broker
+type StreamEvent struct {
+	Type StreamEventType
+	ID   ID
+}
+
+// streamEventBufferSize is the number of stream events buffered for each stream watcher
+const streamEventBufferSize = 256
+
 type Broker interface {
 	Close(id ID)
 	AddStream(ctx context.Context, id ID)
 	Send(id ID, message *SBStreamMessage) error
 	Watch(id ID, ch chan *SBStreamMessage, watcherID uuid.UUID) error
 	DeleteWatcher(id ID, watcherID uuid.UUID)
+	// WatchStreams sends the stream events to ch until the context is done; closing a stream drops its watchers, so
+	// that a watcher which has to outlive the sessions of an xApp watches the stream again when it is added again
+	WatchStreams(ctx context.Context, ch chan<- StreamEvent)
+	Stats() map[ID]StreamStats
 	Print()
 }
 
-func NewBroker() Broker {
-	streams := make(map[ID]Stream)
-	watchers := make(map[ID]map[uuid.UUID]chan *SBStreamMessage)
+func NewBroker(config BrokerConfig) Broker {
 	return &broker{
-		streams:  streams,
-		watchers: watchers,
+		config:   config,
+		streams:  make(map[ID]Stream),
+		watchers: make(map[ID]map[uuid.UUID]*watcher),
+		stats:    make(map[ID]*streamStats),
+		events:   make(map[uuid.UUID]chan StreamEvent),
 	}
 }
 
 type broker struct {
+	config   BrokerConfig
 	streams  map[ID]Stream
-	watchers map[ID]map[uuid.UUID]chan *SBStreamMessage
+	watchers map[ID]map[uuid.UUID]*watcher
+	stats    map[ID]*streamStats
+	events   map[uuid.UUID]chan StreamEvent
 	mu       sync.RWMutex
 }
 
+type streamStats struct {
+	sent      atomic.Uint64
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+}
+
 func (b *broker) Print() {
-	b.mu.Lock()
-	defer b.mu.Unlock()
+	b.mu.RLock()
+	defer b.mu.RUnlock()
 	log.Info("Print streams:")
 	for k, v := range b.streams {
-		log.Infof("stream key: %v, value: %v", k, v)
+		s := b.stats[k]
+		log.Infof("stream key: %v, value: %v, sent: %d, delivered: %d, dropped: %d", k, v, s.sent.Load(), s.delivered.Load(), s.dropped.Load())
 	}
 	log.Info("Print watchers")
 	for k, v := range b.watchers {
@@ -52,82 +140,275 @@ func (b *broker) Print() {
 	}
 }
 
+func (b *broker) Stats() map[ID]StreamStats {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	results := make(map[ID]StreamStats)
+	for id, s := range b.stats {
+		results[id] = StreamStats{
+			Sent:      s.sent.Load(),
+			Delivered: s.delivered.Load(),
+			Dropped:   s.dropped.Load(),
+			Watchers:  len(b.watchers[id]),
+		}
+	}
+	return results
+}
+
 func (b *broker) AddStream(ctx context.Context, id ID) {
 	log.Infof("Creating stream for %v", id)
 	b.mu.Lock()
-	defer b.mu.Unlock()
 	_, ok := b.streams[id]
 	if ok {
+		b.mu.Unlock()
 		log.Warnf("Stream for %v already exists", id)
 		return
 	}
-	stream := NewDirectionalStream(id)
+	stream := NewBufferedDirectionalStream(id, b.config.StreamBufferSize)
 	b.streams[id] = stream
-	b.watchers[id] = make(map[uuid.UUID]chan *SBStreamMessage)
+	b.watchers[id] = make(map[uuid.UUID]*watcher)
+	b.stats[id] = &streamStats{}
+	b.mu.Unlock()
+	b.notify(StreamEvent{Type: StreamAdded, ID: id})
 
-	go func(m *sync.RWMutex) {
+	go func() {
 		for {
 			msg, err := stream.Recv(ctx)
 			if err != nil {
 				log.Warnf("Forwarding channel closed: %v", err)
 				return
 			}
-			m.Lock()
-			log.Infof("watchers: %v", b.watchers)
-			for _, v := range b.watchers[id] {
-				log.Infof("Send %v to watcher %v", msg, v)
-				select {
-				case v <- msg:
-					log.Infof("Sent %v to watcher %v", msg, v)
-				default:
-					log.Infof("Failed to send %v on %v", msg, v)
+			deadline, cancel := b.sendDeadline(ctx)
+			for _, w := range b.getWatchers(id) {
+				log.Debugf("Send %v to watcher %v", msg, w.id)
+				if !w.enqueue(msg, b.config.OverflowPolicy, deadline) {
+					log.Warnf("Watcher %v of stream %v is full - message %v dropped", w.id, id, msg)
 				}
 			}
-			m.Unlock()
+			cancel()
 		}
-	}(&b.mu)
+	}()
+}
+
+// sendDeadline returns the deadline shared by the watchers for the fan-out of a message; it is nil unless the overflow
+// policy waits for room
+func (b *broker) sendDeadline(ctx context.Context) (<-chan struct{}, context.CancelFunc) {
+	if b.config.OverflowPolicy != Block {
+		return nil, func() {}
+	}
+	ctx, cancel := context.WithTimeout(ctx, SendTimeout)
+	return ctx.Done(), cancel
+}
+
+// getWatchers returns a snapshot of the watchers of the stream, so that the fan-out does not hold the lock while sending
+func (b *broker) getWatchers(id ID) []*watcher {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	watchers := make([]*watcher, 0, len(b.watchers[id]))
+	for _, w := range b.watchers[id] {
+		watchers = append(watchers, w)
+	}
+	return watchers
 }
 
 func (b *broker) Close(id ID) {
 	log.Infof("Closing stream id %v", id)
 	b.mu.Lock()
-	defer b.mu.Unlock()
 	stream, ok := b.streams[id]
 	if !ok {
+		b.mu.Unlock()
 		log.Warnf("Stream for SID %v not found", id)
 		return
 	}
 	stream.Close()
+	watchers := b.watchers[id]
 	delete(b.streams, id)
 	delete(b.watchers, id)
+	delete(b.stats, id)
+	b.mu.Unlock()
+
+	for _, w := range watchers {
+		w.stop()
+		close(w.ch)
+	}
+	b.notify(StreamEvent{Type: StreamClosed, ID: id})
+}
+
+// notify sends the stream event to the stream watchers; it is called without the lock, as the stream watchers
+// usually call the broker back
+func (b *broker) notify(event StreamEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for id, in := range b.events {
+		select {
+		case in <- event:
+		default:
+			log.Warnf("Stream watcher %v is full - %v of %v dropped", id, event.Type, event.ID)
+		}
+	}
+}
+
+func (b *broker) WatchStreams(ctx context.Context, ch chan<- StreamEvent) {
+	id := uuid.New()
+	in := make(chan StreamEvent, streamEventBufferSize)
+	b.mu.Lock()
+	b.events[id] = in
+	b.mu.Unlock()
+
+	go func() {
+		defer func() {
+			b.mu.Lock()
+			delete(b.events, id)
+			b.mu.Unlock()
+			close(ch)
+		}()
+		for {
+			select {
+			case event := <-in:
+				select {
+				case ch <- event:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
 }
 
 func (b *broker) Send(id ID, message *SBStreamMessage) error {
 	log.Infof("Sending message id: %v", id)
 	b.mu.RLock()
-	defer b.mu.RUnlock()
-	log.Infof("Start Sending message id: %v", id)
-	return b.streams[id].Send(message)
+	stream, ok := b.streams[id]
+	stats := b.stats[id]
+	b.mu.RUnlock()
+	if !ok {
+		return errors.NewNotFound("stream ID %v not found", id)
+	}
+	err := stream.Send(message)
+	if err != nil {
+		return err
+	}
+	stats.sent.Add(1)
+	return nil
 }
 
+// Watch adds a watcher to the stream; it returns an AlreadyExists error if the stream already has a watcher with this ID
 func (b *broker) Watch(id ID, ch chan *SBStreamMessage, watcherID uuid.UUID) error {
-	log.Infof("Watching message id: %v", id)
 	log.Infof("Add watcher ID %v: %v", watcherID, id)
 	b.mu.Lock()
 	defer b.mu.Unlock()
 	if _, ok := b.streams[id]; !ok {
 		return errors.NewNotFound("stream ID %v not found", id)
 	}
-	b.watchers[id][watcherID] = ch
+	if _, ok := b.watchers[id][watcherID]; ok {
+		return errors.NewAlreadyExists("stream ID %v already has watcher %v", id, watcherID)
+	}
+	b.watchers[id][watcherID] = newWatcher(watcherID, ch, b.config.WatcherBufferSize, b.stats[id])
 	return nil
 }
 
 func (b *broker) DeleteWatcher(id ID, watcherID uuid.UUID) {
-	log.Infof("deleting watcher ID %v: watcher ID %v", id, watcherID)
+	log.Infof("Deleting watcher ID %v: watcher ID %v", id, watcherID)
 	b.mu.Lock()
-	defer b.mu.Unlock()
-	log.Infof("Delete watcherID: %v, watchers", watcherID, b.watchers)
-	close(b.watchers[id][watcherID])
-	delete(b.watchers[id], watcherID)
-	log.Infof("Deleted watcherID: %v, watchers", watcherID, b.watchers)
+	w, ok := b.watchers[id][watcherID]
+	if ok {
+		delete(b.watchers[id], watcherID)
+	}
+	b.mu.Unlock()
+	if !ok {
+		log.Warnf("Watcher %v for stream %v not found", watcherID, id)
+		return
+	}
+	w.stop()
+	close(w.ch)
+}
+
+// watcher buffers the messages for a single watcher channel, so that a slow watcher does not hold up the others
+type watcher struct {
+	id      uuid.UUID
+	ch      chan *SBStreamMessage
+	buffer  chan *SBStreamMessage
+	stats   *streamStats
+	done    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+}
+
+func newWatcher(id uuid.UUID, ch chan *SBStreamMessage, bufferSize int, stats *streamStats) *watcher {
+	w := &watcher{
+		id:      id,
+		ch:      ch,
+		buffer:  make(chan *SBStreamMessage, bufferSize),
+		stats:   stats,
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+func (w *watcher) run() {
+	defer close(w.stopped)
+	for {
+		select {
+		case msg := <-w.buffer:
+			select {
+			case w.ch <- msg:
+				w.stats.delivered.Add(1)
+			case <-w.done:
+				return
+			}
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// enqueue buffers the message following the overflow policy; it returns false if a message was dropped. Under Block,
+// it waits for room until the deadline of the fan-out of the message is done.
+func (w *watcher) enqueue(msg *SBStreamMessage, policy OverflowPolicy, deadline <-chan struct{}) bool {
+	switch policy {
+	case DropNewest:
+		select {
+		case w.buffer <- msg:
+			return true
+		default:
+			w.stats.dropped.Add(1)
+			return false
+		}
+	case DropOldest:
+		dropped := false
+		for {
+			select {
+			case w.buffer <- msg:
+				return !dropped
+			default:
+			}
+			select {
+			case <-w.buffer:
+				w.stats.dropped.Add(1)
+				dropped = true
+			default:
+			}
+		}
+	default:
+		select {
+		case w.buffer <- msg:
+			return true
+		case <-w.done:
+		case <-deadline:
+		}
+		w.stats.dropped.Add(1)
+		return false
+	}
+}
+
+// stop stops the delivery goroutine and waits for it to exit, so that the watcher channel can be safely closed
+func (w *watcher) stop() {
+	w.once.Do(func() {
+		close(w.done)
+	})
+	<-w.stopped
 }
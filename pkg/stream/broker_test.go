@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var testStreamID = ID{SrcEndpointID: "a1t", DestEndpointID: "xapp-1"}
+
+// sendOverflow sends count messages to a watcher which does not read them until they are all sent, then returns the
+// messages it receives and the number of dropped messages
+func sendOverflow(t *testing.T, policy OverflowPolicy, count int) ([]string, uint64) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewBroker(BrokerConfig{StreamBufferSize: count, WatcherBufferSize: 1, OverflowPolicy: policy})
+	b.AddStream(ctx, testStreamID)
+	ch := make(chan *SBStreamMessage)
+	assert.NoError(t, b.Watch(testStreamID, ch, uuid.New()))
+	for i := 0; i < count; i++ {
+		assert.NoError(t, b.Send(testStreamID, &SBStreamMessage{TargetXAppID: strconv.Itoa(i)}))
+	}
+
+	// the watcher holds at most one message in its buffer and one in its delivery goroutine
+	assert.Eventually(t, func() bool {
+		return b.Stats()[testStreamID].Dropped >= uint64(count-2)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	received := make([]string, 0)
+	for {
+		select {
+		case msg := <-ch:
+			received = append(received, msg.TargetXAppID)
+			continue
+		case <-time.After(100 * time.Millisecond):
+		}
+		break
+	}
+	stats := b.Stats()[testStreamID]
+	assert.Equal(t, uint64(count), stats.Sent)
+	assert.Equal(t, uint64(len(received)), stats.Delivered)
+	assert.Equal(t, uint64(count), stats.Delivered+stats.Dropped)
+	return received, stats.Dropped
+}
+
+func TestBrokerDropNewest(t *testing.T) {
+	received, _ := sendOverflow(t, DropNewest, 5)
+	assert.NotEmpty(t, received)
+	assert.Equal(t, "0", received[0])
+}
+
+func TestBrokerDropOldest(t *testing.T) {
+	received, _ := sendOverflow(t, DropOldest, 5)
+	assert.NotEmpty(t, received)
+	assert.Equal(t, "4", received[len(received)-1])
+}
+
+func TestBrokerBlock(t *testing.T) {
+	sendTimeout := SendTimeout
+	SendTimeout = 10 * time.Millisecond
+	defer func() {
+		SendTimeout = sendTimeout
+	}()
+
+	// the fan-out waits for the watcher to take the first message, so only the messages after the second are dropped
+	received, dropped := sendOverflow(t, Block, 4)
+	assert.Equal(t, []string{"0", "1"}, received)
+	assert.Equal(t, uint64(2), dropped)
+}
+
+func TestBrokerBlockDeadline(t *testing.T) {
+	sendTimeout := SendTimeout
+	SendTimeout = 50 * time.Millisecond
+	defer func() {
+		SendTimeout = sendTimeout
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewBroker(BrokerConfig{StreamBufferSize: 4, WatcherBufferSize: 1, OverflowPolicy: Block})
+	b.AddStream(ctx, testStreamID)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, b.Watch(testStreamID, make(chan *SBStreamMessage), uuid.New()))
+	}
+
+	// the watchers are never read, so each takes two messages and the last two wait for the shared deadline once each,
+	// rather than once for every watcher
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, b.Send(testStreamID, &SBStreamMessage{TargetXAppID: strconv.Itoa(i)}))
+	}
+	assert.Eventually(t, func() bool {
+		return b.Stats()[testStreamID].Dropped == 6
+	}, 5*time.Second, 5*time.Millisecond)
+	assert.Less(t, time.Since(start), 4*SendTimeout)
+}
+
+func TestBrokerClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewBroker(DefaultBrokerConfig())
+	events := make(chan StreamEvent, 2)
+	b.WatchStreams(ctx, events)
+
+	b.AddStream(ctx, testStreamID)
+	assert.Equal(t, StreamEvent{Type: StreamAdded, ID: testStreamID}, <-events)
+
+	watcherID := uuid.New()
+	// the first watcher is never read, so its delivery goroutine is blocked when the stream is closed
+	stuck := make(chan *SBStreamMessage)
+	assert.NoError(t, b.Watch(testStreamID, stuck, watcherID))
+	assert.True(t, errors.IsAlreadyExists(b.Watch(testStreamID, make(chan *SBStreamMessage), watcherID)))
+	deleted := make(chan *SBStreamMessage)
+	deletedID := uuid.New()
+	assert.NoError(t, b.Watch(testStreamID, deleted, deletedID))
+	b.DeleteWatcher(testStreamID, deletedID)
+	_, ok := <-deleted
+	assert.False(t, ok)
+
+	assert.NoError(t, b.Send(testStreamID, &SBStreamMessage{}))
+	assert.Eventually(t, func() bool {
+		return len(stuck) == 0 && b.Stats()[testStreamID].Sent == 1
+	}, time.Second, 10*time.Millisecond)
+
+	closed := make(chan struct{})
+	go func() {
+		b.Close(testStreamID)
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("closing the stream blocked on its watcher")
+	}
+	assert.Equal(t, StreamEvent{Type: StreamClosed, ID: testStreamID}, <-events)
+
+	_, ok = <-stuck
+	assert.False(t, ok)
+	assert.True(t, errors.IsNotFound(b.Send(testStreamID, &SBStreamMessage{})))
+	assert.True(t, errors.IsNotFound(b.Watch(testStreamID, make(chan *SBStreamMessage), uuid.New())))
+	assert.NotContains(t, b.Stats(), testStreamID)
+}
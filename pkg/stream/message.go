@@ -44,6 +44,11 @@ func (r A1SBIRPCType) String() string {
 		"EIQuery", "EIJobSetup", "EIJobUpdate", "EIJobDelete", "EIJobStatusQuery", "EIJobStatusNotify", "EIJobResultDelivery"}[r]
 }
 
+// IsIdempotent returns true if the RPC can be retried on another endpoint of the xApp
+func (r A1SBIRPCType) IsIdempotent() bool {
+	return r == PolicyQuery
+}
+
 type A1Service int
 
 const (
@@ -61,6 +66,10 @@ type SBStreamMessage struct {
 	A1SBIRPCType     A1SBIRPCType
 	A1Service        A1Service
 	Payload          interface{}
+	// Endpoint is the xApp endpoint a message came from, or has to be sent to; empty lets the southbound pick one
+	Endpoint string
+	// Error is set on the failure responses A1T makes up for the requests it could not deliver, keeping the error type
+	Error error
 }
 
 const (
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	streamLabels = []string{"src_endpoint_id", "dest_endpoint_id"}
+
+	sentDesc = prometheus.NewDesc("a1t_stream_messages_sent_total",
+		"Number of messages sent to a stream", streamLabels, nil)
+	deliveredDesc = prometheus.NewDesc("a1t_stream_messages_delivered_total",
+		"Number of messages of a stream delivered to its watchers", streamLabels, nil)
+	droppedDesc = prometheus.NewDesc("a1t_stream_messages_dropped_total",
+		"Number of messages of a stream dropped because a watcher was full", streamLabels, nil)
+	watchersDesc = prometheus.NewDesc("a1t_stream_watchers",
+		"Number of watchers of a stream", streamLabels, nil)
+)
+
+// NewStatsCollector returns a collector of the delivery counters of the streams of the broker; the counters are read
+// at scrape time, so that the series of a closed stream go away with it
+func NewStatsCollector(broker Broker) prometheus.Collector {
+	return &statsCollector{broker: broker}
+}
+
+type statsCollector struct {
+	broker Broker
+}
+
+func (c *statsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- sentDesc
+	ch <- deliveredDesc
+	ch <- droppedDesc
+	ch <- watchersDesc
+}
+
+func (c *statsCollector) Collect(ch chan<- prometheus.Metric) {
+	for id, stats := range c.broker.Stats() {
+		labels := []string{string(id.SrcEndpointID), string(id.DestEndpointID)}
+		ch <- prometheus.MustNewConstMetric(sentDesc, prometheus.CounterValue, float64(stats.Sent), labels...)
+		ch <- prometheus.MustNewConstMetric(deliveredDesc, prometheus.CounterValue, float64(stats.Delivered), labels...)
+		ch <- prometheus.MustNewConstMetric(droppedDesc, prometheus.CounterValue, float64(stats.Dropped), labels...)
+		ch <- prometheus.MustNewConstMetric(watchersDesc, prometheus.GaugeValue, float64(stats.Watchers), labels...)
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsCollector(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewBroker(DefaultBrokerConfig())
+	id := ID{SrcEndpointID: "a1t", DestEndpointID: "xapp-1"}
+	b.AddStream(ctx, id)
+	ch := make(chan *SBStreamMessage, 1)
+	assert.NoError(t, b.Watch(id, ch, uuid.New()))
+	assert.NoError(t, b.Send(id, &SBStreamMessage{}))
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("message not delivered")
+	}
+
+	expected := `
+# HELP a1t_stream_messages_sent_total Number of messages sent to a stream
+# TYPE a1t_stream_messages_sent_total counter
+a1t_stream_messages_sent_total{dest_endpoint_id="xapp-1",src_endpoint_id="a1t"} 1
+# HELP a1t_stream_watchers Number of watchers of a stream
+# TYPE a1t_stream_watchers gauge
+a1t_stream_watchers{dest_endpoint_id="xapp-1",src_endpoint_id="a1t"} 1
+`
+	collector := NewStatsCollector(b)
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"a1t_stream_messages_sent_total", "a1t_stream_watchers"))
+
+	b.Close(id)
+	assert.Equal(t, 0, testutil.CollectAndCount(collector))
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// Requester sends a request message on a stream and waits for the response with the same request ID on the reverse stream
+type Requester interface {
+	Request(ctx context.Context, id ID, message *SBStreamMessage) (*SBStreamMessage, error)
+}
+
+// NewRequester creates a requester; defaultTimeout applies to the requests whose context has no deadline
+func NewRequester(streamBroker Broker, defaultTimeout time.Duration) Requester {
+	return &requester{
+		streamBroker:   streamBroker,
+		defaultTimeout: defaultTimeout,
+		sessions:       make(map[ID]*requesterSession),
+	}
+}
+
+type requester struct {
+	streamBroker   Broker
+	defaultTimeout time.Duration
+	sessions       map[ID]*requesterSession
+	mu             sync.Mutex
+}
+
+// requesterSession is the single watcher of a response stream and its table of pending requests
+type requesterSession struct {
+	id      ID
+	pending map[string]chan *SBStreamMessage
+	closed  bool
+	mu      sync.Mutex
+}
+
+func (r *requester) Request(ctx context.Context, id ID, message *SBStreamMessage) (*SBStreamMessage, error) {
+	requestID := GetRequestID(message)
+	if requestID == "" {
+		return nil, errors.NewInvalid("message %v has no request ID", message)
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.defaultTimeout)
+		defer cancel()
+	}
+
+	session, err := r.getSession(ReverseID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	respCh, err := session.addPending(requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer session.removePending(requestID)
+
+	err = r.streamBroker.Send(id, message)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case resp, ok := <-respCh:
+		if !ok {
+			return nil, errors.NewUnavailable("stream %v closed before the response to request %v was received", session.id, requestID)
+		}
+		return resp, nil
+	case <-ctx.Done():
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, errors.NewTimeout("could not receive the response to request %v in time", requestID)
+		}
+		return nil, errors.NewCanceled("request %v canceled: %v", requestID, ctx.Err())
+	}
+}
+
+// getSession returns the session watching the response stream, creating it if necessary
+func (r *requester) getSession(id ID) (*requesterSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if session, ok := r.sessions[id]; ok {
+		return session, nil
+	}
+
+	session := &requesterSession{
+		id:      id,
+		pending: make(map[string]chan *SBStreamMessage),
+	}
+	ch := make(chan *SBStreamMessage)
+	err := r.streamBroker.Watch(id, ch, uuid.New())
+	if err != nil {
+		return nil, err
+	}
+	r.sessions[id] = session
+
+	go func() {
+		for msg := range ch {
+			session.dispatch(msg)
+		}
+		// the stream was closed - the next request creates a new session
+		r.mu.Lock()
+		if r.sessions[id] == session {
+			delete(r.sessions, id)
+		}
+		r.mu.Unlock()
+		session.closePending()
+	}()
+	return session, nil
+}
+
+// addPending registers a request waiting for its response; it fails if the response stream was closed meanwhile, as
+// nothing would then answer or close the request
+func (s *requesterSession) addPending(requestID string) (chan *SBStreamMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, errors.NewUnavailable("stream %v closed before request %v was sent", s.id, requestID)
+	}
+	ch := make(chan *SBStreamMessage, 1)
+	s.pending[requestID] = ch
+	return ch, nil
+}
+
+func (s *requesterSession) removePending(requestID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pending, requestID)
+}
+
+func (s *requesterSession) dispatch(msg *SBStreamMessage) {
+	requestID := GetRequestID(msg)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch, ok := s.pending[requestID]
+	if !ok {
+		return
+	}
+	delete(s.pending, requestID)
+	ch <- msg
+}
+
+func (s *requesterSession) closePending() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for requestID, ch := range s.pending {
+		close(ch)
+		delete(s.pending, requestID)
+	}
+}
+
+// GetRequestID returns the request ID in the header of the message payload
+func GetRequestID(message *SBStreamMessage) string {
+	switch msg := message.Payload.(type) {
+	case *a1.PolicyRequestMessage:
+		return msg.GetMessage().GetHeader().GetRequestId()
+	case *a1.PolicyResultMessage:
+		return msg.GetMessage().GetHeader().GetRequestId()
+	case *a1.PolicyStatusMessage:
+		return msg.GetMessage().GetHeader().GetRequestId()
+	case *a1.PolicyAckMessage:
+		return msg.GetMessage().GetHeader().GetRequestId()
+	case *a1.EIRequestMessage:
+		return msg.GetMessage().GetHeader().GetRequestId()
+	case *a1.EIResultMessage:
+		return msg.GetMessage().GetHeader().GetRequestId()
+	case *a1.EIStatusMessage:
+		return msg.GetMessage().GetHeader().GetRequestId()
+	case *a1.EIAckMessage:
+		return msg.GetMessage().GetHeader().GetRequestId()
+	}
+	return ""
+}
+
+var _ Requester = &requester{}
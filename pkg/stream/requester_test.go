@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-api/go/onos/a1t/a1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRequest(requestID string) *SBStreamMessage {
+	return &SBStreamMessage{
+		Payload: &a1.PolicyRequestMessage{
+			PolicyId: requestID,
+			Message:  &a1.RequestMessage{Header: &a1.Header{RequestId: requestID}},
+		},
+	}
+}
+
+func newTestResult(requestID string) *SBStreamMessage {
+	return &SBStreamMessage{
+		Payload: &a1.PolicyResultMessage{
+			PolicyId: requestID,
+			Message:  &a1.ResultMessage{Header: &a1.Header{RequestId: requestID}},
+		},
+	}
+}
+
+// newTestXApp adds the request and response streams and returns the channel of the requests the xApp receives
+func newTestXApp(ctx context.Context, t *testing.T, b Broker) chan *SBStreamMessage {
+	b.AddStream(ctx, testStreamID)
+	b.AddStream(ctx, ReverseID(testStreamID))
+	requests := make(chan *SBStreamMessage, 16)
+	assert.NoError(t, b.Watch(testStreamID, requests, uuid.New()))
+	return requests
+}
+
+func TestRequesterCorrelation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewBroker(DefaultBrokerConfig())
+	requests := newTestXApp(ctx, t, b)
+	r := NewRequester(b, time.Second)
+
+	// the xApp answers the requests in the reverse order
+	go func() {
+		first := <-requests
+		second := <-requests
+		assert.NoError(t, b.Send(ReverseID(testStreamID), newTestResult("unknown")))
+		assert.NoError(t, b.Send(ReverseID(testStreamID), newTestResult(GetRequestID(second))))
+		assert.NoError(t, b.Send(ReverseID(testStreamID), newTestResult(GetRequestID(first))))
+	}()
+
+	var wg sync.WaitGroup
+	for _, requestID := range []string{"request-1", "request-2"} {
+		wg.Add(1)
+		go func(requestID string) {
+			defer wg.Done()
+			resp, err := r.Request(ctx, testStreamID, newTestRequest(requestID))
+			if assert.NoError(t, err) {
+				assert.Equal(t, requestID, GetRequestID(resp))
+			}
+		}(requestID)
+	}
+	wg.Wait()
+}
+
+func TestRequesterErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewBroker(DefaultBrokerConfig())
+	requests := newTestXApp(ctx, t, b)
+	r := NewRequester(b, 50*time.Millisecond)
+
+	_, err := r.Request(ctx, testStreamID, &SBStreamMessage{Payload: &a1.PolicyRequestMessage{}})
+	assert.True(t, errors.IsInvalid(err))
+
+	_, err = r.Request(ctx, testStreamID, newTestRequest("timeout"))
+	assert.True(t, errors.IsTimeout(err))
+	<-requests
+
+	canceledCtx, cancelRequest := context.WithCancel(ctx)
+	go func() {
+		<-requests
+		cancelRequest()
+	}()
+	_, err = r.Request(canceledCtx, testStreamID, newTestRequest("canceled"))
+	assert.True(t, errors.IsCanceled(err))
+
+	go func() {
+		<-requests
+		b.Close(ReverseID(testStreamID))
+	}()
+	_, err = r.Request(ctx, testStreamID, newTestRequest("closed"))
+	assert.True(t, errors.IsUnavailable(err))
+
+	// the next request watches the response stream again once it is back
+	_, err = r.Request(ctx, testStreamID, newTestRequest("missing"))
+	assert.True(t, errors.IsNotFound(err))
+	b.AddStream(ctx, ReverseID(testStreamID))
+	go func() {
+		request := <-requests
+		assert.NoError(t, b.Send(ReverseID(testStreamID), newTestResult(GetRequestID(request))))
+	}()
+	resp, err := r.Request(ctx, testStreamID, newTestRequest("reopened"))
+	assert.NoError(t, err)
+	assert.Equal(t, "reopened", GetRequestID(resp))
+}
+
+func TestRequesterClosedSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewBroker(DefaultBrokerConfig())
+	newTestXApp(ctx, t, b)
+	r := NewRequester(b, time.Second).(*requester)
+
+	// a request which got the session just before its stream was closed is rejected rather than left waiting
+	session, err := r.getSession(ReverseID(testStreamID))
+	assert.NoError(t, err)
+	b.Close(ReverseID(testStreamID))
+	assert.Eventually(t, func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		_, ok := r.sessions[ReverseID(testStreamID)]
+		return !ok
+	}, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool {
+		_, err := session.addPending("late")
+		return errors.IsUnavailable(err)
+	}, time.Second, time.Millisecond)
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	assert.Empty(t, session.pending)
+}
@@ -107,7 +107,12 @@ func (d *directionalStreamReader) Recv(ctx context.Context) (*SBStreamMessage, e
 }
 
 func NewDirectionalStream(id ID) Stream {
-	ch := make(chan *SBStreamMessage)
+	return NewBufferedDirectionalStream(id, 0)
+}
+
+// NewBufferedDirectionalStream creates a stream which accepts up to bufferSize messages before Send blocks
+func NewBufferedDirectionalStream(id ID, bufferSize int) Stream {
+	ch := make(chan *SBStreamMessage, bufferSize)
 	return &directionalStream{
 		IO: &directionalStreamIO{
 			id: id,
@@ -4,7 +4,11 @@
 
 package stream
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
 
 func GetEndpointIDWithTargetXAppID(targetXAppID string, a1Service A1Service) EndpointID {
 	return EndpointID(fmt.Sprintf("%s-%s", targetXAppID, a1Service.String()))
@@ -34,3 +38,17 @@ func GetStreamID(nbControllerID EndpointID, sbControllerID EndpointID) (ID, ID)
 			DestEndpointID: nbControllerID,
 		}
 }
+
+// GetWatcherID returns the ID of the watcher of the stream on behalf of the endpoint; the same endpoint watching the
+// same stream again gets the same ID, so that the broker rejects a second watcher
+func GetWatcherID(endpointID EndpointID, id ID) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("%s:%s:%s", endpointID, id.SrcEndpointID, id.DestEndpointID)))
+}
+
+// ReverseID returns the ID of the stream in the opposite direction
+func ReverseID(id ID) ID {
+	return ID{
+		SrcEndpointID:  id.DestEndpointID,
+		DestEndpointID: id.SrcEndpointID,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/onosproject/onos-a1t/pkg/store"
 
 	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 )
 
@@ -108,12 +109,7 @@ func (sm *Manager) createSubscription(ctx context.Context, topoObject topoapi.Ob
 	}
 
 	// get endpoint information
-	for _, i := range xAppInfo.GetInterfaces() {
-		if i.GetType() == topoapi.Interface_INTERFACE_A1_XAPP {
-			subValue.A1EndpointIP = i.GetIP()
-			subValue.A1EndpointPort = i.GetPort()
-		}
-	}
+	subValue.A1Endpoints = getA1Endpoints(xAppInfo)
 
 	// get capabilities
 	for _, p := range xAppInfo.GetA1PolicyTypes() {
@@ -123,13 +119,7 @@ func (sm *Manager) createSubscription(ctx context.Context, topoObject topoapi.Ob
 		}
 		subValue.A1ServiceCapabilities = append(subValue.A1ServiceCapabilities, serviceTypeDef)
 	}
-
-	// todo: have to be clarified but now by default it added the EI capability; at this moment, it's fine
-	eiServiceTypeDef := &store.A1ServiceType{
-		A1Service: store.EnrichmentInformation,
-		TypeID:    "",
-	}
-	subValue.A1ServiceCapabilities = append(subValue.A1ServiceCapabilities, eiServiceTypeDef)
+	subValue.A1ServiceCapabilities = append(subValue.A1ServiceCapabilities, sm.getEICapabilities(ctx, topoObject.GetID())...)
 
 	_, err = sm.subscriptionStore.Put(ctx, subKey, subValue)
 	if err != nil {
@@ -207,12 +197,7 @@ func (sm *Manager) updateSubscription(ctx context.Context, topoObject topoapi.Ob
 	}
 
 	// get endpoint information
-	for _, i := range xAppInfo.GetInterfaces() {
-		if i.GetType() == topoapi.Interface_INTERFACE_A1_XAPP {
-			subValue.A1EndpointIP = i.GetIP()
-			subValue.A1EndpointPort = i.GetPort()
-		}
-	}
+	subValue.A1Endpoints = getA1Endpoints(xAppInfo)
 
 	// get capabilities
 	for _, p := range xAppInfo.GetA1PolicyTypes() {
@@ -222,13 +207,7 @@ func (sm *Manager) updateSubscription(ctx context.Context, topoObject topoapi.Ob
 		}
 		subValue.A1ServiceCapabilities = append(subValue.A1ServiceCapabilities, serviceTypeDef)
 	}
-
-	// todo: have to be clarified but now by default it added the EI capability; at this moment, it's fine
-	eiServiceTypeDef := &store.A1ServiceType{
-		A1Service: store.EnrichmentInformation,
-		TypeID:    "",
-	}
-	subValue.A1ServiceCapabilities = append(subValue.A1ServiceCapabilities, eiServiceTypeDef)
+	subValue.A1ServiceCapabilities = append(subValue.A1ServiceCapabilities, sm.getEICapabilities(ctx, topoObject.GetID())...)
 
 	_, err = sm.subscriptionStore.Update(ctx, subKey, subValue)
 	if err != nil {
@@ -237,3 +216,54 @@ func (sm *Manager) updateSubscription(ctx context.Context, topoObject topoapi.Ob
 
 	return nil
 }
+
+// getEICapabilities returns the EI types declared by the xApp; an xApp which does not declare them gets a capability
+// with an empty type, which stands for any EI type
+func (sm *Manager) getEICapabilities(ctx context.Context, xAppID topoapi.ID) []*store.A1ServiceType {
+	eiTypes, err := sm.rnibClient.GetXappEITypes(ctx, xAppID)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Warn(err)
+		}
+		return []*store.A1ServiceType{{
+			A1Service: store.EnrichmentInformation,
+			TypeID:    store.AnyEIType,
+		}}
+	}
+	capabilities := make([]*store.A1ServiceType, 0, len(eiTypes))
+	for _, t := range eiTypes {
+		if t.ID == "" {
+			continue
+		}
+		capabilities = append(capabilities, &store.A1ServiceType{
+			A1Service: store.EnrichmentInformation,
+			TypeID:    t.ID,
+		})
+	}
+	return capabilities
+}
+
+// getA1Endpoints returns all distinct A1 interfaces of the xApp
+func getA1Endpoints(xAppInfo *topoapi.XAppInfo) []store.A1Endpoint {
+	endpoints := make([]store.A1Endpoint, 0)
+	for _, i := range xAppInfo.GetInterfaces() {
+		if i.GetType() != topoapi.Interface_INTERFACE_A1_XAPP {
+			continue
+		}
+		endpoint := store.A1Endpoint{
+			IP:   i.GetIP(),
+			Port: i.GetPort(),
+		}
+		duplicate := false
+		for _, e := range endpoints {
+			if e == endpoint {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
@@ -6,9 +6,11 @@ package utils
 
 import (
 	"encoding/json"
-	"fmt"
+	"reflect"
+	"sort"
 
 	policyschemas "github.com/onosproject/onos-a1-dm/go/policy_schemas"
+	"github.com/onosproject/onos-a1t/pkg/policydiff"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/xeipuuv/gojsonschema"
@@ -18,6 +20,14 @@ var log = logging.GetLogger()
 
 const NotificationDestination = "notificationDestination"
 
+// A1T extension parameters of a policy limiting it to a validity window, see controller.ParsePolicySchedule
+const (
+	ValidFrom       = "validFrom"
+	ValidUntil      = "validUntil"
+	Recurrence      = "recurrence"
+	RecurrenceUntil = "recurrenceUntil"
+)
+
 func JsonValidateWithTypeID(policyTypeID string, jsonDoc string) bool {
 	schemeDoc, ok := policyschemas.PolicySchemas[policyTypeID]
 	if !ok {
@@ -69,56 +79,57 @@ func GetPolicyObject(query map[string]interface{}) map[string]interface{} {
 }
 
 func PolicyObjListValidate(objs interface{}) (bool, error) {
+	i, err := PolicyObjListDivergence(objs)
+	if err != nil {
+		return false, err
+	}
+	if i > 0 {
+		return false, errors.NewConflict("PolicyObject is inconsistent")
+	}
+	return true, nil
+}
+
+// PolicyObjListDivergence returns the index of the first policy object, or policy ID list, which differs from the
+// first one, or -1 if they are all the same; the policy objects are compared in their canonical JSON form (RFC 8785)
+// and the policy ID lists regardless of their order
+func PolicyObjListDivergence(objs interface{}) (int, error) {
 	switch objs := objs.(type) {
 	case []map[string]interface{}:
 		if len(objs) == 0 {
-			return false, errors.NewNotFound("there is no policy object")
+			return -1, errors.NewNotFound("there is no policy object")
 		}
-
-		targetDoc, err := json.Marshal(objs[0])
+		targetDoc, err := policydiff.Canonicalize(objs[0])
 		if err != nil {
-			return false, err
+			return -1, err
 		}
-
 		for i := 1; i < len(objs); i++ {
-			tmpDoc, err := json.Marshal(objs[0])
+			tmpDoc, err := policydiff.Canonicalize(objs[i])
 			if err != nil {
-				return false, err
+				return -1, err
 			}
-
-			fmt.Printf("WKIM (%v): targetDoc: %v / tmpDoc: %v\n", string(targetDoc) == string(tmpDoc), string(targetDoc), string(tmpDoc))
-
 			if string(targetDoc) != string(tmpDoc) {
-				return false, errors.NewConflict("PolicyObject is inconsistent")
+				return i, nil
 			}
 		}
-
-		return true, nil
+		return -1, nil
 	case [][]string:
 		if len(objs) == 0 {
-			return false, errors.NewNotFound("there is no policy object")
+			return -1, errors.NewNotFound("there is no policy object")
 		}
-
-		targetDoc, err := json.Marshal(objs[0])
-		if err != nil {
-			return false, err
-		}
-
+		target := sortedCopy(objs[0])
 		for i := 1; i < len(objs); i++ {
-			tmpDoc, err := json.Marshal(objs[0])
-			if err != nil {
-				return false, err
-			}
-
-			fmt.Printf("WKIM (%v): targetDoc: %v / tmpDoc: %v\n", string(targetDoc) == string(tmpDoc), string(targetDoc), string(tmpDoc))
-
-			if string(targetDoc) != string(tmpDoc) {
-				return false, errors.NewConflict("PolicyObject is inconsistent")
+			if !reflect.DeepEqual(target, sortedCopy(objs[i])) {
+				return i, nil
 			}
 		}
-
-		return true, nil
+		return -1, nil
 	}
 
-	return false, errors.NewNotSupported("object type should be map[string]interface{} or []string")
+	return -1, errors.NewNotSupported("object type should be map[string]interface{} or []string")
+}
+
+func sortedCopy(values []string) []string {
+	results := append(make([]string, 0, len(values)), values...)
+	sort.Strings(results)
+	return results
 }